@@ -59,6 +59,12 @@ func main() {
 		if err := container.Migrator.AutoMigrate(ctx); err != nil {
 			container.Logger.Fatal("Failed to run auto-migration", zap.Error(err))
 		}
+		if err := container.Migrator.SeedDefaults(ctx); err != nil {
+			container.Logger.Fatal("Failed to seed default data", zap.Error(err))
+		}
+		if err := container.Migrator.MigrateRolePermissions(ctx); err != nil {
+			container.Logger.Fatal("Failed to migrate role permissions", zap.Error(err))
+		}
 		container.Logger.Info("Auto-migration completed successfully")
 	} else {
 		container.Logger.Info("Auto-migration is disabled")
@@ -88,5 +94,10 @@ func main() {
 		container.Logger.Error("Server forced to shutdown", zap.Error(err))
 	}
 
+	// Deliver the mail still queued in the outbox
+	if err := container.MailOutbox.Close(ctx); err != nil {
+		container.Logger.Error("Failed to flush mail outbox", zap.Error(err))
+	}
+
 	container.Logger.Info("Server exited")
 }
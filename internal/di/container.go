@@ -1,16 +1,24 @@
 package di
 
 import (
+	"bm-staff/internal/domain/entities"
 	"bm-staff/internal/domain/services"
 	"bm-staff/internal/infrastructure/config"
 	"bm-staff/internal/infrastructure/database"
 	"bm-staff/internal/infrastructure/http"
 	"bm-staff/internal/infrastructure/logging"
+	"bm-staff/internal/infrastructure/mail"
+	"bm-staff/internal/infrastructure/sms"
 	"bm-staff/internal/interfaces/http/handlers"
 	"bm-staff/internal/interfaces/http/middleware"
 	"bm-staff/internal/interfaces/repositories/oracle"
+	"bm-staff/internal/usecases/audit"
 	"bm-staff/internal/usecases/auth"
+	"bm-staff/internal/usecases/department"
+	"bm-staff/internal/usecases/permission"
+	"bm-staff/internal/usecases/role"
 	"bm-staff/internal/usecases/user"
+	"bm-staff/pkg/pagination"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/google/wire"
@@ -19,14 +27,24 @@ import (
 
 // Container holds all dependencies
 type Container struct {
-	Config         *config.Config
-	Logger         *zap.Logger
-	Database       *database.OracleDB
-	Migrator       *database.GORMMigrator
-	UserHandler    *handlers.UserHandler
-	AuthHandler    *handlers.AuthHandler
-	AuthMiddleware *middleware.AuthMiddleware
-	HTTPServer     *http.Server
+	Config                   *config.Config
+	Logger                   *zap.Logger
+	Database                 *database.OracleDB
+	Migrator                 *database.GORMMigrator
+	UserHandler              *handlers.UserHandler
+	RoleHandler              *handlers.RoleHandler
+	PermissionHandler        *handlers.PermissionHandler
+	DepartmentHandler        *handlers.DepartmentHandler
+	AuditLogHandler          *handlers.AuditLogHandler
+	SessionHandler           *handlers.SessionHandler
+	MeHandler                *handlers.MeHandler
+	PasswordResetHandler     *handlers.PasswordResetHandler
+	EmailVerificationHandler *handlers.EmailVerificationHandler
+	MFAHandler               *handlers.MFAHandler
+	AuthHandler              *handlers.AuthHandler
+	AuthMiddleware           *middleware.AuthMiddleware
+	MailOutbox               *mail.Outbox
+	HTTPServer               *http.Server
 }
 
 // NewContainer creates a new dependency injection container
@@ -70,26 +88,212 @@ func NewContainer() (*Container, error) {
 	// Create repositories
 	userRepo := oracle.NewUserRepository(oracleDB.DB(), logger)
 	refreshTokenRepo := oracle.NewRefreshTokenRepository(oracleDB.DB(), logger)
+	passwordResetTokenRepo := oracle.NewPasswordResetTokenRepository(oracleDB.DB(), logger)
+	phoneVerificationRepo := oracle.NewPhoneVerificationRepository(oracleDB.DB(), logger)
+	userMFARepo := oracle.NewUserMFARepository(oracleDB.DB(), logger)
+	mfaRecoveryCodeRepo := oracle.NewMFARecoveryCodeRepository(oracleDB.DB(), logger)
+	passwordHistoryRepo := oracle.NewPasswordHistoryRepository(oracleDB.DB(), logger)
+	roleRepo := oracle.NewRoleRepository(oracleDB.DB(), logger)
+	permissionRepo := oracle.NewPermissionRepository(oracleDB.DB(), logger)
+	departmentRepo := oracle.NewDepartmentRepository(oracleDB.DB(), logger)
+	auditLogRepo := oracle.NewAuditLogRepository(oracleDB.DB(), logger)
+	transactor := oracle.NewTransactor(oracleDB.DB(), logger)
 
 	// Create domain services
 	userService := services.NewUserService(userRepo)
-	passwordService := services.NewPasswordService()
+	roleService := services.NewRoleService(roleRepo)
+	departmentService := services.NewDepartmentService(departmentRepo)
+	authorizationService := services.NewAuthorizationService(roleRepo, permissionRepo)
+	policyEngine := services.NewPolicyEngine(authorizationService, userRepo, departmentRepo, roleRepo)
+	auditChainService := services.NewAuditChainService(auditLogRepo)
+	auditRecorder := services.NewAuditRecorder(auditLogRepo, auditChainService, transactor)
+	passwordHasher, err := services.NewPasswordHasher(
+		cfg.Password.Algorithm,
+		services.Argon2Params{
+			Memory:      cfg.Password.Argon2Memory,
+			Iterations:  cfg.Password.Argon2Iterations,
+			Parallelism: cfg.Password.Argon2Parallelism,
+			SaltLength:  cfg.Password.Argon2SaltLength,
+			KeyLength:   cfg.Password.Argon2KeyLength,
+		},
+		cfg.Password.BcryptCost,
+	)
+	if err != nil {
+		return nil, err
+	}
+	passwordService := services.NewPasswordService(passwordHasher)
+	passwordPolicy := services.NewPasswordPolicy(services.PasswordRules{
+		MinLength:     cfg.Password.MinLength,
+		RequireUpper:  cfg.Password.RequireUpper,
+		RequireLower:  cfg.Password.RequireLower,
+		RequireDigit:  cfg.Password.RequireDigit,
+		RequireSymbol: cfg.Password.RequireSymbol,
+		History:       cfg.Password.History,
+		MaxAge:        cfg.Password.MaxAge,
+	}, passwordHistoryRepo, passwordService)
+	jwtKeys := services.NewHMACKeySet(cfg.JWT.SecretKey)
+	if cfg.JWT.SigningKey.File != "" {
+		verificationKeys := make([]services.JWTKeyFile, 0, len(cfg.JWT.VerificationKeys))
+		for _, key := range cfg.JWT.VerificationKeys {
+			verificationKeys = append(verificationKeys, services.JWTKeyFile{ID: key.KeyID, Path: key.File})
+		}
+		jwtKeys, err = services.LoadJWTKeySet(
+			services.JWTKeyFile{ID: cfg.JWT.SigningKey.KeyID, Path: cfg.JWT.SigningKey.File},
+			verificationKeys,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
 	jwtService := services.NewJWTService(
-		cfg.JWT.SecretKey,
+		jwtKeys,
 		cfg.JWT.AccessExpiry,
 		cfg.JWT.RefreshExpiry,
 	)
+	tokenRevoker := services.NewTokenRevoker(services.NewMemoryTokenRevocationStore(), cfg.JWT.AccessExpiry)
+
+	cursorCodec := pagination.NewCursorCodec(cfg.Pagination.CursorSecret)
+
+	// Create mail sender, messages are delivered in the background through the outbox
+	mailSender, err := mail.NewSender(mail.Config{
+		Driver:   cfg.Mail.Driver,
+		From:     cfg.Mail.From,
+		Host:     cfg.Mail.SMTP.Host,
+		Port:     cfg.Mail.SMTP.Port,
+		Username: cfg.Mail.SMTP.Username,
+		Password: cfg.Mail.SMTP.Password,
+		Dir:      cfg.Mail.Dir,
+	}, logger)
+	if err != nil {
+		return nil, err
+	}
+	mailOutbox := mail.NewOutbox(mailSender, logger, cfg.Mail.OutboxSize)
+	emailVerificationService := services.NewEmailVerificationService(
+		cfg.EmailVerification.Secret,
+		cfg.EmailVerification.TokenExpiry,
+		cfg.EmailVerification.URL,
+		mailOutbox,
+	)
+	verificationThrottle := services.NewThrottle(
+		cfg.EmailVerification.ResendInterval,
+		cfg.EmailVerification.ResendLimit,
+		cfg.EmailVerification.ResendWindow,
+	)
+
+	// Create SMS sender for phone verification codes
+	smsSender, err := sms.NewSender(sms.Config{
+		Driver:  cfg.SMS.Driver,
+		URL:     cfg.SMS.URL,
+		APIKey:  cfg.SMS.APIKey,
+		Sender:  cfg.SMS.Sender,
+		Timeout: cfg.SMS.Timeout,
+		File:    cfg.SMS.File,
+	}, logger)
+	if err != nil {
+		return nil, err
+	}
+	otpService := services.NewOTPService(cfg.PhoneVerification.Secret, cfg.PhoneVerification.CodeLength)
+	phoneVerificationThrottle := services.NewThrottle(
+		cfg.PhoneVerification.ResendInterval,
+		cfg.PhoneVerification.ResendLimit,
+		cfg.PhoneVerification.ResendWindow,
+	)
+
+	// Create login throttle, counting failed logins per client IP and username in memory
+	loginThrottle := services.NewLoginThrottle(
+		services.NewMemoryLoginAttemptStore(),
+		services.LoginLimit{
+			Window: cfg.Login.IPWindow,
+			Lockout: entities.LockoutPolicy{
+				MaxAttempts: cfg.Login.IPMaxAttempts,
+				Duration:    cfg.Login.IPLockoutDuration,
+				MaxDuration: cfg.Login.IPMaxLockoutDuration,
+			},
+		},
+		services.LoginLimit{
+			Window: cfg.Login.Window,
+			Lockout: entities.LockoutPolicy{
+				MaxAttempts: cfg.Login.MaxAttempts,
+				Duration:    cfg.Login.LockoutDuration,
+				MaxDuration: cfg.Login.MaxLockoutDuration,
+			},
+		},
+	)
+
+	// Create two-factor authentication services, code entries are limited per user
+	totpService := services.NewTOTPService(cfg.MFA.Issuer, cfg.MFA.Secret)
+	mfaService := services.NewMFAService(userMFARepo, mfaRecoveryCodeRepo, totpService, cfg.MFA.RecoveryCodes)
+	mfaThrottle := services.NewThrottle(0, cfg.MFA.MaxAttempts, cfg.MFA.AttemptWindow)
 
 	// Create use cases
-	createUserUseCase := user.NewCreateUserUseCase(userRepo, userService, passwordService)
-	getUserUseCase := user.NewGetUserUseCase(userRepo)
-	updateUserUseCase := user.NewUpdateUserUseCase(userRepo, userService)
-	deleteUserUseCase := user.NewDeleteUserUseCase(userRepo, userService)
+	createUserUseCase := user.NewCreateUserUseCase(userRepo, userService, passwordService, passwordPolicy, emailVerificationService, transactor, auditRecorder)
+	getUserUseCase := user.NewGetUserUseCase(userRepo, policyEngine)
+	updateUserUseCase := user.NewUpdateUserUseCase(userRepo, userService, policyEngine, emailVerificationService, transactor, auditRecorder)
+	deleteUserUseCase := user.NewDeleteUserUseCase(userRepo, refreshTokenRepo, userService, policyEngine, tokenRevoker, transactor, auditRecorder)
+	listUsersUseCase := user.NewListUsersUseCase(userRepo, cursorCodec)
+	assignRoleUseCase := user.NewAssignRoleUseCase(userRepo, roleRepo, authorizationService, tokenRevoker, transactor, auditRecorder)
+	updateOrganizationUseCase := user.NewUpdateOrganizationUseCase(userRepo, departmentRepo, userService, policyEngine, transactor, auditRecorder)
+	updateUserStatusUseCase := user.NewUpdateUserStatusUseCase(userRepo, refreshTokenRepo, userService, policyEngine, tokenRevoker, transactor, auditRecorder)
+	unlockUserUseCase := user.NewUnlockUserUseCase(userRepo, loginThrottle, transactor, auditRecorder)
+	listSessionsUseCase := user.NewListSessionsUseCase(userRepo, refreshTokenRepo, policyEngine)
+	revokeSessionUseCase := user.NewRevokeSessionUseCase(userRepo, refreshTokenRepo, policyEngine, transactor, auditRecorder)
+	revokeAllSessionsUseCase := user.NewRevokeAllSessionsUseCase(userRepo, refreshTokenRepo, policyEngine, tokenRevoker, transactor, auditRecorder)
+	updateProfileUseCase := user.NewUpdateProfileUseCase(userRepo, userService, transactor, auditRecorder)
+	updatePreferencesUseCase := user.NewUpdatePreferencesUseCase(userRepo, transactor, auditRecorder)
+	changePasswordUseCase := user.NewChangePasswordUseCase(userRepo, refreshTokenRepo, passwordService, passwordPolicy, jwtService, tokenRevoker, transactor, auditRecorder)
+	sendPhoneVerificationUseCase := user.NewSendPhoneVerificationUseCase(userRepo, phoneVerificationRepo, otpService, smsSender, phoneVerificationThrottle, transactor, auditRecorder, cfg.PhoneVerification.CodeExpiry)
+	verifyPhoneUseCase := user.NewVerifyPhoneUseCase(userRepo, phoneVerificationRepo, otpService, transactor, auditRecorder, cfg.PhoneVerification.MaxAttempts)
+	getMFAStatusUseCase := user.NewGetMFAStatusUseCase(userRepo, mfaService)
+	enrollTOTPUseCase := user.NewEnrollTOTPUseCase(userRepo, userMFARepo, mfaService, totpService, transactor, auditRecorder)
+	confirmTOTPUseCase := user.NewConfirmTOTPUseCase(userRepo, userMFARepo, mfaService, totpService, mfaThrottle, transactor, auditRecorder)
+	regenerateRecoveryCodesUseCase := user.NewRegenerateRecoveryCodesUseCase(userRepo, mfaService, mfaThrottle, transactor, auditRecorder)
+	disableMFAUseCase := user.NewDisableMFAUseCase(userRepo, mfaService, mfaThrottle, transactor, auditRecorder)
+	getReportsUseCase := user.NewGetReportsUseCase(userRepo, policyEngine)
+	getManagerChainUseCase := user.NewGetManagerChainUseCase(userRepo, policyEngine)
+	getOrgChartUseCase := user.NewGetOrgChartUseCase(userRepo, departmentRepo)
+
+	// Create role use cases
+	createRoleUseCase := role.NewCreateRoleUseCase(roleRepo, permissionRepo, roleService, authorizationService, transactor, auditRecorder)
+	getRoleUseCase := role.NewGetRoleUseCase(roleRepo)
+	updateRoleUseCase := role.NewUpdateRoleUseCase(roleRepo, userRepo, roleService, authorizationService, tokenRevoker, transactor, auditRecorder)
+	deleteRoleUseCase := role.NewDeleteRoleUseCase(roleRepo, userRepo, transactor, auditRecorder)
+	listRolesUseCase := role.NewListRolesUseCase(roleRepo)
+	setRolePermissionsUseCase := role.NewSetRolePermissionsUseCase(roleRepo, permissionRepo, authorizationService, transactor, auditRecorder)
+	revokeRolePermissionUseCase := role.NewRevokeRolePermissionUseCase(roleRepo, transactor, auditRecorder)
+	getEffectivePermissionsUseCase := role.NewGetEffectivePermissionsUseCase(roleRepo, authorizationService)
+
+	// Create permission use cases
+	createPermissionUseCase := permission.NewCreatePermissionUseCase(permissionRepo, transactor, auditRecorder)
+	getPermissionUseCase := permission.NewGetPermissionUseCase(permissionRepo)
+	updatePermissionUseCase := permission.NewUpdatePermissionUseCase(permissionRepo, transactor, auditRecorder)
+	deletePermissionUseCase := permission.NewDeletePermissionUseCase(permissionRepo, transactor, auditRecorder)
+	listPermissionsUseCase := permission.NewListPermissionsUseCase(permissionRepo)
+
+	// Create department use cases
+	createDepartmentUseCase := department.NewCreateDepartmentUseCase(departmentRepo, userRepo, departmentService)
+	getDepartmentUseCase := department.NewGetDepartmentUseCase(departmentRepo)
+	updateDepartmentUseCase := department.NewUpdateDepartmentUseCase(departmentRepo, userRepo)
+	deleteDepartmentUseCase := department.NewDeleteDepartmentUseCase(departmentRepo, userRepo)
+	listDepartmentsUseCase := department.NewListDepartmentsUseCase(departmentRepo)
+	listDepartmentChildrenUseCase := department.NewListDepartmentChildrenUseCase(departmentRepo)
+	getDepartmentSubtreeUseCase := department.NewGetDepartmentSubtreeUseCase(departmentRepo)
+	moveDepartmentUseCase := department.NewMoveDepartmentUseCase(departmentRepo, departmentService)
+
+	// Create audit log use cases
+	listAuditLogsUseCase := audit.NewListAuditLogsUseCase(auditLogRepo, cursorCodec)
+	exportAuditLogsUseCase := audit.NewExportAuditLogsUseCase(auditLogRepo)
+	getResourceHistoryUseCase := audit.NewGetResourceHistoryUseCase(auditLogRepo, cursorCodec)
+	verifyAuditChainUseCase := audit.NewVerifyAuditChainUseCase(auditChainService)
 
 	// Create auth use cases
-	loginUseCase := auth.NewLoginUseCase(userRepo, refreshTokenRepo, passwordService, jwtService)
-	logoutUseCase := auth.NewLogoutUseCase(refreshTokenRepo, jwtService)
-	refreshTokenUseCase := auth.NewRefreshTokenUseCase(userRepo, refreshTokenRepo, jwtService)
+	loginUseCase := auth.NewLoginUseCase(userRepo, refreshTokenRepo, passwordService, jwtService, loginThrottle, passwordPolicy, mfaService, tokenRevoker, mfaThrottle, transactor, auditRecorder, logger, cfg.MFA.TokenExpiry, cfg.Password.ChangeTokenExpiry)
+	logoutUseCase := auth.NewLogoutUseCase(refreshTokenRepo, jwtService, tokenRevoker, transactor, auditRecorder)
+	refreshTokenUseCase := auth.NewRefreshTokenUseCase(userRepo, refreshTokenRepo, jwtService, transactor, auditRecorder, cfg.JWT.RevokeAllOnReuse)
+	getJWKSUseCase := auth.NewGetJWKSUseCase(jwtService)
+	forgotPasswordUseCase := auth.NewForgotPasswordUseCase(userRepo, passwordResetTokenRepo, passwordService, mailOutbox, transactor, auditRecorder, cfg.PasswordReset.TokenExpiry, cfg.PasswordReset.URL)
+	verifyEmailUseCase := auth.NewVerifyEmailUseCase(userRepo, userService, emailVerificationService, transactor, auditRecorder, cfg.EmailVerification.AutoActivate)
+	resendVerificationEmailUseCase := auth.NewResendVerificationEmailUseCase(userRepo, emailVerificationService, verificationThrottle)
+	resetPasswordUseCase := auth.NewResetPasswordUseCase(userRepo, passwordResetTokenRepo, refreshTokenRepo, passwordService, passwordPolicy, tokenRevoker, transactor, auditRecorder)
 
 	// Create validator
 	validator := validator.New()
@@ -100,6 +304,102 @@ func NewContainer() (*Container, error) {
 		getUserUseCase,
 		updateUserUseCase,
 		deleteUserUseCase,
+		listUsersUseCase,
+		assignRoleUseCase,
+		updateOrganizationUseCase,
+		updateUserStatusUseCase,
+		unlockUserUseCase,
+		getReportsUseCase,
+		getManagerChainUseCase,
+		getOrgChartUseCase,
+		validator,
+		logger,
+	)
+
+	roleHandler := handlers.NewRoleHandler(
+		createRoleUseCase,
+		getRoleUseCase,
+		updateRoleUseCase,
+		deleteRoleUseCase,
+		listRolesUseCase,
+		setRolePermissionsUseCase,
+		revokeRolePermissionUseCase,
+		getEffectivePermissionsUseCase,
+		validator,
+		logger,
+	)
+
+	permissionHandler := handlers.NewPermissionHandler(
+		createPermissionUseCase,
+		getPermissionUseCase,
+		updatePermissionUseCase,
+		deletePermissionUseCase,
+		listPermissionsUseCase,
+		validator,
+		logger,
+	)
+
+	departmentHandler := handlers.NewDepartmentHandler(
+		createDepartmentUseCase,
+		getDepartmentUseCase,
+		updateDepartmentUseCase,
+		deleteDepartmentUseCase,
+		listDepartmentsUseCase,
+		listDepartmentChildrenUseCase,
+		getDepartmentSubtreeUseCase,
+		moveDepartmentUseCase,
+		validator,
+		logger,
+	)
+
+	auditLogHandler := handlers.NewAuditLogHandler(
+		listAuditLogsUseCase,
+		exportAuditLogsUseCase,
+		getResourceHistoryUseCase,
+		verifyAuditChainUseCase,
+		validator,
+		logger,
+	)
+
+	sessionHandler := handlers.NewSessionHandler(
+		listSessionsUseCase,
+		revokeSessionUseCase,
+		revokeAllSessionsUseCase,
+		validator,
+		logger,
+	)
+
+	meHandler := handlers.NewMeHandler(
+		getUserUseCase,
+		updateProfileUseCase,
+		updatePreferencesUseCase,
+		changePasswordUseCase,
+		sendPhoneVerificationUseCase,
+		verifyPhoneUseCase,
+		validator,
+		logger,
+	)
+
+	passwordResetHandler := handlers.NewPasswordResetHandler(
+		forgotPasswordUseCase,
+		resetPasswordUseCase,
+		validator,
+		logger,
+	)
+
+	emailVerificationHandler := handlers.NewEmailVerificationHandler(
+		verifyEmailUseCase,
+		resendVerificationEmailUseCase,
+		validator,
+		logger,
+	)
+
+	mfaHandler := handlers.NewMFAHandler(
+		getMFAStatusUseCase,
+		enrollTOTPUseCase,
+		confirmTOTPUseCase,
+		regenerateRecoveryCodesUseCase,
+		disableMFAUseCase,
 		validator,
 		logger,
 	)
@@ -108,25 +408,39 @@ func NewContainer() (*Container, error) {
 		loginUseCase,
 		logoutUseCase,
 		refreshTokenUseCase,
+		getJWKSUseCase,
 		validator,
 		logger,
 	)
 
 	// Create middleware
-	authMiddleware := middleware.NewAuthMiddleware(jwtService, logger)
+	authMiddleware := middleware.NewAuthMiddleware(jwtService, tokenRevoker, authorizationService, logger)
 
 	// Create HTTP server
-	httpServer := http.NewServer(cfg, logger, userHandler, authHandler, authMiddleware)
+	httpServer, err := http.NewServer(cfg, logger, userHandler, roleHandler, permissionHandler, departmentHandler, auditLogHandler, sessionHandler, meHandler, passwordResetHandler, emailVerificationHandler, mfaHandler, authHandler, authMiddleware)
+	if err != nil {
+		return nil, err
+	}
 
 	return &Container{
-		Config:         cfg,
-		Logger:         logger,
-		Database:       oracleDB,
-		Migrator:       migrator,
-		UserHandler:    userHandler,
-		AuthHandler:    authHandler,
-		AuthMiddleware: authMiddleware,
-		HTTPServer:     httpServer,
+		Config:                   cfg,
+		Logger:                   logger,
+		Database:                 oracleDB,
+		Migrator:                 migrator,
+		UserHandler:              userHandler,
+		RoleHandler:              roleHandler,
+		PermissionHandler:        permissionHandler,
+		DepartmentHandler:        departmentHandler,
+		AuditLogHandler:          auditLogHandler,
+		SessionHandler:           sessionHandler,
+		MeHandler:                meHandler,
+		PasswordResetHandler:     passwordResetHandler,
+		EmailVerificationHandler: emailVerificationHandler,
+		MFAHandler:               mfaHandler,
+		AuthHandler:              authHandler,
+		AuthMiddleware:           authMiddleware,
+		MailOutbox:               mailOutbox,
+		HTTPServer:               httpServer,
 	}, nil
 }
 
@@ -138,17 +452,103 @@ var WireSet = wire.NewSet(
 	database.NewGORMMigrator,
 	oracle.NewUserRepository,
 	oracle.NewRefreshTokenRepository,
+	oracle.NewPasswordResetTokenRepository,
+	oracle.NewPhoneVerificationRepository,
+	oracle.NewUserMFARepository,
+	oracle.NewMFARecoveryCodeRepository,
+	oracle.NewPasswordHistoryRepository,
+	oracle.NewRoleRepository,
+	oracle.NewPermissionRepository,
+	oracle.NewDepartmentRepository,
+	oracle.NewAuditLogRepository,
+	oracle.NewTransactor,
 	services.NewUserService,
+	services.NewRoleService,
+	services.NewDepartmentService,
+	services.NewAuthorizationService,
+	services.NewPolicyEngine,
+	services.NewAuditChainService,
+	services.NewAuditRecorder,
 	services.NewPasswordService,
+	services.NewPasswordPolicy,
 	services.NewJWTService,
+	services.NewMemoryTokenRevocationStore,
+	services.NewTokenRevoker,
+	services.NewMemoryLoginAttemptStore,
+	services.NewLoginThrottle,
+	services.NewEmailVerificationService,
+	services.NewThrottle,
+	services.NewOTPService,
+	services.NewTOTPService,
+	services.NewMFAService,
 	user.NewCreateUserUseCase,
 	user.NewGetUserUseCase,
 	user.NewUpdateUserUseCase,
 	user.NewDeleteUserUseCase,
+	user.NewListUsersUseCase,
+	user.NewAssignRoleUseCase,
+	user.NewUpdateOrganizationUseCase,
+	user.NewUpdateUserStatusUseCase,
+	user.NewUnlockUserUseCase,
+	user.NewListSessionsUseCase,
+	user.NewRevokeSessionUseCase,
+	user.NewRevokeAllSessionsUseCase,
+	user.NewUpdateProfileUseCase,
+	user.NewUpdatePreferencesUseCase,
+	user.NewChangePasswordUseCase,
+	user.NewSendPhoneVerificationUseCase,
+	user.NewVerifyPhoneUseCase,
+	user.NewGetMFAStatusUseCase,
+	user.NewEnrollTOTPUseCase,
+	user.NewConfirmTOTPUseCase,
+	user.NewRegenerateRecoveryCodesUseCase,
+	user.NewDisableMFAUseCase,
+	user.NewGetReportsUseCase,
+	user.NewGetManagerChainUseCase,
+	user.NewGetOrgChartUseCase,
+	role.NewCreateRoleUseCase,
+	role.NewGetRoleUseCase,
+	role.NewUpdateRoleUseCase,
+	role.NewDeleteRoleUseCase,
+	role.NewListRolesUseCase,
+	role.NewSetRolePermissionsUseCase,
+	role.NewRevokeRolePermissionUseCase,
+	role.NewGetEffectivePermissionsUseCase,
+	permission.NewCreatePermissionUseCase,
+	permission.NewGetPermissionUseCase,
+	permission.NewUpdatePermissionUseCase,
+	permission.NewDeletePermissionUseCase,
+	permission.NewListPermissionsUseCase,
+	department.NewCreateDepartmentUseCase,
+	department.NewGetDepartmentUseCase,
+	department.NewUpdateDepartmentUseCase,
+	department.NewDeleteDepartmentUseCase,
+	department.NewListDepartmentsUseCase,
+	department.NewListDepartmentChildrenUseCase,
+	department.NewGetDepartmentSubtreeUseCase,
+	department.NewMoveDepartmentUseCase,
+	audit.NewListAuditLogsUseCase,
+	audit.NewExportAuditLogsUseCase,
+	audit.NewGetResourceHistoryUseCase,
+	audit.NewVerifyAuditChainUseCase,
 	auth.NewLoginUseCase,
 	auth.NewLogoutUseCase,
 	auth.NewRefreshTokenUseCase,
+	auth.NewGetJWKSUseCase,
+	auth.NewForgotPasswordUseCase,
+	auth.NewResetPasswordUseCase,
+	auth.NewVerifyEmailUseCase,
+	auth.NewResendVerificationEmailUseCase,
 	handlers.NewUserHandler,
+	handlers.NewRoleHandler,
+	handlers.NewPermissionHandler,
+	handlers.NewDepartmentHandler,
+	handlers.NewAuditLogHandler,
+	handlers.NewSessionHandler,
+	handlers.NewMeHandler,
+	handlers.NewPasswordResetHandler,
+	handlers.NewEmailVerificationHandler,
+	handlers.NewMFAHandler,
 	handlers.NewAuthHandler,
 	middleware.NewAuthMiddleware,
 	http.NewServer,
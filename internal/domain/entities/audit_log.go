@@ -20,6 +20,37 @@ type AuditLog struct {
 	UserAgent  string     `json:"user_agent" gorm:"column:USER_AGENT;size:500"`                           // Maps to BMSF_AUDIT_LOG.USER_AGENT
 	SessionID  string     `json:"session_id" gorm:"column:SESSION_ID;size:100"`                           // Maps to BMSF_AUDIT_LOG.SESSION_ID
 	Timestamp  time.Time  `json:"timestamp" gorm:"column:TIMESTAMP;autoCreateTime;not null"`              // Maps to BMSF_AUDIT_LOG.TIMESTAMP
+
+	// Tamper-evident hash chain, one chain per tenant; rows written before chaining have no sequence
+	ChainSequence *int64 `json:"chain_sequence,omitempty" gorm:"column:CHAIN_SEQUENCE;index"` // Maps to BMSF_AUDIT_LOG.CHAIN_SEQUENCE
+	PrevHash      string `json:"prev_hash,omitempty" gorm:"column:PREV_HASH;size:64"`         // Maps to BMSF_AUDIT_LOG.PREV_HASH (hex SHA-256)
+	RowHash       string `json:"row_hash,omitempty" gorm:"column:ROW_HASH;size:64"`           // Maps to BMSF_AUDIT_LOG.ROW_HASH (hex SHA-256)
+}
+
+// AuditChainDefaultKey identifies the hash chain of records without a tenant
+const AuditChainDefaultKey = "default"
+
+// AuditChainKey returns the key of the hash chain a tenant's audit records belong to
+func AuditChainKey(tenantID *uuid.UUID) string {
+	if tenantID == nil {
+		return AuditChainDefaultKey
+	}
+	return tenantID.String()
+}
+
+// AuditChainHead tracks the last record of a tenant's audit hash chain
+// Maps to BMSF_AUDIT_CHAIN_HEAD table in Oracle database
+type AuditChainHead struct {
+	ChainKey       string     `json:"chain_key" gorm:"column:CHAIN_KEY;size:36;primaryKey"`                         // Maps to BMSF_AUDIT_CHAIN_HEAD.CHAIN_KEY
+	LastSequence   int64      `json:"last_sequence" gorm:"column:LAST_SEQUENCE;default:0;not null"`                 // Maps to BMSF_AUDIT_CHAIN_HEAD.LAST_SEQUENCE
+	LastAuditLogID *uuid.UUID `json:"last_audit_log_id,omitempty" gorm:"column:LAST_AUDIT_LOG_ID;type:varchar(36)"` // Maps to BMSF_AUDIT_CHAIN_HEAD.LAST_AUDIT_LOG_ID
+	LastHash       string     `json:"last_hash,omitempty" gorm:"column:LAST_HASH;size:64"`                          // Maps to BMSF_AUDIT_CHAIN_HEAD.LAST_HASH
+	UpdatedAt      time.Time  `json:"updated_at" gorm:"column:UPDATED_AT;autoUpdateTime"`                           // Maps to BMSF_AUDIT_CHAIN_HEAD.UPDATED_AT
+}
+
+// TableName maps the entity to BMSF_AUDIT_CHAIN_HEAD, the table the audit repository locks
+func (AuditChainHead) TableName() string {
+	return "BMSF_AUDIT_CHAIN_HEAD"
 }
 
 // NewAuditLog creates a new audit log entity
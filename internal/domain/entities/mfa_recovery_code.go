@@ -0,0 +1,35 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MFARecoveryCode represents a single-use code that stands in for an authenticator code
+// Maps to BMSF_MFA_RECOVERY_CODE table in Oracle database; only the HMAC of the code is stored.
+type MFARecoveryCode struct {
+	BaseEntity
+	UserID   uuid.UUID  `json:"user_id" gorm:"column:USER_ID;type:varchar(36);not null;index"` // Maps to BMSF_MFA_RECOVERY_CODE.USER_ID
+	CodeHash string     `json:"-" gorm:"column:CODE_HASH;size:64;not null;index"`              // Maps to BMSF_MFA_RECOVERY_CODE.CODE_HASH (HMAC-SHA256 hex digest)
+	UsedAt   *time.Time `json:"used_at,omitempty" gorm:"column:USED_AT"`                       // Maps to BMSF_MFA_RECOVERY_CODE.USED_AT
+}
+
+// TableName maps the entity to BMSF_MFA_RECOVERY_CODE
+func (MFARecoveryCode) TableName() string {
+	return "BMSF_MFA_RECOVERY_CODE"
+}
+
+// NewMFARecoveryCode creates a new unused recovery code entity from the code digest
+func NewMFARecoveryCode(userID uuid.UUID, codeHash string) *MFARecoveryCode {
+	return &MFARecoveryCode{
+		BaseEntity: NewBaseEntity(),
+		UserID:     userID,
+		CodeHash:   codeHash,
+	}
+}
+
+// IsUsed checks if the recovery code has been used
+func (c *MFARecoveryCode) IsUsed() bool {
+	return c.UsedAt != nil
+}
@@ -0,0 +1,31 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+)
+
+// PasswordHistory represents a password a user had before, kept to prevent its reuse
+// Maps to BMSF_PASSWORD_HISTORY table in Oracle database
+type PasswordHistory struct {
+	BaseEntity
+	UserID       uuid.UUID `json:"user_id" gorm:"column:USER_ID;type:varchar(36);not null;index"` // Maps to BMSF_PASSWORD_HISTORY.USER_ID
+	PasswordHash string    `json:"-" gorm:"column:PASSWORD_HASH;size:255;not null"`               // Maps to BMSF_PASSWORD_HISTORY.PASSWORD_HASH
+	Salt         string    `json:"-" gorm:"column:SALT;size:32"`                                  // Maps to BMSF_PASSWORD_HISTORY.SALT (legacy SHA-256 hashes only)
+}
+
+// TableName maps the entity to BMSF_PASSWORD_HISTORY
+func (PasswordHistory) TableName() string {
+	return "BMSF_PASSWORD_HISTORY"
+}
+
+// NewPasswordHistory creates a new password history entry from a replaced password hash
+func NewPasswordHistory(userID uuid.UUID, passwordHash, salt string, createdBy *uuid.UUID) *PasswordHistory {
+	entry := &PasswordHistory{
+		BaseEntity:   NewBaseEntity(),
+		UserID:       userID,
+		PasswordHash: passwordHash,
+		Salt:         salt,
+	}
+	entry.CreatedBy = createdBy
+	return entry
+}
@@ -0,0 +1,66 @@
+package entities
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PasswordResetToken represents a single-use password reset token in the domain
+// Maps to BMSF_PASSWORD_RESET_TOKEN table in Oracle database; only the SHA-256 digest of the token is stored.
+type PasswordResetToken struct {
+	BaseEntity
+	UserID    uuid.UUID  `json:"user_id" gorm:"column:USER_ID;type:varchar(36);not null;index"` // Maps to BMSF_PASSWORD_RESET_TOKEN.USER_ID
+	TokenHash string     `json:"-" gorm:"column:TOKEN;size:64;not null;uniqueIndex"`            // Maps to BMSF_PASSWORD_RESET_TOKEN.TOKEN (SHA-256 hex digest)
+	ExpiresAt time.Time  `json:"expires_at" gorm:"column:EXPIRES_AT;not null;index"`            // Maps to BMSF_PASSWORD_RESET_TOKEN.EXPIRES_AT
+	UsedAt    *time.Time `json:"used_at,omitempty" gorm:"column:USED_AT"`                       // Maps to BMSF_PASSWORD_RESET_TOKEN.USED_AT
+	IPAddress string     `json:"ip_address" gorm:"column:IP_ADDRESS;size:45"`                   // Maps to BMSF_PASSWORD_RESET_TOKEN.IP_ADDRESS
+	UserAgent string     `json:"user_agent" gorm:"column:USER_AGENT;size:500"`                  // Maps to BMSF_PASSWORD_RESET_TOKEN.USER_AGENT
+}
+
+// TableName maps the entity to BMSF_PASSWORD_RESET_TOKEN, the table the reset token repository queries
+func (PasswordResetToken) TableName() string {
+	return "BMSF_PASSWORD_RESET_TOKEN"
+}
+
+// NewPasswordResetToken creates a new password reset token entity
+func NewPasswordResetToken(userID uuid.UUID, token string, expiresAt time.Time, ipAddress, userAgent string) *PasswordResetToken {
+	return &PasswordResetToken{
+		BaseEntity: NewBaseEntity(),
+		UserID:     userID,
+		TokenHash:  HashPasswordResetToken(token),
+		ExpiresAt:  expiresAt,
+		IPAddress:  ipAddress,
+		UserAgent:  userAgent,
+	}
+}
+
+// HashPasswordResetToken returns the hex-encoded SHA-256 digest stored in place of the raw reset token
+func HashPasswordResetToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// MarkUsed consumes the password reset token
+func (t *PasswordResetToken) MarkUsed(updatedBy *uuid.UUID) {
+	now := time.Now()
+	t.UsedAt = &now
+	t.UpdateVersion(updatedBy)
+}
+
+// IsUsed checks if the password reset token has been consumed
+func (t *PasswordResetToken) IsUsed() bool {
+	return t.UsedAt != nil
+}
+
+// IsExpired checks if the password reset token is expired
+func (t *PasswordResetToken) IsExpired() bool {
+	return time.Now().After(t.ExpiresAt)
+}
+
+// IsValid checks if the password reset token can still be used (not consumed and not expired)
+func (t *PasswordResetToken) IsValid() bool {
+	return !t.IsUsed() && !t.IsExpired()
+}
@@ -1,6 +1,45 @@
 package entities
 
-import "github.com/google/uuid"
+import (
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// PermissionWildcard grants every permission, or every action when used as "resource:*"
+const PermissionWildcard = "*"
+
+// Built-in permission codes (resource:action)
+const (
+	PermissionUsersCreate = "users:create"
+	PermissionUsersRead   = "users:read"
+	PermissionUsersUpdate = "users:update"
+	PermissionUsersDelete = "users:delete"
+	PermissionUsersUnlock = "users:unlock"
+
+	// Department-scoped user permissions, granted for users in the holder's department subtree
+	PermissionUsersReadDepartment   = "users:read_department"
+	PermissionUsersUpdateDepartment = "users:update_department"
+	PermissionUsersDeleteDepartment = "users:delete_department"
+
+	PermissionRolesCreate = "roles:create"
+	PermissionRolesRead   = "roles:read"
+	PermissionRolesUpdate = "roles:update"
+	PermissionRolesDelete = "roles:delete"
+	PermissionRolesAssign = "roles:assign"
+
+	PermissionPermissionsCreate = "permissions:create"
+	PermissionPermissionsRead   = "permissions:read"
+	PermissionPermissionsUpdate = "permissions:update"
+	PermissionPermissionsDelete = "permissions:delete"
+
+	PermissionDepartmentsCreate = "departments:create"
+	PermissionDepartmentsRead   = "departments:read"
+	PermissionDepartmentsUpdate = "departments:update"
+	PermissionDepartmentsDelete = "departments:delete"
+
+	PermissionAuditLogsRead = "audit_logs:read"
+)
 
 // Permission represents a permission entity in the domain
 // Maps to BMSF_PERMISSION table in Oracle database
@@ -51,3 +90,19 @@ func (p *Permission) Deactivate(updatedBy *uuid.UUID) {
 func (p *Permission) GetFullCode() string {
 	return p.Resource + ":" + p.Action
 }
+
+// PermissionMatches checks if a granted permission code covers the requested code
+// A granted "*" matches everything and "resource:*" matches every action on the resource.
+func PermissionMatches(granted, code string) bool {
+	if granted == code || granted == PermissionWildcard {
+		return true
+	}
+	resource, _, _ := strings.Cut(code, ":")
+	return granted == resource+":"+PermissionWildcard
+}
+
+// PermissionGrantingCodes returns the granted codes that cover the requested code
+func PermissionGrantingCodes(code string) []string {
+	resource, _, _ := strings.Cut(code, ":")
+	return []string{code, resource + ":" + PermissionWildcard, PermissionWildcard}
+}
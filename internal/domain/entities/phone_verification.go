@@ -0,0 +1,57 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PhoneVerification represents a one-time code sent by SMS to verify a user's phone number
+// Maps to BMSF_PHONE_VERIFICATION table in Oracle database; only the HMAC of the code is stored.
+type PhoneVerification struct {
+	BaseEntity
+	UserID     uuid.UUID  `json:"user_id" gorm:"column:USER_ID;type:varchar(36);not null;index"` // Maps to BMSF_PHONE_VERIFICATION.USER_ID
+	Phone      string     `json:"phone" gorm:"column:PHONE;size:20;not null"`                    // Maps to BMSF_PHONE_VERIFICATION.PHONE (number the code was sent to)
+	CodeHash   string     `json:"-" gorm:"column:CODE_HASH;size:64;not null"`                    // Maps to BMSF_PHONE_VERIFICATION.CODE_HASH (HMAC-SHA256 hex digest)
+	ExpiresAt  time.Time  `json:"expires_at" gorm:"column:EXPIRES_AT;not null;index"`            // Maps to BMSF_PHONE_VERIFICATION.EXPIRES_AT
+	Attempts   int        `json:"attempts" gorm:"column:ATTEMPTS;default:0;not null"`            // Maps to BMSF_PHONE_VERIFICATION.ATTEMPTS
+	ConsumedAt *time.Time `json:"consumed_at,omitempty" gorm:"column:CONSUMED_AT"`               // Maps to BMSF_PHONE_VERIFICATION.CONSUMED_AT
+}
+
+// TableName maps the entity to BMSF_PHONE_VERIFICATION
+func (PhoneVerification) TableName() string {
+	return "BMSF_PHONE_VERIFICATION"
+}
+
+// NewPhoneVerification creates a new phone verification entity; the code hash is set by the caller
+// since it is keyed by the verification ID
+func NewPhoneVerification(userID uuid.UUID, phone string, expiresAt time.Time) *PhoneVerification {
+	return &PhoneVerification{
+		BaseEntity: NewBaseEntity(),
+		UserID:     userID,
+		Phone:      phone,
+		ExpiresAt:  expiresAt,
+	}
+}
+
+// MarkConsumed uses up the phone verification
+func (v *PhoneVerification) MarkConsumed(updatedBy *uuid.UUID) {
+	now := time.Now()
+	v.ConsumedAt = &now
+	v.UpdateVersion(updatedBy)
+}
+
+// IsConsumed checks if the phone verification has been used up
+func (v *PhoneVerification) IsConsumed() bool {
+	return v.ConsumedAt != nil
+}
+
+// IsExpired checks if the phone verification is expired
+func (v *PhoneVerification) IsExpired() bool {
+	return time.Now().After(v.ExpiresAt)
+}
+
+// IsValid checks if the code can still be entered (not consumed and not expired)
+func (v *PhoneVerification) IsValid() bool {
+	return !v.IsConsumed() && !v.IsExpired()
+}
@@ -1,17 +1,23 @@
 package entities
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+// RefreshTokenHashLength is the length of the hex-encoded SHA-256 digest stored in BMSF_REFRESH_TOKEN.TOKEN
+const RefreshTokenHashLength = sha256.Size * 2
+
 // RefreshToken represents a refresh token entity in the domain
 // Maps to BMSF_REFRESH_TOKEN table in Oracle database
 type RefreshToken struct {
 	BaseEntity
 	UserID    uuid.UUID  `json:"user_id" gorm:"column:USER_ID;type:varchar(36);not null;index"` // Maps to BMSF_REFRESH_TOKEN.USER_ID
-	Token     string     `json:"token" gorm:"column:TOKEN;size:500;not null;uniqueIndex"`       // Maps to BMSF_REFRESH_TOKEN.TOKEN
+	FamilyID  uuid.UUID  `json:"family_id" gorm:"column:FAMILY_ID;type:varchar(36);index"`      // Maps to BMSF_REFRESH_TOKEN.FAMILY_ID (shared across rotations)
+	TokenHash string     `json:"-" gorm:"column:TOKEN;size:64;not null;uniqueIndex"`            // Maps to BMSF_REFRESH_TOKEN.TOKEN (SHA-256 hex digest)
 	ExpiresAt time.Time  `json:"expires_at" gorm:"column:EXPIRES_AT;not null;index"`            // Maps to BMSF_REFRESH_TOKEN.EXPIRES_AT
 	IsRevoked bool       `json:"is_revoked" gorm:"column:IS_REVOKED;default:false;not null"`    // Maps to BMSF_REFRESH_TOKEN.IS_REVOKED
 	RevokedAt *time.Time `json:"revoked_at,omitempty" gorm:"column:REVOKED_AT"`                 // Maps to BMSF_REFRESH_TOKEN.REVOKED_AT
@@ -19,20 +25,42 @@ type RefreshToken struct {
 	UserAgent string     `json:"user_agent" gorm:"column:USER_AGENT;size:500"`                  // Maps to BMSF_REFRESH_TOKEN.USER_AGENT
 }
 
-// NewRefreshToken creates a new refresh token entity
+// NewRefreshToken creates a new refresh token entity starting a new token family
 func NewRefreshToken(userID uuid.UUID, token string, expiresAt time.Time, ipAddress, userAgent string) *RefreshToken {
 	refreshToken := &RefreshToken{
 		BaseEntity: NewBaseEntity(),
 		UserID:     userID,
-		Token:      token,
+		TokenHash:  HashRefreshToken(token),
 		ExpiresAt:  expiresAt,
 		IsRevoked:  false,
 		IPAddress:  ipAddress,
 		UserAgent:  userAgent,
 	}
+	refreshToken.FamilyID = refreshToken.ID
 	return refreshToken
 }
 
+// HashRefreshToken returns the hex-encoded SHA-256 digest stored in place of the raw refresh token
+func HashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// Rotate creates the successor of the refresh token in the same family, keeping its expiry
+func (rt *RefreshToken) Rotate(token, ipAddress, userAgent string) *RefreshToken {
+	successor := NewRefreshToken(rt.UserID, token, rt.ExpiresAt, ipAddress, userAgent)
+	successor.FamilyID = rt.Family()
+	return successor
+}
+
+// Family returns the token family, tokens issued before families were tracked form their own
+func (rt *RefreshToken) Family() uuid.UUID {
+	if rt.FamilyID == uuid.Nil {
+		return rt.ID
+	}
+	return rt.FamilyID
+}
+
 // Revoke revokes the refresh token
 func (rt *RefreshToken) Revoke(revokedBy *uuid.UUID) {
 	now := time.Now()
@@ -2,28 +2,39 @@ package entities
 
 import "github.com/google/uuid"
 
+// System role codes
+const (
+	RoleCodeSuperAdmin = "SUPER_ADMIN"
+)
+
 // Role represents a role entity in the domain
 // Maps to BMSF_ROLE table in Oracle database
 type Role struct {
 	BaseEntity
-	Name        string `json:"name" gorm:"column:NAME;size:100;not null"`                // Maps to BMSF_ROLE.NAME
-	Code        string `json:"code" gorm:"column:CODE;size:50;uniqueIndex;not null"`     // Maps to BMSF_ROLE.CODE
-	Description string `json:"description" gorm:"column:DESCRIPTION;size:500"`           // Maps to BMSF_ROLE.DESCRIPTION
-	Permissions string `json:"permissions" gorm:"column:PERMISSIONS;type:CLOB"`          // Maps to BMSF_ROLE.PERMISSIONS (JSON)
-	IsActive    bool   `json:"is_active" gorm:"column:IS_ACTIVE;default:true;not null"`  // Maps to BMSF_ROLE.IS_ACTIVE
-	IsSystem    bool   `json:"is_system" gorm:"column:IS_SYSTEM;default:false;not null"` // Maps to BMSF_ROLE.IS_SYSTEM
+	Name        string     `json:"name" gorm:"column:NAME;size:100;not null"`                          // Maps to BMSF_ROLE.NAME
+	Code        string     `json:"code" gorm:"column:CODE;size:50;uniqueIndex;not null"`               // Maps to BMSF_ROLE.CODE
+	Description string     `json:"description" gorm:"column:DESCRIPTION;size:500"`                     // Maps to BMSF_ROLE.DESCRIPTION
+	IsActive    bool       `json:"is_active" gorm:"column:IS_ACTIVE;default:true;not null"`            // Maps to BMSF_ROLE.IS_ACTIVE
+	IsSystem    bool       `json:"is_system" gorm:"column:IS_SYSTEM;default:false;not null"`           // Maps to BMSF_ROLE.IS_SYSTEM
+	ParentID    *uuid.UUID `json:"parent_id,omitempty" gorm:"column:PARENT_ID;type:varchar(36);index"` // Maps to BMSF_ROLE.PARENT_ID (FK BMSF_ROLE.ID)
+
+	// PermissionCodes are the codes granted through BMSF_ROLE_PERMISSION, loaded by the repository
+	PermissionCodes []string `json:"permissions" gorm:"-"`
 }
 
 // NewRole creates a new role entity
-func NewRole(name, code, description, permissions string, isSystem bool) *Role {
+func NewRole(name, code, description string, permissionCodes []string, isSystem bool) *Role {
+	if permissionCodes == nil {
+		permissionCodes = []string{}
+	}
 	role := &Role{
-		BaseEntity:  NewBaseEntity(),
-		Name:        name,
-		Code:        code,
-		Description: description,
-		Permissions: permissions,
-		IsActive:    true,
-		IsSystem:    isSystem,
+		BaseEntity:      NewBaseEntity(),
+		Name:            name,
+		Code:            code,
+		Description:     description,
+		PermissionCodes: permissionCodes,
+		IsActive:        true,
+		IsSystem:        isSystem,
 	}
 	return role
 }
@@ -35,9 +46,18 @@ func (r *Role) UpdateInfo(name, description string, updatedBy *uuid.UUID) {
 	r.UpdateVersion(updatedBy)
 }
 
-// UpdatePermissions updates role permissions
-func (r *Role) UpdatePermissions(permissions string, updatedBy *uuid.UUID) {
-	r.Permissions = permissions
+// UpdatePermissions replaces the permission codes granted by the role
+func (r *Role) UpdatePermissions(permissionCodes []string, updatedBy *uuid.UUID) {
+	if permissionCodes == nil {
+		permissionCodes = []string{}
+	}
+	r.PermissionCodes = permissionCodes
+	r.UpdateVersion(updatedBy)
+}
+
+// SetParent sets the role this role inherits permissions from
+func (r *Role) SetParent(parentID *uuid.UUID, updatedBy *uuid.UUID) {
+	r.ParentID = parentID
 	r.UpdateVersion(updatedBy)
 }
 
@@ -57,3 +77,13 @@ func (r *Role) Deactivate(updatedBy *uuid.UUID) {
 func (r *Role) IsSystemRole() bool {
 	return r.IsSystem
 }
+
+// HasPermission checks if the role grants a permission code
+func (r *Role) HasPermission(code string) bool {
+	for _, granted := range r.PermissionCodes {
+		if PermissionMatches(granted, code) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,31 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RolePermission represents a permission granted to a role
+// Maps to BMSF_ROLE_PERMISSION table in Oracle database
+type RolePermission struct {
+	RoleID       uuid.UUID  `json:"role_id" gorm:"column:ROLE_ID;type:varchar(36);primaryKey"`                   // Maps to BMSF_ROLE_PERMISSION.ROLE_ID (FK BMSF_ROLE.ID)
+	PermissionID uuid.UUID  `json:"permission_id" gorm:"column:PERMISSION_ID;type:varchar(36);primaryKey;index"` // Maps to BMSF_ROLE_PERMISSION.PERMISSION_ID (FK BMSF_PERMISSION.ID)
+	CreatedAt    time.Time  `json:"created_at" gorm:"column:CREATED_AT;autoCreateTime"`                          // Maps to BMSF_ROLE_PERMISSION.CREATED_AT
+	CreatedBy    *uuid.UUID `json:"created_by,omitempty" gorm:"column:CREATED_BY;type:varchar(36)"`              // Maps to BMSF_ROLE_PERMISSION.CREATED_BY
+}
+
+// TableName keeps the join table at BMSF_ROLE_PERMISSION rather than the strategy's BMSF_ROLEPERMISSION
+func (RolePermission) TableName() string {
+	return "BMSF_ROLE_PERMISSION"
+}
+
+// NewRolePermission creates a new role permission grant
+func NewRolePermission(roleID, permissionID uuid.UUID, createdBy *uuid.UUID) *RolePermission {
+	return &RolePermission{
+		RoleID:       roleID,
+		PermissionID: permissionID,
+		CreatedAt:    time.Now(),
+		CreatedBy:    createdBy,
+	}
+}
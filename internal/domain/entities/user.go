@@ -1,6 +1,7 @@
 package entities
 
 import (
+	"math"
 	"time"
 
 	"github.com/google/uuid"
@@ -25,6 +26,8 @@ type User struct {
 	LoginAttempts int        `json:"login_attempts" gorm:"column:LOGIN_ATTEMPTS;default:0;not null"` // Maps to BMSF_USER.LOGIN_ATTEMPTS
 	LockedUntil   *time.Time `json:"locked_until,omitempty" gorm:"column:LOCKED_UNTIL"`              // Maps to BMSF_USER.LOCKED_UNTIL
 
+	PasswordChangedAt *time.Time `json:"password_changed_at,omitempty" gorm:"column:PASSWORD_CHANGED_AT"` // Maps to BMSF_USER.PASSWORD_CHANGED_AT
+
 	// Profile Enhancement
 	Avatar      string     `json:"avatar" gorm:"column:AVATAR;size:500"`                // Maps to BMSF_USER.AVATAR
 	DateOfBirth *time.Time `json:"date_of_birth,omitempty" gorm:"column:DATE_OF_BIRTH"` // Maps to BMSF_USER.DATE_OF_BIRTH
@@ -69,6 +72,7 @@ func (s UserStatus) IsValid() bool {
 
 // NewUser creates a new user entity
 func NewUser(username, email, firstName, lastName, phone, passwordHash, salt string) *User {
+	now := time.Now()
 	user := &User{
 		BaseEntity: NewBaseEntity(),
 		// Basic Information
@@ -79,9 +83,10 @@ func NewUser(username, email, firstName, lastName, phone, passwordHash, salt str
 		Phone:     phone,
 		Status:    UserStatusPending,
 		// Security Fields
-		PasswordHash:  passwordHash,
-		Salt:          salt,
-		LoginAttempts: 0,
+		PasswordHash:      passwordHash,
+		Salt:              salt,
+		LoginAttempts:     0,
+		PasswordChangedAt: &now,
 		// Notification & Preferences
 		EmailVerified:    false,
 		PhoneVerified:    false,
@@ -120,10 +125,13 @@ func (u *User) Block(updatedBy *uuid.UUID) {
 	u.UpdateVersion(updatedBy)
 }
 
-// UpdateProfile updates user profile information
+// UpdateProfile updates user profile information; a new phone number has to be verified again
 func (u *User) UpdateProfile(firstName, lastName, phone string, updatedBy *uuid.UUID) {
 	u.FirstName = firstName
 	u.LastName = lastName
+	if phone != u.Phone {
+		u.PhoneVerified = false
+	}
 	u.Phone = phone
 	u.UpdateVersion(updatedBy)
 }
@@ -157,12 +165,29 @@ func (u *User) UpdatePreferences(language, timezone, notificationPref string, up
 }
 
 // SetPassword updates user password
+// It only replaces the stored hash, e.g. when upgrading it; a new password is set with ChangePassword.
 func (u *User) SetPassword(passwordHash, salt string, updatedBy *uuid.UUID) {
 	u.PasswordHash = passwordHash
 	u.Salt = salt
 	u.UpdateVersion(updatedBy)
 }
 
+// ChangePassword sets a new password, restarting its age
+func (u *User) ChangePassword(passwordHash, salt string, updatedBy *uuid.UUID) {
+	now := time.Now()
+	u.PasswordChangedAt = &now
+	u.SetPassword(passwordHash, salt, updatedBy)
+}
+
+// PasswordAge returns how long ago the password was set
+// Passwords of accounts from before PASSWORD_CHANGED_AT was recorded count from the account's creation.
+func (u *User) PasswordAge(now time.Time) time.Duration {
+	if u.PasswordChangedAt == nil {
+		return now.Sub(u.CreatedAt)
+	}
+	return now.Sub(*u.PasswordChangedAt)
+}
+
 // RecordLogin records successful login
 func (u *User) RecordLogin(updatedBy *uuid.UUID) {
 	now := time.Now()
@@ -172,12 +197,48 @@ func (u *User) RecordLogin(updatedBy *uuid.UUID) {
 	u.UpdateVersion(updatedBy)
 }
 
-// RecordFailedLogin records failed login attempt
-func (u *User) RecordFailedLogin(updatedBy *uuid.UUID) {
+// LockoutPolicy decides when failed logins lock an account and for how long
+// Every MaxAttempts consecutive failures lock the account again, each lockout twice as long as the previous one.
+type LockoutPolicy struct {
+	MaxAttempts int           // Failed logins per lockout, zero never locks
+	Duration    time.Duration // First lockout
+	MaxDuration time.Duration // Longest lockout, zero for no cap
+}
+
+// DefaultLockoutPolicy locks an account for 30 minutes after 5 failed logins
+func DefaultLockoutPolicy() LockoutPolicy {
+	return LockoutPolicy{
+		MaxAttempts: 5,
+		Duration:    30 * time.Minute,
+		MaxDuration: 24 * time.Hour,
+	}
+}
+
+// LockoutDuration returns how long the nth lockout, counting from one, lasts
+func (p LockoutPolicy) LockoutDuration(n int) time.Duration {
+	duration := p.Duration
+	for i := 1; i < n; i++ {
+		if p.MaxDuration > 0 && duration >= p.MaxDuration {
+			break
+		}
+		if duration > math.MaxInt64/2 {
+			return time.Duration(math.MaxInt64)
+		}
+		duration *= 2
+	}
+
+	if p.MaxDuration > 0 && duration > p.MaxDuration {
+		return p.MaxDuration
+	}
+	return duration
+}
+
+// RecordFailedLogin records failed login attempt, locking the account as the policy decides
+// Failures keep counting across lockouts until a successful login or an unlock, so repeated lockouts grow longer.
+func (u *User) RecordFailedLogin(policy LockoutPolicy, updatedBy *uuid.UUID) {
 	u.LoginAttempts++
-	if u.LoginAttempts >= 5 {
-		// Lock account for 30 minutes after 5 failed attempts
-		lockUntil := time.Now().Add(30 * time.Minute)
+	if policy.MaxAttempts > 0 && u.LoginAttempts%policy.MaxAttempts == 0 {
+		lockUntil := time.Now().Add(policy.LockoutDuration(u.LoginAttempts / policy.MaxAttempts))
 		u.LockedUntil = &lockUntil
 	}
 	u.UpdateVersion(updatedBy)
@@ -0,0 +1,47 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserMFA represents a user's TOTP authenticator enrollment
+// Maps to BMSF_USER_MFA table in Oracle database; the shared secret is stored encrypted.
+// An enrollment only protects logins once it has been confirmed with a code from the authenticator.
+type UserMFA struct {
+	BaseEntity
+	UserID       uuid.UUID  `json:"user_id" gorm:"column:USER_ID;type:varchar(36);not null;uniqueIndex"` // Maps to BMSF_USER_MFA.USER_ID
+	Secret       string     `json:"-" gorm:"column:SECRET;size:255;not null"`                            // Maps to BMSF_USER_MFA.SECRET (AES-GCM sealed base32 secret)
+	ConfirmedAt  *time.Time `json:"confirmed_at,omitempty" gorm:"column:CONFIRMED_AT"`                   // Maps to BMSF_USER_MFA.CONFIRMED_AT
+	LastUsedStep int64      `json:"-" gorm:"column:LAST_USED_STEP;default:0;not null"`                   // Maps to BMSF_USER_MFA.LAST_USED_STEP (TOTP time step of the last accepted code)
+}
+
+// TableName maps the entity to BMSF_USER_MFA, the table the MFA repository queries
+func (UserMFA) TableName() string {
+	return "BMSF_USER_MFA"
+}
+
+// NewUserMFA creates a new unconfirmed TOTP enrollment
+func NewUserMFA(userID uuid.UUID, sealedSecret string, createdBy *uuid.UUID) *UserMFA {
+	mfa := &UserMFA{
+		BaseEntity: NewBaseEntity(),
+		UserID:     userID,
+		Secret:     sealedSecret,
+	}
+	mfa.CreatedBy = createdBy
+	return mfa
+}
+
+// IsConfirmed checks if the enrollment has been confirmed and protects logins
+func (m *UserMFA) IsConfirmed() bool {
+	return m.ConfirmedAt != nil
+}
+
+// Confirm activates the enrollment; step is the time step of the confirming code
+func (m *UserMFA) Confirm(step int64, updatedBy *uuid.UUID) {
+	now := time.Now()
+	m.ConfirmedAt = &now
+	m.LastUsedStep = step
+	m.UpdateVersion(updatedBy)
+}
@@ -0,0 +1,74 @@
+package entities
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestLockoutPolicyLockoutDuration(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy LockoutPolicy
+		n      int
+		want   time.Duration
+	}{
+		{name: "first lockout", policy: LockoutPolicy{Duration: time.Minute, MaxDuration: time.Hour}, n: 1, want: time.Minute},
+		{name: "second lockout doubles", policy: LockoutPolicy{Duration: time.Minute, MaxDuration: time.Hour}, n: 2, want: 2 * time.Minute},
+		{name: "fourth lockout", policy: LockoutPolicy{Duration: time.Minute, MaxDuration: time.Hour}, n: 4, want: 8 * time.Minute},
+		{name: "capped at the maximum", policy: LockoutPolicy{Duration: time.Minute, MaxDuration: 5 * time.Minute}, n: 4, want: 5 * time.Minute},
+		{name: "first lockout above the maximum", policy: LockoutPolicy{Duration: time.Hour, MaxDuration: time.Minute}, n: 1, want: time.Minute},
+		{name: "many lockouts stay capped", policy: LockoutPolicy{Duration: time.Minute, MaxDuration: time.Hour}, n: 1000, want: time.Hour},
+		{name: "uncapped growth", policy: LockoutPolicy{Duration: time.Minute}, n: 11, want: 1024 * time.Minute},
+		{name: "uncapped growth saturates", policy: LockoutPolicy{Duration: time.Minute}, n: 1000, want: time.Duration(math.MaxInt64)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.LockoutDuration(tt.n); got != tt.want {
+				t.Errorf("LockoutDuration(%d) = %v, want %v", tt.n, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUserRecordFailedLogin(t *testing.T) {
+	policy := LockoutPolicy{MaxAttempts: 3, Duration: time.Minute, MaxDuration: time.Hour}
+	user := &User{}
+
+	tests := []struct {
+		attempt    int
+		wantLocked bool
+		wantFor    time.Duration
+	}{
+		{attempt: 1},
+		{attempt: 2},
+		{attempt: 3, wantLocked: true, wantFor: time.Minute},
+		{attempt: 4, wantLocked: true, wantFor: time.Minute},
+		{attempt: 5, wantLocked: true, wantFor: time.Minute},
+		{attempt: 6, wantLocked: true, wantFor: 2 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		before := time.Now()
+		user.RecordFailedLogin(policy, nil)
+
+		if user.LoginAttempts != tt.attempt {
+			t.Fatalf("attempt %d: LoginAttempts = %d", tt.attempt, user.LoginAttempts)
+		}
+		if user.IsLocked() != tt.wantLocked {
+			t.Fatalf("attempt %d: IsLocked() = %v, want %v", tt.attempt, user.IsLocked(), tt.wantLocked)
+		}
+		// Failures between lockouts keep the current lockout, the next multiple locks twice as long
+		if tt.attempt%policy.MaxAttempts == 0 {
+			if lockedFor := user.LockedUntil.Sub(before); lockedFor < tt.wantFor || lockedFor > tt.wantFor+time.Second {
+				t.Errorf("attempt %d: locked for %v, want %v", tt.attempt, lockedFor, tt.wantFor)
+			}
+		}
+	}
+
+	user.UnlockAccount(nil)
+	if user.IsLocked() || user.LoginAttempts != 0 {
+		t.Errorf("UnlockAccount() left LoginAttempts = %d, locked = %v", user.LoginAttempts, user.IsLocked())
+	}
+}
@@ -0,0 +1,60 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"bm-staff/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// AuditLogRepository defines the interface for audit log data access
+type AuditLogRepository interface {
+	// Create appends an audit log record
+	Create(ctx context.Context, auditLog *entities.AuditLog) error
+
+	// List retrieves audit log records matching the filter, ordered by (TIMESTAMP, ID)
+	List(ctx context.Context, filter *AuditLogFilter) ([]*entities.AuditLog, error)
+
+	// Count returns the total number of audit log records matching the filter (pagination is ignored)
+	Count(ctx context.Context, filter *AuditLogFilter) (int64, error)
+
+	// Stream calls fn for every audit log record matching the filter, in order, without
+	// loading the result set into memory. Iteration stops at the first error returned by fn.
+	Stream(ctx context.Context, filter *AuditLogFilter, fn func(auditLog *entities.AuditLog) error) error
+
+	// StreamChain calls fn for every chained record of a hash chain up to lastSequence in sequence order,
+	// including soft-deleted records
+	StreamChain(ctx context.Context, chainKey string, lastSequence int64, fn func(auditLog *entities.AuditLog) error) error
+
+	// LockChainHead creates the head of a hash chain if needed and locks it until the transaction ends
+	LockChainHead(ctx context.Context, chainKey string) (*entities.AuditChainHead, error)
+
+	// GetChainHead retrieves the head of a hash chain, nil when the chain has no records
+	GetChainHead(ctx context.Context, chainKey string) (*entities.AuditChainHead, error)
+
+	// UpdateChainHead stores the new head of a hash chain
+	UpdateChainHead(ctx context.Context, head *entities.AuditChainHead) error
+}
+
+// AuditLogFilter holds filtering and keyset pagination criteria for listing audit log records
+type AuditLogFilter struct {
+	UserID     *uuid.UUID
+	Resource   string
+	ResourceID *uuid.UUID
+	Action     string
+	From       *time.Time // inclusive lower bound on TIMESTAMP
+	To         *time.Time // inclusive upper bound on TIMESTAMP
+	Ascending  bool       // oldest first, newest first by default
+	Limit      int        // zero means no limit
+
+	// Keyset pagination on (TIMESTAMP, ID): rows following the cursor in sort order
+	After *AuditLogCursor
+}
+
+// AuditLogCursor marks a position in a keyset-paginated audit log listing
+type AuditLogCursor struct {
+	Timestamp time.Time
+	ID        uuid.UUID
+}
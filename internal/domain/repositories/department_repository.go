@@ -0,0 +1,45 @@
+package repositories
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// DepartmentRepository defines the interface for department data access
+type DepartmentRepository interface {
+	// Create creates a new department
+	Create(ctx context.Context, department *entities.Department) error
+
+	// GetByID retrieves a department by ID
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.Department, error)
+
+	// GetByCode retrieves a department by code
+	GetByCode(ctx context.Context, code string) (*entities.Department, error)
+
+	// Update updates an existing department
+	Update(ctx context.Context, department *entities.Department) error
+
+	// Delete deletes a department by ID
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// List retrieves departments with pagination
+	List(ctx context.Context, limit, offset int) ([]*entities.Department, error)
+
+	// Count returns the total number of departments
+	Count(ctx context.Context) (int64, error)
+
+	// GetChildren retrieves the direct child departments of a department
+	GetChildren(ctx context.Context, id uuid.UUID) ([]*entities.Department, error)
+
+	// CountChildren returns the number of direct child departments of a department
+	CountChildren(ctx context.Context, id uuid.UUID) (int64, error)
+
+	// GetSubtree retrieves a department followed by all of its descendants, depth first
+	GetSubtree(ctx context.Context, id uuid.UUID) ([]*entities.Department, error)
+
+	// GetHierarchy retrieves a department followed by its ancestors, nearest first
+	GetHierarchy(ctx context.Context, id uuid.UUID) ([]*entities.Department, error)
+}
@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"bm-staff/internal/domain/entities"
+	"context"
+)
+
+// MFARecoveryCodeRepository defines the interface for MFA recovery code repository operations
+type MFARecoveryCodeRepository interface {
+	// Create creates a new recovery code
+	Create(ctx context.Context, code *entities.MFARecoveryCode) error
+
+	// Use marks an unused recovery code of a user as used, returning false when there is none
+	Use(ctx context.Context, userID, codeHash string) (bool, error)
+
+	// CountUnused counts the recovery codes a user has left
+	CountUnused(ctx context.Context, userID string) (int, error)
+
+	// DeleteAllForUser removes every recovery code of a user
+	DeleteAllForUser(ctx context.Context, userID string) error
+}
@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"bm-staff/internal/domain/entities"
+	"context"
+)
+
+// PasswordHistoryRepository defines the interface for password history repository operations
+type PasswordHistoryRepository interface {
+	// Create creates a new password history entry
+	Create(ctx context.Context, entry *entities.PasswordHistory) error
+
+	// ListRecent lists the latest password history entries of a user, newest first
+	ListRecent(ctx context.Context, userID string, limit int) ([]*entities.PasswordHistory, error)
+
+	// DeleteAllButRecent removes the password history entries of a user except the latest keep ones
+	DeleteAllButRecent(ctx context.Context, userID string, keep int) error
+}
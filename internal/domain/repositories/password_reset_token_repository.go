@@ -0,0 +1,24 @@
+package repositories
+
+import (
+	"bm-staff/internal/domain/entities"
+	"context"
+)
+
+// PasswordResetTokenRepository defines the interface for password reset token repository operations
+type PasswordResetTokenRepository interface {
+	// Create creates a new password reset token
+	Create(ctx context.Context, resetToken *entities.PasswordResetToken) error
+
+	// GetByToken gets a password reset token by token string
+	GetByToken(ctx context.Context, token string) (*entities.PasswordResetToken, error)
+
+	// MarkUsed consumes a password reset token, failing when it has been consumed already
+	MarkUsed(ctx context.Context, resetToken *entities.PasswordResetToken) error
+
+	// InvalidateAllForUser consumes every outstanding password reset token of a user
+	InvalidateAllForUser(ctx context.Context, userID string) error
+
+	// CleanupExpired removes expired password reset tokens
+	CleanupExpired(ctx context.Context) error
+}
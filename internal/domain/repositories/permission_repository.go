@@ -0,0 +1,33 @@
+package repositories
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// PermissionRepository defines the interface for permission data access
+type PermissionRepository interface {
+	// Create creates a new permission
+	Create(ctx context.Context, permission *entities.Permission) error
+
+	// GetByID retrieves a permission by ID
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.Permission, error)
+
+	// GetByCode retrieves a permission by code
+	GetByCode(ctx context.Context, code string) (*entities.Permission, error)
+
+	// Update updates an existing permission
+	Update(ctx context.Context, permission *entities.Permission) error
+
+	// Delete deletes a permission by ID, revoking it from every role
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// List retrieves permissions with pagination
+	List(ctx context.Context, limit, offset int) ([]*entities.Permission, error)
+
+	// Count returns the total number of permissions
+	Count(ctx context.Context) (int64, error)
+}
@@ -0,0 +1,27 @@
+package repositories
+
+import (
+	"bm-staff/internal/domain/entities"
+	"context"
+)
+
+// PhoneVerificationRepository defines the interface for phone verification repository operations
+type PhoneVerificationRepository interface {
+	// Create creates a new phone verification
+	Create(ctx context.Context, verification *entities.PhoneVerification) error
+
+	// GetLatestForUser gets the most recent unconsumed phone verification of a user
+	GetLatestForUser(ctx context.Context, userID string) (*entities.PhoneVerification, error)
+
+	// ReserveAttempt counts one code entry, returning false once maxAttempts entries have been counted
+	ReserveAttempt(ctx context.Context, id string, maxAttempts int) (bool, error)
+
+	// MarkConsumed uses up a phone verification, failing when it has been consumed already
+	MarkConsumed(ctx context.Context, verification *entities.PhoneVerification) error
+
+	// InvalidateAllForUser consumes every outstanding phone verification of a user
+	InvalidateAllForUser(ctx context.Context, userID string) error
+
+	// CleanupExpired removes expired phone verifications
+	CleanupExpired(ctx context.Context) error
+}
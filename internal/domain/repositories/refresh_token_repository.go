@@ -28,6 +28,9 @@ type RefreshTokenRepository interface {
 	// RevokeAllForUser revokes all refresh tokens for a user
 	RevokeAllForUser(ctx context.Context, userID string) error
 
+	// RevokeFamily revokes every refresh token of a token family
+	RevokeFamily(ctx context.Context, familyID string) error
+
 	// CleanupExpired removes expired refresh tokens
 	CleanupExpired(ctx context.Context) error
 }
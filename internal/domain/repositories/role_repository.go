@@ -0,0 +1,42 @@
+package repositories
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// RoleRepository defines the interface for role data access
+type RoleRepository interface {
+	// Create creates a new role together with its permission grants
+	Create(ctx context.Context, role *entities.Role) error
+
+	// GetByID retrieves a role by ID
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.Role, error)
+
+	// GetByCode retrieves a role by code
+	GetByCode(ctx context.Context, code string) (*entities.Role, error)
+
+	// Update updates an existing role and replaces its permission grants
+	Update(ctx context.Context, role *entities.Role) error
+
+	// Delete deletes a role by ID
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// List retrieves roles with pagination
+	List(ctx context.Context, limit, offset int) ([]*entities.Role, error)
+
+	// Count returns the total number of roles
+	Count(ctx context.Context) (int64, error)
+
+	// ListByPermission retrieves the roles granting a permission code, directly or through a wildcard
+	ListByPermission(ctx context.Context, permissionCode string) ([]*entities.Role, error)
+
+	// GetHierarchy retrieves a role followed by its ancestors, nearest first
+	GetHierarchy(ctx context.Context, id uuid.UUID) ([]*entities.Role, error)
+
+	// CountChildren returns the number of roles inheriting directly from a role
+	CountChildren(ctx context.Context, id uuid.UUID) (int64, error)
+}
@@ -0,0 +1,10 @@
+package repositories
+
+import "context"
+
+// Transactor runs units of work spanning several repositories in one database transaction
+type Transactor interface {
+	// WithinTransaction runs fn with a context carrying the transaction, committing when fn
+	// returns nil and rolling back otherwise. Nested calls join the outer transaction.
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
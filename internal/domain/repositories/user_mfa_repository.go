@@ -0,0 +1,29 @@
+package repositories
+
+import (
+	"bm-staff/internal/domain/entities"
+	"context"
+	"errors"
+)
+
+// ErrMFANotEnrolled is returned by GetByUserID when the user has no TOTP enrollment
+var ErrMFANotEnrolled = errors.New("MFA enrollment not found")
+
+// UserMFARepository defines the interface for TOTP enrollment repository operations
+type UserMFARepository interface {
+	// Create creates a new TOTP enrollment
+	Create(ctx context.Context, mfa *entities.UserMFA) error
+
+	// GetByUserID gets the TOTP enrollment of a user, or ErrMFANotEnrolled
+	GetByUserID(ctx context.Context, userID string) (*entities.UserMFA, error)
+
+	// Update updates a TOTP enrollment
+	Update(ctx context.Context, mfa *entities.UserMFA) error
+
+	// AdvanceStep records the time step of an accepted code, returning false when that step
+	// or a later one was accepted already
+	AdvanceStep(ctx context.Context, id string, step int64) (bool, error)
+
+	// DeleteByUserID removes the TOTP enrollment of a user
+	DeleteByUserID(ctx context.Context, userID string) error
+}
@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"bm-staff/internal/domain/entities"
 
@@ -28,12 +29,79 @@ type UserRepository interface {
 	// Delete deletes a user by ID
 	Delete(ctx context.Context, id uuid.UUID) error
 
-	// List retrieves users with pagination
-	List(ctx context.Context, limit, offset int) ([]*entities.User, error)
+	// List retrieves users matching the filter with sorting and pagination
+	// When a keyset cursor is set, rows before the cursor are returned nearest-first.
+	List(ctx context.Context, filter *UserListFilter) ([]*entities.User, error)
 
-	// Count returns the total number of users
-	Count(ctx context.Context) (int64, error)
+	// Count returns the total number of users matching the filter (pagination is ignored)
+	Count(ctx context.Context, filter *UserListFilter) (int64, error)
 
 	// GetByIDs retrieves multiple users by IDs (for DataLoader)
 	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*entities.User, error)
+
+	// ListByPermission retrieves active users whose active role effectively grants an active permission code,
+	// directly, through a wildcard or inherited from an active ancestor role
+	ListByPermission(ctx context.Context, permissionCode string, limit, offset int) ([]*entities.User, error)
+
+	// ListIDsByRole retrieves the IDs of users whose role is the given role or inherits from it
+	ListIDsByRole(ctx context.Context, roleID uuid.UUID) ([]uuid.UUID, error)
+
+	// GetManagerChain retrieves the managers of a user following MANAGER_ID, nearest first
+	GetManagerChain(ctx context.Context, id uuid.UUID) ([]*entities.User, error)
+
+	// GetReports retrieves the users reporting to a manager, directly or, when transitive, at any depth
+	GetReports(ctx context.Context, managerID uuid.UUID, transitive bool) ([]*entities.User, error)
+
+	// ListOrganization retrieves every user that is not deleted, for org chart exports
+	ListOrganization(ctx context.Context) ([]*entities.User, error)
+}
+
+// UserSortField is a whitelisted field users can be sorted by
+type UserSortField string
+
+const (
+	UserSortByCreatedAt   UserSortField = "created_at"
+	UserSortByUpdatedAt   UserSortField = "updated_at"
+	UserSortByUsername    UserSortField = "username"
+	UserSortByEmail       UserSortField = "email"
+	UserSortByFirstName   UserSortField = "first_name"
+	UserSortByLastName    UserSortField = "last_name"
+	UserSortByStatus      UserSortField = "status"
+	UserSortByLastLoginAt UserSortField = "last_login_at"
+)
+
+// IsValid checks if the sort field is whitelisted
+func (f UserSortField) IsValid() bool {
+	switch f {
+	case UserSortByCreatedAt, UserSortByUpdatedAt, UserSortByUsername, UserSortByEmail,
+		UserSortByFirstName, UserSortByLastName, UserSortByStatus, UserSortByLastLoginAt:
+		return true
+	default:
+		return false
+	}
+}
+
+// UserListFilter holds filtering, sorting and pagination criteria for listing users
+type UserListFilter struct {
+	Status       *entities.UserStatus
+	DepartmentID *uuid.UUID
+	RoleID       *uuid.UUID
+	ManagerID    *uuid.UUID
+	CreatedFrom  *time.Time
+	CreatedTo    *time.Time
+	Search       string // matched against username, email and first/last name
+	SortBy       UserSortField
+	SortDesc     bool
+	Limit        int
+	Offset       int
+
+	// Keyset pagination on (CREATED_AT, ID), takes precedence over SortBy and Offset
+	After  *UserCursor // rows following the cursor in sort order
+	Before *UserCursor // rows preceding the cursor in sort order
+}
+
+// UserCursor marks a position in a keyset-paginated user listing
+type UserCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
 }
@@ -0,0 +1,171 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+
+	"github.com/google/uuid"
+)
+
+// auditTimestampLayout formats audit timestamps at the microsecond precision Oracle stores,
+// without a zone so the hash does not depend on the location the driver reads times in
+const auditTimestampLayout = "2006-01-02T15:04:05.000000"
+
+// errChainBroken stops walking a hash chain at its first broken link
+var errChainBroken = errors.New("audit chain broken")
+
+// AuditChainBreak describes the first broken link of a hash chain
+type AuditChainBreak struct {
+	Sequence   int64      `json:"sequence"`
+	AuditLogID *uuid.UUID `json:"audit_log_id,omitempty"` // nil when the record is missing
+	Reason     string     `json:"reason"`
+}
+
+// AuditChainVerification is the result of walking a hash chain
+type AuditChainVerification struct {
+	ChainKey   string           `json:"chain_key"`
+	Verified   int64            `json:"verified"` // records checked before the first broken link
+	Valid      bool             `json:"valid"`
+	BrokenLink *AuditChainBreak `json:"broken_link,omitempty"`
+}
+
+// AuditChainService links audit records into per-tenant hash chains and verifies them
+// Each record stores the SHA-256 of the previous record's hash and its own canonical content,
+// so editing, deleting or reordering records breaks every later link.
+type AuditChainService struct {
+	auditLogRepo repositories.AuditLogRepository
+}
+
+// NewAuditChainService creates a new audit chain service
+func NewAuditChainService(auditLogRepo repositories.AuditLogRepository) *AuditChainService {
+	return &AuditChainService{
+		auditLogRepo: auditLogRepo,
+	}
+}
+
+// Link appends an audit record to its tenant's chain. It must run inside the transaction
+// that creates the record, the chain head stays locked until that transaction ends.
+func (s *AuditChainService) Link(ctx context.Context, auditLog *entities.AuditLog) (*entities.AuditChainHead, error) {
+	head, err := s.auditLogRepo.LockChainHead(ctx, entities.AuditChainKey(auditLog.TenantID))
+	if err != nil {
+		return nil, err
+	}
+
+	sequence := head.LastSequence + 1
+	auditLog.Timestamp = auditLog.Timestamp.UTC().Truncate(time.Microsecond)
+	auditLog.ChainSequence = &sequence
+	auditLog.PrevHash = head.LastHash
+	auditLog.RowHash = HashAuditLog(auditLog)
+
+	head.LastSequence = sequence
+	head.LastAuditLogID = &auditLog.ID
+	head.LastHash = auditLog.RowHash
+	head.UpdatedAt = time.Now()
+
+	return head, nil
+}
+
+// Verify walks the chain of a tenant, or of records without a tenant when tenantID is nil,
+// and reports the first broken link
+// The walk stops at the chain head read before it, so records appended meanwhile are left for the next verification.
+func (s *AuditChainService) Verify(ctx context.Context, tenantID *uuid.UUID) (*AuditChainVerification, error) {
+	result := &AuditChainVerification{ChainKey: entities.AuditChainKey(tenantID)}
+
+	head, err := s.auditLogRepo.GetChainHead(ctx, result.ChainKey)
+	if err != nil {
+		return nil, err
+	}
+	lastSequence := int64(math.MaxInt64)
+	if head != nil {
+		lastSequence = head.LastSequence
+	}
+
+	expected, prevHash := int64(1), ""
+	err = s.auditLogRepo.StreamChain(ctx, result.ChainKey, lastSequence, func(auditLog *entities.AuditLog) error {
+		id := auditLog.ID
+		switch sequence := *auditLog.ChainSequence; {
+		case sequence > expected:
+			result.BrokenLink = &AuditChainBreak{Sequence: expected, Reason: "record is missing"}
+		case sequence < expected:
+			result.BrokenLink = &AuditChainBreak{Sequence: sequence, AuditLogID: &id, Reason: "sequence is duplicated"}
+		case auditLog.PrevHash != prevHash:
+			result.BrokenLink = &AuditChainBreak{Sequence: sequence, AuditLogID: &id, Reason: "previous hash does not match the previous record"}
+		case HashAuditLog(auditLog) != auditLog.RowHash:
+			result.BrokenLink = &AuditChainBreak{Sequence: sequence, AuditLogID: &id, Reason: "content does not match the record hash"}
+		default:
+			expected, prevHash = sequence+1, auditLog.RowHash
+			result.Verified++
+			return nil
+		}
+		return errChainBroken
+	})
+	if err != nil && !errors.Is(err, errChainBroken) {
+		return nil, err
+	}
+
+	// Records removed from the end of the chain are only visible from the head
+	if result.BrokenLink == nil && head != nil && (head.LastSequence != expected-1 || head.LastHash != prevHash) {
+		result.BrokenLink = &AuditChainBreak{
+			Sequence:   expected,
+			AuditLogID: head.LastAuditLogID,
+			Reason:     fmt.Sprintf("chain head expects %d records", head.LastSequence),
+		}
+	}
+
+	result.Valid = result.BrokenLink == nil
+	return result, nil
+}
+
+// HashAuditLog computes the hex SHA-256 chaining an audit record to the previous hash
+func HashAuditLog(auditLog *entities.AuditLog) string {
+	sum := sha256.Sum256(append([]byte(auditLog.PrevHash+"\n"), canonicalAuditLog(auditLog)...))
+	return hex.EncodeToString(sum[:])
+}
+
+// canonicalAuditLog serializes the hashed content of an audit record as a JSON array in a fixed order
+func canonicalAuditLog(auditLog *entities.AuditLog) []byte {
+	optionalUUID := func(id *uuid.UUID) string {
+		if id == nil {
+			return ""
+		}
+		return id.String()
+	}
+
+	var sequence int64
+	if auditLog.ChainSequence != nil {
+		sequence = *auditLog.ChainSequence
+	}
+
+	var deletedAt string
+	if auditLog.DeletedAt != nil {
+		deletedAt = auditLog.DeletedAt.Format(auditTimestampLayout)
+	}
+
+	// Marshalling strings and integers cannot fail
+	data, _ := json.Marshal([]any{
+		auditLog.ID.String(),
+		optionalUUID(auditLog.TenantID),
+		sequence,
+		optionalUUID(auditLog.UserID),
+		auditLog.Action,
+		auditLog.Resource,
+		optionalUUID(auditLog.ResourceID),
+		auditLog.OldValues,
+		auditLog.NewValues,
+		auditLog.IPAddress,
+		auditLog.UserAgent,
+		auditLog.SessionID,
+		auditLog.Timestamp.Format(auditTimestampLayout),
+		deletedAt,
+	})
+	return data
+}
@@ -0,0 +1,159 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/pkg/actor"
+
+	"github.com/google/uuid"
+)
+
+// Audit actions recorded in BMSF_AUDIT_LOG.ACTION
+const (
+	AuditActionCreate               = "CREATE"
+	AuditActionUpdate               = "UPDATE"
+	AuditActionDelete               = "DELETE"
+	AuditActionLogin                = "LOGIN"
+	AuditActionLoginFailed          = "LOGIN_FAILED"
+	AuditActionLogout               = "LOGOUT"
+	AuditActionTokenRefresh         = "TOKEN_REFRESH"
+	AuditActionTokenReuse           = "TOKEN_REUSE"
+	AuditActionPasswordResetRequest = "PASSWORD_RESET_REQUEST"
+	AuditActionPasswordReset        = "PASSWORD_RESET"
+	AuditActionMFAChallenge         = "MFA_CHALLENGE"
+	AuditActionMFAFailed            = "MFA_FAILED"
+	AuditActionMFARecoveryCodes     = "MFA_RECOVERY_CODES"
+	AuditActionUnlock               = "UNLOCK"
+	AuditActionPasswordExpired      = "PASSWORD_EXPIRED"
+)
+
+// Audit resources recorded in BMSF_AUDIT_LOG.RESOURCE
+const (
+	AuditResourceUsers               = "users"
+	AuditResourceRefreshTokens       = "refresh_tokens"
+	AuditResourcePasswordResetTokens = "password_reset_tokens"
+	AuditResourcePhoneVerifications  = "phone_verifications"
+	AuditResourceUserMFA             = "user_mfa"
+	AuditResourceRoles               = "roles"
+	AuditResourcePermissions         = "permissions"
+)
+
+// redactedValue replaces the value of sensitive fields in audit snapshots
+const redactedValue = "[REDACTED]"
+
+// redactedFields lists the snapshot keys whose values never reach the audit log
+var redactedFields = []string{"password", "password_hash", "salt", "token", "refresh_token", "access_token"}
+
+// AuditEntry describes one audited change. UserID, IPAddress, UserAgent and SessionID
+// default to the request actor when left empty.
+type AuditEntry struct {
+	Action     string
+	Resource   string
+	ResourceID *uuid.UUID
+	UserID     *uuid.UUID
+	OldValues  string
+	NewValues  string
+	IPAddress  string
+	UserAgent  string
+	SessionID  string
+}
+
+// AuditRecorder writes audit log records for mutating use cases
+type AuditRecorder struct {
+	auditLogRepo      repositories.AuditLogRepository
+	auditChainService *AuditChainService
+	transactor        repositories.Transactor
+}
+
+// NewAuditRecorder creates a new audit recorder
+func NewAuditRecorder(auditLogRepo repositories.AuditLogRepository, auditChainService *AuditChainService, transactor repositories.Transactor) *AuditRecorder {
+	return &AuditRecorder{
+		auditLogRepo:      auditLogRepo,
+		auditChainService: auditChainService,
+		transactor:        transactor,
+	}
+}
+
+// Record writes an audit log record. Call it inside the transaction of the audited change
+// so that the record and the change commit or roll back together.
+func (r *AuditRecorder) Record(ctx context.Context, entry AuditEntry) error {
+	if current, ok := actor.FromContext(ctx); ok {
+		if entry.UserID == nil {
+			userID := current.UserID
+			entry.UserID = &userID
+		}
+		if entry.IPAddress == "" {
+			entry.IPAddress = current.IPAddress
+		}
+		if entry.UserAgent == "" {
+			entry.UserAgent = current.UserAgent
+		}
+		if entry.SessionID == "" {
+			entry.SessionID = current.SessionID
+		}
+	}
+
+	auditLog := entities.NewAuditLog(
+		entry.UserID,
+		entry.Action,
+		entry.Resource,
+		entry.ResourceID,
+		entry.OldValues,
+		entry.NewValues,
+		entry.IPAddress,
+		entry.UserAgent,
+		entry.SessionID,
+	)
+	auditLog.CreatedBy = entry.UserID
+	auditLog.UpdatedBy = entry.UserID
+
+	// Joins the caller's transaction, which keeps the chain head locked until the change commits
+	return r.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		head, err := r.auditChainService.Link(ctx, auditLog)
+		if err != nil {
+			return err
+		}
+
+		if err := r.auditLogRepo.Create(ctx, auditLog); err != nil {
+			return err
+		}
+
+		return r.auditLogRepo.UpdateChainHead(ctx, head)
+	})
+}
+
+// Snapshot serializes v as a JSON object for the audit log, masking sensitive fields.
+// It returns an empty string for nil values.
+func Snapshot(v any) string {
+	if v == nil {
+		return ""
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return string(data)
+	}
+	if fields == nil {
+		return ""
+	}
+
+	for _, key := range redactedFields {
+		if _, ok := fields[key]; ok {
+			fields[key] = redactedValue
+		}
+	}
+
+	data, err = json.Marshal(fields)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
@@ -0,0 +1,215 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+
+	"github.com/google/uuid"
+)
+
+// AuthorizationService handles role-based access control decisions
+type AuthorizationService struct {
+	roleRepo       repositories.RoleRepository
+	permissionRepo repositories.PermissionRepository
+}
+
+// NewAuthorizationService creates a new authorization service
+func NewAuthorizationService(roleRepo repositories.RoleRepository, permissionRepo repositories.PermissionRepository) *AuthorizationService {
+	return &AuthorizationService{
+		roleRepo:       roleRepo,
+		permissionRepo: permissionRepo,
+	}
+}
+
+// GetActiveRole resolves a role by ID, returning nil when there is no role or it is inactive
+func (s *AuthorizationService) GetActiveRole(ctx context.Context, roleID *uuid.UUID) (*entities.Role, error) {
+	if roleID == nil {
+		return nil, nil
+	}
+
+	role, err := s.roleRepo.GetByID(ctx, *roleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve role: %w", err)
+	}
+
+	if role == nil || !role.IsActive {
+		return nil, nil
+	}
+
+	return role, nil
+}
+
+// HasRole checks if the role is active and is, or inherits from, one of the given role codes
+func (s *AuthorizationService) HasRole(ctx context.Context, roleID *uuid.UUID, roleCodes ...string) (bool, error) {
+	hierarchy, err := s.activeHierarchy(ctx, roleID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, role := range hierarchy {
+		for _, code := range roleCodes {
+			if role.Code == code {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// HasPermission checks if the role is active and grants the permission code, directly or by inheritance
+// Only registered, active permissions can be granted, so deleting or deactivating
+// a permission revokes it for everyone, including wildcard grants.
+func (s *AuthorizationService) HasPermission(ctx context.Context, roleID *uuid.UUID, permissionCode string) (bool, error) {
+	permissions, err := s.resolvePermissions(ctx, roleID)
+	if err != nil {
+		return false, err
+	}
+
+	granted := false
+	for _, permission := range permissions {
+		if entities.PermissionMatches(permission.Code, permissionCode) {
+			granted = true
+			break
+		}
+	}
+	if !granted {
+		return false, nil
+	}
+
+	permission, err := s.permissionRepo.GetByCode(ctx, permissionCode)
+	if err != nil {
+		return false, fmt.Errorf("failed to resolve permission: %w", err)
+	}
+
+	if permission == nil || !permission.IsActive {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// CanGrantRole checks that the grantor's role holds every permission the role grants, directly or by inheritance,
+// so granting it cannot raise anyone above the grantor. System roles can only be granted by holders of "*".
+func (s *AuthorizationService) CanGrantRole(ctx context.Context, grantorRoleID *uuid.UUID, role *entities.Role) (bool, error) {
+	holds, err := s.holder(ctx, grantorRoleID)
+	if err != nil {
+		return false, err
+	}
+
+	if role.IsSystemRole() && !holds(entities.PermissionWildcard) {
+		return false, nil
+	}
+
+	granted, err := s.resolvePermissions(ctx, &role.ID)
+	if err != nil {
+		return false, err
+	}
+	for _, permission := range granted {
+		if !holds(permission.Code) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// CanGrantPermissions checks that the grantor's role holds every permission code, directly or by inheritance,
+// so a role granting them cannot rise above the grantor
+func (s *AuthorizationService) CanGrantPermissions(ctx context.Context, grantorRoleID *uuid.UUID, codes []string) (bool, error) {
+	holds, err := s.holder(ctx, grantorRoleID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, code := range codes {
+		if !holds(code) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// holder resolves the permissions of a role into a check of whether it holds a permission code
+// A wildcard code is only held through a grant at least as wide.
+func (s *AuthorizationService) holder(ctx context.Context, roleID *uuid.UUID) (func(code string) bool, error) {
+	held, err := s.resolvePermissions(ctx, roleID)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(code string) bool {
+		for _, permission := range held {
+			if entities.PermissionMatches(permission.Code, code) {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+// EffectivePermission is a permission code granted to a role, directly or inherited from an ancestor
+type EffectivePermission struct {
+	Code      string    `json:"code"`
+	RoleID    uuid.UUID `json:"role_id"`
+	RoleCode  string    `json:"role_code"`
+	Inherited bool      `json:"inherited"`
+}
+
+// EffectivePermissions flattens the permissions a role grants directly and inherits from its ancestors
+// Each code is attributed to the nearest role granting it. Inheritance stops at the first inactive role.
+func (s *AuthorizationService) EffectivePermissions(ctx context.Context, roleID uuid.UUID) ([]EffectivePermission, error) {
+	return s.resolvePermissions(ctx, &roleID)
+}
+
+// resolvePermissions implements EffectivePermissions for an optional role ID
+func (s *AuthorizationService) resolvePermissions(ctx context.Context, roleID *uuid.UUID) ([]EffectivePermission, error) {
+	hierarchy, err := s.activeHierarchy(ctx, roleID)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions := []EffectivePermission{}
+	seen := make(map[string]bool)
+	for i, role := range hierarchy {
+		for _, code := range role.PermissionCodes {
+			if seen[code] {
+				continue
+			}
+			seen[code] = true
+
+			permissions = append(permissions, EffectivePermission{
+				Code:      code,
+				RoleID:    role.ID,
+				RoleCode:  role.Code,
+				Inherited: i > 0,
+			})
+		}
+	}
+
+	return permissions, nil
+}
+
+// activeHierarchy returns the role followed by its ancestors, nearest first, up to the first inactive role
+func (s *AuthorizationService) activeHierarchy(ctx context.Context, roleID *uuid.UUID) ([]*entities.Role, error) {
+	if roleID == nil {
+		return nil, nil
+	}
+
+	hierarchy, err := s.roleRepo.GetHierarchy(ctx, *roleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve role hierarchy: %w", err)
+	}
+
+	for i, role := range hierarchy {
+		if !role.IsActive {
+			return hierarchy[:i], nil
+		}
+	}
+
+	return hierarchy, nil
+}
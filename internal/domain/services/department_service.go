@@ -0,0 +1,68 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrDepartmentCycle is returned when moving a department would place it under itself or one of its descendants
+	ErrDepartmentCycle = errors.New("department hierarchy cycle")
+
+	// ErrParentDepartmentNotFound is returned when the parent department does not exist
+	ErrParentDepartmentNotFound = errors.New("parent department not found")
+
+	// ErrParentDepartmentInactive is returned when the parent department is inactive
+	ErrParentDepartmentInactive = errors.New("parent department is inactive")
+)
+
+// DepartmentService handles department-related business logic
+type DepartmentService struct {
+	departmentRepo repositories.DepartmentRepository
+}
+
+// NewDepartmentService creates a new department service
+func NewDepartmentService(departmentRepo repositories.DepartmentRepository) *DepartmentService {
+	return &DepartmentService{
+		departmentRepo: departmentRepo,
+	}
+}
+
+// ValidateParent checks that a department can be placed under the given parent
+// The parent must exist, be active and must not be the department itself or one of its descendants.
+func (s *DepartmentService) ValidateParent(ctx context.Context, department *entities.Department, parentID *uuid.UUID) error {
+	if parentID == nil {
+		return nil
+	}
+
+	if *parentID == department.ID {
+		return ErrDepartmentCycle
+	}
+
+	ancestors, err := s.departmentRepo.GetHierarchy(ctx, *parentID)
+	if err != nil {
+		return fmt.Errorf("failed to resolve parent department hierarchy: %w", err)
+	}
+
+	if len(ancestors) == 0 {
+		return ErrParentDepartmentNotFound
+	}
+
+	for _, ancestor := range ancestors {
+		if ancestor.ID == department.ID {
+			return ErrDepartmentCycle
+		}
+	}
+
+	if !ancestors[0].IsActive {
+		return ErrParentDepartmentInactive
+	}
+
+	return nil
+}
@@ -0,0 +1,308 @@
+package services
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// JWTKeyFile references a PEM encoded key used for JWT signing or verification
+type JWTKeyFile struct {
+	ID   string // Key ID published as "kid"; defaults to the RFC 7638 thumbprint of the public key
+	Path string // PEM file holding a private key (PKCS#1, PKCS#8, SEC 1) or a public key (PKIX, PKCS#1)
+}
+
+// JWTKey is a key that signs or verifies JWTs
+type JWTKey struct {
+	ID         string
+	Method     jwt.SigningMethod
+	signingKey interface{} // Private key or HMAC secret; nil for verification-only keys
+	verifyKey  interface{} // Public key or HMAC secret
+	publicJWK  *JWK        // Nil for HMAC keys, which must never be published
+}
+
+// JWKSet is a JSON Web Key Set as served at /.well-known/jwks.json
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWK is the public part of an asymmetric key in JSON Web Key format
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// JWTKeySet holds the key tokens are signed with and every key tokens are accepted from
+type JWTKeySet struct {
+	signing *JWTKey
+	keys    map[string]*JWTKey
+	ordered []*JWTKey
+}
+
+// NewHMACKeySet creates a key set signing and verifying HS256 tokens with a shared secret
+// Tokens carry no "kid" header and nothing is published in the JWKS.
+func NewHMACKeySet(secretKey string) *JWTKeySet {
+	key := &JWTKey{
+		Method:     jwt.SigningMethodHS256,
+		signingKey: []byte(secretKey),
+		verifyKey:  []byte(secretKey),
+	}
+	return &JWTKeySet{
+		signing: key,
+		keys:    map[string]*JWTKey{"": key},
+		ordered: []*JWTKey{key},
+	}
+}
+
+// LoadJWTKeySet loads an asymmetric signing key and additional verification keys from PEM files
+// The algorithm follows the key type: RSA signs RS256, ECDSA P-256 signs ES256 and Ed25519 signs EdDSA.
+// Verification keys keep tokens issued with a retired key valid, or let a new key be published before it signs.
+func LoadJWTKeySet(signing JWTKeyFile, verification []JWTKeyFile) (*JWTKeySet, error) {
+	signingKey, err := loadJWTKey(signing, true)
+	if err != nil {
+		return nil, err
+	}
+
+	keySet := &JWTKeySet{
+		signing: signingKey,
+		keys:    make(map[string]*JWTKey),
+	}
+	if err := keySet.add(signingKey); err != nil {
+		return nil, err
+	}
+
+	for _, file := range verification {
+		key, err := loadJWTKey(file, false)
+		if err != nil {
+			return nil, err
+		}
+		if err := keySet.add(key); err != nil {
+			return nil, err
+		}
+	}
+
+	return keySet, nil
+}
+
+// add registers a key, rejecting duplicate key IDs
+func (ks *JWTKeySet) add(key *JWTKey) error {
+	if _, exists := ks.keys[key.ID]; exists {
+		return fmt.Errorf("duplicate JWT key id: %s", key.ID)
+	}
+	ks.keys[key.ID] = key
+	ks.ordered = append(ks.ordered, key)
+	return nil
+}
+
+// SigningKey returns the key new tokens are signed with
+func (ks *JWTKeySet) SigningKey() *JWTKey {
+	return ks.signing
+}
+
+// Methods returns the algorithms of every accepted key
+func (ks *JWTKeySet) Methods() []string {
+	seen := make(map[string]bool)
+	var methods []string
+	for _, key := range ks.ordered {
+		if alg := key.Method.Alg(); !seen[alg] {
+			seen[alg] = true
+			methods = append(methods, alg)
+		}
+	}
+	return methods
+}
+
+// VerificationKey resolves the key a parsed token must verify against
+// Tokens are matched by their "kid" header and must use the algorithm of that key.
+func (ks *JWTKeySet) VerificationKey(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	key, ok := ks.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key: %q", kid)
+	}
+	if token.Method.Alg() != key.Method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+	return key.verifyKey, nil
+}
+
+// JWKS returns the public keys of the set; HMAC secrets are never included
+func (ks *JWTKeySet) JWKS() *JWKSet {
+	jwks := &JWKSet{Keys: []JWK{}}
+	for _, key := range ks.ordered {
+		if key.publicJWK != nil {
+			jwks.Keys = append(jwks.Keys, *key.publicJWK)
+		}
+	}
+	return jwks
+}
+
+// loadJWTKey reads a PEM key file; signing keys must hold a private key
+func loadJWTKey(file JWTKeyFile, requirePrivate bool) (*JWTKey, error) {
+	data, err := os.ReadFile(file.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWT key %s: %w", file.Path, err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("failed to decode JWT key %s: no PEM block found", file.Path)
+	}
+
+	var private crypto.Signer
+	var public crypto.PublicKey
+	switch block.Type {
+	case "PUBLIC KEY", "RSA PUBLIC KEY":
+		if requirePrivate {
+			return nil, fmt.Errorf("JWT signing key %s must be a private key", file.Path)
+		}
+		public, err = parsePublicKey(block)
+	default:
+		private, err = parsePrivateKey(block)
+		if err == nil {
+			public = private.Public()
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse JWT key %s: %w", file.Path, err)
+	}
+
+	publicJWK, method, err := newJWK(public)
+	if err != nil {
+		return nil, fmt.Errorf("unsupported JWT key %s: %w", file.Path, err)
+	}
+
+	publicJWK.Kid = file.ID
+	if publicJWK.Kid == "" {
+		publicJWK.Kid = jwkThumbprint(publicJWK)
+	}
+
+	key := &JWTKey{
+		ID:        publicJWK.Kid,
+		Method:    method,
+		verifyKey: public,
+		publicJWK: publicJWK,
+	}
+	if requirePrivate {
+		key.signingKey = private
+	}
+	return key, nil
+}
+
+// parsePrivateKey parses PKCS#8, PKCS#1 and SEC 1 private keys
+func parsePrivateKey(block *pem.Block) (crypto.Signer, error) {
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, errors.New("private key cannot sign")
+		}
+		return signer, nil
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type: %s", block.Type)
+	}
+}
+
+// parsePublicKey parses PKIX and PKCS#1 public keys
+func parsePublicKey(block *pem.Block) (crypto.PublicKey, error) {
+	if block.Type == "RSA PUBLIC KEY" {
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	}
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
+
+// newJWK converts a public key into its JWK and the signing method it is used with
+func newJWK(public crypto.PublicKey) (*JWK, jwt.SigningMethod, error) {
+	switch key := public.(type) {
+	case *rsa.PublicKey:
+		return &JWK{
+			Kty: "RSA",
+			Use: "sig",
+			Alg: jwt.SigningMethodRS256.Alg(),
+			N:   base64URL(key.N.Bytes()),
+			E:   base64URL(big.NewInt(int64(key.E)).Bytes()),
+		}, jwt.SigningMethodRS256, nil
+	case *ecdsa.PublicKey:
+		if key.Curve != elliptic.P256() {
+			return nil, nil, fmt.Errorf("ECDSA curve %s is not supported, use P-256", key.Curve.Params().Name)
+		}
+		ecdh, err := key.ECDH()
+		if err != nil {
+			return nil, nil, err
+		}
+		point := ecdh.Bytes() // Uncompressed point: 0x04 || X || Y
+		return &JWK{
+			Kty: "EC",
+			Use: "sig",
+			Alg: jwt.SigningMethodES256.Alg(),
+			Crv: "P-256",
+			X:   base64URL(point[1:33]),
+			Y:   base64URL(point[33:]),
+		}, jwt.SigningMethodES256, nil
+	case ed25519.PublicKey:
+		return &JWK{
+			Kty: "OKP",
+			Use: "sig",
+			Alg: jwt.SigningMethodEdDSA.Alg(),
+			Crv: "Ed25519",
+			X:   base64URL(key),
+		}, jwt.SigningMethodEdDSA, nil
+	default:
+		return nil, nil, fmt.Errorf("key type %T is not supported", public)
+	}
+}
+
+// jwkThumbprint computes the RFC 7638 SHA-256 thumbprint of a JWK
+func jwkThumbprint(jwk *JWK) string {
+	members := map[string]string{"kty": jwk.Kty}
+	switch jwk.Kty {
+	case "RSA":
+		members["e"] = jwk.E
+		members["n"] = jwk.N
+	case "EC":
+		members["crv"] = jwk.Crv
+		members["x"] = jwk.X
+		members["y"] = jwk.Y
+	case "OKP":
+		members["crv"] = jwk.Crv
+		members["x"] = jwk.X
+	}
+
+	// encoding/json sorts map keys, giving the canonical member order
+	canonical, _ := json.Marshal(members)
+	sum := sha256.Sum256(canonical)
+	return base64URL(sum[:])
+}
+
+// base64URL encodes bytes as unpadded base64url
+func base64URL(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
@@ -11,7 +11,7 @@ import (
 
 // JWTService handles JWT token operations
 type JWTService struct {
-	secretKey     []byte
+	keys          *JWTKeySet
 	accessExpiry  time.Duration
 	refreshExpiry time.Duration
 }
@@ -22,21 +22,32 @@ type JWTClaims struct {
 	Username string     `json:"username"`
 	Email    string     `json:"email"`
 	RoleID   *uuid.UUID `json:"role_id,omitempty"`
+	Scope    string     `json:"scope,omitempty"` // Restricts an access token to routes allowing the scope
 	jwt.RegisteredClaims
 }
 
+// TokenScopePasswordChange restricts an access token to changing an expired password
+const TokenScopePasswordChange = "password_change"
+
 // TokenPair represents access and refresh token pair
 type TokenPair struct {
 	AccessToken  string `json:"access_token"`
 	RefreshToken string `json:"refresh_token"`
 	ExpiresIn    int64  `json:"expires_in"`
 	TokenType    string `json:"token_type"`
+
+	accessTokenID string
+}
+
+// AccessTokenID returns the jti of the access token
+func (tp *TokenPair) AccessTokenID() string {
+	return tp.accessTokenID
 }
 
-// NewJWTService creates a new JWT service
-func NewJWTService(secretKey string, accessExpiry, refreshExpiry time.Duration) *JWTService {
+// NewJWTService creates a new JWT service signing with the signing key of the key set
+func NewJWTService(keys *JWTKeySet, accessExpiry, refreshExpiry time.Duration) *JWTService {
 	return &JWTService{
-		secretKey:     []byte(secretKey),
+		keys:          keys,
 		accessExpiry:  accessExpiry,
 		refreshExpiry: refreshExpiry,
 	}
@@ -45,7 +56,7 @@ func NewJWTService(secretKey string, accessExpiry, refreshExpiry time.Duration)
 // GenerateTokenPair generates both access and refresh tokens
 func (js *JWTService) GenerateTokenPair(userID uuid.UUID, username, email string, roleID *uuid.UUID) (*TokenPair, error) {
 	// Generate access token
-	accessToken, _, err := js.generateAccessToken(userID, username, email, roleID)
+	accessToken, accessTokenID, err := js.generateAccessToken(userID, username, email, roleID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate access token: %w", err)
 	}
@@ -61,11 +72,13 @@ func (js *JWTService) GenerateTokenPair(userID uuid.UUID, username, email string
 		RefreshToken: refreshToken,
 		ExpiresIn:    int64(js.accessExpiry.Seconds()),
 		TokenType:    "Bearer",
+
+		accessTokenID: accessTokenID,
 	}, nil
 }
 
-// generateAccessToken generates an access token
-func (js *JWTService) generateAccessToken(userID uuid.UUID, username, email string, roleID *uuid.UUID) (string, time.Time, error) {
+// generateAccessToken generates an access token, returning it with its jti
+func (js *JWTService) generateAccessToken(userID uuid.UUID, username, email string, roleID *uuid.UUID) (string, string, error) {
 	now := time.Now()
 	expiresAt := now.Add(js.accessExpiry)
 
@@ -85,13 +98,37 @@ func (js *JWTService) generateAccessToken(userID uuid.UUID, username, email stri
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString(js.secretKey)
+	tokenString, err := js.sign(claims)
 	if err != nil {
-		return "", time.Time{}, err
+		return "", "", err
 	}
 
-	return tokenString, expiresAt, nil
+	return tokenString, claims.ID, nil
+}
+
+// GenerateRestrictedToken generates an access token limited to a scope, without a refresh token
+// Only routes allowing the scope accept it, see AuthMiddleware.RequireAuth.
+func (js *JWTService) GenerateRestrictedToken(userID uuid.UUID, username, email string, roleID *uuid.UUID, scope string, expiry time.Duration) (string, error) {
+	now := time.Now()
+
+	claims := &JWTClaims{
+		UserID:   userID,
+		Username: username,
+		Email:    email,
+		RoleID:   roleID,
+		Scope:    scope,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    "bm-staff",
+			Subject:   userID.String(),
+			Audience:  []string{"bm-staff-api"},
+			ExpiresAt: jwt.NewNumericDate(now.Add(expiry)),
+			NotBefore: jwt.NewNumericDate(now),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ID:        uuid.New().String(),
+		},
+	}
+
+	return js.sign(claims)
 }
 
 // generateRefreshToken generates a refresh token
@@ -112,8 +149,7 @@ func (js *JWTService) generateRefreshToken(userID uuid.UUID) (string, time.Time,
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString(js.secretKey)
+	tokenString, err := js.sign(claims)
 	if err != nil {
 		return "", time.Time{}, err
 	}
@@ -121,14 +157,20 @@ func (js *JWTService) generateRefreshToken(userID uuid.UUID) (string, time.Time,
 	return tokenString, expiresAt, nil
 }
 
-// ValidateToken validates a JWT token and returns claims
+// sign signs claims with the signing key, naming it in the "kid" header
+func (js *JWTService) sign(claims *JWTClaims) (string, error) {
+	key := js.keys.SigningKey()
+	token := jwt.NewWithClaims(key.Method, claims)
+	if key.ID != "" {
+		token.Header["kid"] = key.ID
+	}
+	return token.SignedString(key.signingKey)
+}
+
+// ValidateToken validates a JWT token against the verification keys and returns claims
 func (js *JWTService) ValidateToken(tokenString string) (*JWTClaims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return js.secretKey, nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, js.keys.VerificationKey,
+		jwt.WithValidMethods(js.keys.Methods()))
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse token: %w", err)
@@ -141,6 +183,41 @@ func (js *JWTService) ValidateToken(tokenString string) (*JWTClaims, error) {
 	return nil, errors.New("invalid token")
 }
 
+// GenerateMFAChallengeToken issues the short-lived token a password login yields when the user has
+// two-factor authentication enabled; it is only accepted by ValidateMFAChallengeToken
+func (js *JWTService) GenerateMFAChallengeToken(userID uuid.UUID, expiry time.Duration) (string, error) {
+	now := time.Now()
+
+	claims := &JWTClaims{
+		UserID: userID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    "bm-staff",
+			Subject:   userID.String(),
+			Audience:  []string{"bm-staff-mfa"},
+			ExpiresAt: jwt.NewNumericDate(now.Add(expiry)),
+			NotBefore: jwt.NewNumericDate(now),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ID:        uuid.New().String(),
+		},
+	}
+
+	return js.sign(claims)
+}
+
+// ValidateMFAChallengeToken validates an MFA challenge token and returns its claims
+func (js *JWTService) ValidateMFAChallengeToken(tokenString string) (*JWTClaims, error) {
+	claims, err := js.ValidateToken(tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(claims.Audience) == 0 || claims.Audience[0] != "bm-staff-mfa" {
+		return nil, errors.New("invalid token type for MFA challenge")
+	}
+
+	return claims, nil
+}
+
 // RefreshToken generates a new access token from refresh token
 func (js *JWTService) RefreshToken(refreshTokenString string, username, email string, roleID *uuid.UUID) (*TokenPair, error) {
 	// Validate refresh token
@@ -158,6 +235,11 @@ func (js *JWTService) RefreshToken(refreshTokenString string, username, email st
 	return js.GenerateTokenPair(claims.UserID, username, email, roleID)
 }
 
+// JWKS returns the public verification keys for other services validating our tokens
+func (js *JWTService) JWKS() *JWKSet {
+	return js.keys.JWKS()
+}
+
 // ExtractTokenFromHeader extracts token from Authorization header
 func (js *JWTService) ExtractTokenFromHeader(authHeader string) (string, error) {
 	if authHeader == "" {
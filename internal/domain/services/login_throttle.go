@@ -0,0 +1,197 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"bm-staff/internal/domain/entities"
+)
+
+// LoginAttempts holds the failed logins counted for a login throttle key
+type LoginAttempts struct {
+	Failures    int       // Failures in the current window
+	WindowStart time.Time // First failure of the current window
+	Lockouts    int       // Lockouts so far, each one twice as long as the previous one
+	LockedUntil time.Time // End of the current lockout
+}
+
+// LoginAttemptStore keeps the failed login counters of the login throttle
+// Implementations must be safe for concurrent use; entries may be dropped once their ttl has passed.
+type LoginAttemptStore interface {
+	// Get returns the counters of a key, or zero counters when there are none
+	Get(ctx context.Context, key string) (LoginAttempts, error)
+
+	// Set stores the counters of a key for ttl
+	Set(ctx context.Context, key string, attempts LoginAttempts, ttl time.Duration) error
+
+	// Delete drops the counters of a key
+	Delete(ctx context.Context, key string) error
+}
+
+// LoginLimit configures the failed logins allowed per client IP or per username
+type LoginLimit struct {
+	Window  time.Duration          // Failures older than this are forgotten
+	Lockout entities.LockoutPolicy // Failures per lockout and lockout durations
+}
+
+// LoginThrottle slows down password guessing with failed login counters per client IP and per username
+// Username counters also cover usernames that do not exist, which cannot be locked in the database.
+type LoginThrottle struct {
+	mu            sync.Mutex
+	store         LoginAttemptStore
+	ipLimit       LoginLimit
+	usernameLimit LoginLimit
+}
+
+// NewLoginThrottle creates a new login throttle; the username limit also applies to account lockouts
+func NewLoginThrottle(store LoginAttemptStore, ipLimit, usernameLimit LoginLimit) *LoginThrottle {
+	return &LoginThrottle{
+		store:         store,
+		ipLimit:       ipLimit,
+		usernameLimit: usernameLimit,
+	}
+}
+
+// AccountLockout returns the policy locking accounts in the database after failed logins
+func (t *LoginThrottle) AccountLockout() entities.LockoutPolicy {
+	return t.usernameLimit.Lockout
+}
+
+// Check returns how long a login of the username from the client IP has to wait, zero when it may proceed
+func (t *LoginThrottle) Check(ctx context.Context, ipAddress, username string) (time.Duration, error) {
+	now := time.Now()
+
+	var wait time.Duration
+	for _, key := range []string{ipKey(ipAddress), usernameKey(username)} {
+		attempts, err := t.store.Get(ctx, key)
+		if err != nil {
+			return 0, err
+		}
+		wait = max(wait, attempts.LockedUntil.Sub(now))
+	}
+
+	return wait, nil
+}
+
+// RecordFailure counts a failed login of the username from the client IP, locking either when its limit is reached
+func (t *LoginThrottle) RecordFailure(ctx context.Context, ipAddress, username string) error {
+	// Serializes the read-modify-write within this process; shared stores may lose concurrent updates
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if err := t.recordFailureLocked(ctx, ipKey(ipAddress), t.ipLimit); err != nil {
+		return err
+	}
+	return t.recordFailureLocked(ctx, usernameKey(username), t.usernameLimit)
+}
+
+// Reset clears the counters of a username after a successful login or an unlock
+// Client IP counters are kept, so logging in to one account does not allow more guesses at others.
+func (t *LoginThrottle) Reset(ctx context.Context, username string) error {
+	return t.store.Delete(ctx, usernameKey(username))
+}
+
+// recordFailureLocked counts a failure for one key; the caller holds the lock
+func (t *LoginThrottle) recordFailureLocked(ctx context.Context, key string, limit LoginLimit) error {
+	if limit.Lockout.MaxAttempts <= 0 {
+		return nil
+	}
+
+	attempts, err := t.store.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if attempts.Failures == 0 || !now.Before(attempts.WindowStart.Add(limit.Window)) {
+		attempts.Failures = 0
+		attempts.WindowStart = now
+	}
+
+	attempts.Failures++
+	if attempts.Failures >= limit.Lockout.MaxAttempts {
+		attempts.Lockouts++
+		attempts.LockedUntil = now.Add(limit.Lockout.LockoutDuration(attempts.Lockouts))
+		attempts.Failures = 0
+	}
+
+	// Lockouts count towards the next one until a window has passed after the current one ends
+	end := attempts.WindowStart.Add(limit.Window)
+	if attempts.LockedUntil.After(end) {
+		end = attempts.LockedUntil
+	}
+	return t.store.Set(ctx, key, attempts, end.Sub(now)+limit.Window)
+}
+
+// ipKey returns the login throttle key of a client IP
+func ipKey(ipAddress string) string {
+	return "ip:" + ipAddress
+}
+
+// usernameKey returns the login throttle key of a username, ignoring case
+func usernameKey(username string) string {
+	return "username:" + strings.ToLower(strings.TrimSpace(username))
+}
+
+// memoryLoginAttempts is an entry of the in-memory login attempt store
+type memoryLoginAttempts struct {
+	attempts  LoginAttempts
+	expiresAt time.Time
+}
+
+// MemoryLoginAttemptStore is a process-local LoginAttemptStore
+// Counters are lost on restart and not shared between instances.
+type MemoryLoginAttemptStore struct {
+	mu      sync.RWMutex
+	entries map[string]memoryLoginAttempts
+}
+
+// NewMemoryLoginAttemptStore creates a new in-memory login attempt store
+func NewMemoryLoginAttemptStore() *MemoryLoginAttemptStore {
+	return &MemoryLoginAttemptStore{
+		entries: make(map[string]memoryLoginAttempts),
+	}
+}
+
+// Get returns the counters of a key, or zero counters when there are none
+func (s *MemoryLoginAttemptStore) Get(ctx context.Context, key string) (LoginAttempts, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entry, ok := s.entries[key]
+	if !ok || !time.Now().Before(entry.expiresAt) {
+		return LoginAttempts{}, nil
+	}
+	return entry.attempts, nil
+}
+
+// Set stores the counters of a key for ttl
+func (s *MemoryLoginAttemptStore) Set(ctx context.Context, key string, attempts LoginAttempts, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.pruneLocked(now)
+	s.entries[key] = memoryLoginAttempts{attempts: attempts, expiresAt: now.Add(ttl)}
+	return nil
+}
+
+// Delete drops the counters of a key
+func (s *MemoryLoginAttemptStore) Delete(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, key)
+	return nil
+}
+
+// pruneLocked drops expired entries; the caller holds the write lock
+func (s *MemoryLoginAttemptStore) pruneLocked(now time.Time) {
+	for key, entry := range s.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(s.entries, key)
+		}
+	}
+}
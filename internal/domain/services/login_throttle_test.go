@@ -0,0 +1,259 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"bm-staff/internal/domain/entities"
+)
+
+// fakeLoginAttemptStore keeps counters in a map and records the ttl of every write
+type fakeLoginAttemptStore struct {
+	entries map[string]LoginAttempts
+	ttls    map[string]time.Duration
+}
+
+func newFakeLoginAttemptStore() *fakeLoginAttemptStore {
+	return &fakeLoginAttemptStore{
+		entries: make(map[string]LoginAttempts),
+		ttls:    make(map[string]time.Duration),
+	}
+}
+
+func (s *fakeLoginAttemptStore) Get(ctx context.Context, key string) (LoginAttempts, error) {
+	return s.entries[key], nil
+}
+
+func (s *fakeLoginAttemptStore) Set(ctx context.Context, key string, attempts LoginAttempts, ttl time.Duration) error {
+	s.entries[key] = attempts
+	s.ttls[key] = ttl
+	return nil
+}
+
+func (s *fakeLoginAttemptStore) Delete(ctx context.Context, key string) error {
+	delete(s.entries, key)
+	delete(s.ttls, key)
+	return nil
+}
+
+// withinSecond reports whether a time measured inside the throttle matches the expected one
+func withinSecond(got, want time.Time) bool {
+	d := got.Sub(want)
+	return d > -time.Second && d < time.Second
+}
+
+func TestLoginThrottleRecordFailure(t *testing.T) {
+	limit := LoginLimit{
+		Window:  2 * time.Minute,
+		Lockout: entities.LockoutPolicy{MaxAttempts: 3, Duration: time.Minute, MaxDuration: 8 * time.Minute},
+	}
+	now := time.Now()
+
+	tests := []struct {
+		name            string
+		seed            LoginAttempts
+		wantFailures    int
+		wantWindowStart time.Time
+		wantLockouts    int
+		wantLockedUntil time.Time // zero when the failure does not lock
+		wantTTL         time.Duration
+	}{
+		{
+			name:            "first failure opens a window",
+			wantFailures:    1,
+			wantWindowStart: now,
+			wantTTL:         4 * time.Minute,
+		},
+		{
+			name:            "failure within the window",
+			seed:            LoginAttempts{Failures: 1, WindowStart: now.Add(-time.Minute)},
+			wantFailures:    2,
+			wantWindowStart: now.Add(-time.Minute),
+			wantTTL:         3 * time.Minute,
+		},
+		{
+			name:            "failure after the window resets the count",
+			seed:            LoginAttempts{Failures: 2, WindowStart: now.Add(-3 * time.Minute)},
+			wantFailures:    1,
+			wantWindowStart: now,
+			wantTTL:         4 * time.Minute,
+		},
+		{
+			name:            "reaching the limit locks",
+			seed:            LoginAttempts{Failures: 2, WindowStart: now.Add(-30 * time.Second)},
+			wantWindowStart: now.Add(-30 * time.Second),
+			wantLockouts:    1,
+			wantLockedUntil: now.Add(time.Minute),
+			wantTTL:         3*time.Minute + 30*time.Second,
+		},
+		{
+			name:            "second lockout doubles",
+			seed:            LoginAttempts{Failures: 2, WindowStart: now.Add(-30 * time.Second), Lockouts: 1},
+			wantWindowStart: now.Add(-30 * time.Second),
+			wantLockouts:    2,
+			wantLockedUntil: now.Add(2 * time.Minute),
+			wantTTL:         4 * time.Minute,
+		},
+		{
+			name:            "third lockout doubles again",
+			seed:            LoginAttempts{Failures: 2, WindowStart: now.Add(-30 * time.Second), Lockouts: 2},
+			wantWindowStart: now.Add(-30 * time.Second),
+			wantLockouts:    3,
+			wantLockedUntil: now.Add(4 * time.Minute),
+			wantTTL:         6 * time.Minute,
+		},
+		{
+			name:            "lockout is capped",
+			seed:            LoginAttempts{Failures: 2, WindowStart: now.Add(-30 * time.Second), Lockouts: 5},
+			wantWindowStart: now.Add(-30 * time.Second),
+			wantLockouts:    6,
+			wantLockedUntil: now.Add(8 * time.Minute),
+			wantTTL:         10 * time.Minute,
+		},
+		{
+			name:            "failure after a lockout keeps the lockout count",
+			seed:            LoginAttempts{Lockouts: 2, LockedUntil: now.Add(-time.Minute)},
+			wantFailures:    1,
+			wantWindowStart: now,
+			wantLockouts:    2,
+			wantLockedUntil: now.Add(-time.Minute),
+			wantTTL:         4 * time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newFakeLoginAttemptStore()
+			store.entries[usernameKey("jdoe")] = tt.seed
+			throttle := NewLoginThrottle(store, LoginLimit{}, limit)
+
+			if err := throttle.RecordFailure(context.Background(), "10.0.0.1", "jdoe"); err != nil {
+				t.Fatalf("RecordFailure() error = %v", err)
+			}
+
+			got := store.entries[usernameKey("jdoe")]
+			if got.Failures != tt.wantFailures {
+				t.Errorf("Failures = %d, want %d", got.Failures, tt.wantFailures)
+			}
+			if !withinSecond(got.WindowStart, tt.wantWindowStart) {
+				t.Errorf("WindowStart = %v, want %v", got.WindowStart, tt.wantWindowStart)
+			}
+			if got.Lockouts != tt.wantLockouts {
+				t.Errorf("Lockouts = %d, want %d", got.Lockouts, tt.wantLockouts)
+			}
+			if tt.wantLockedUntil.IsZero() != got.LockedUntil.IsZero() || !withinSecond(got.LockedUntil, tt.wantLockedUntil) {
+				t.Errorf("LockedUntil = %v, want %v", got.LockedUntil, tt.wantLockedUntil)
+			}
+			if ttl := store.ttls[usernameKey("jdoe")]; ttl <= tt.wantTTL-time.Second || ttl > tt.wantTTL {
+				t.Errorf("ttl = %v, want %v", ttl, tt.wantTTL)
+			}
+		})
+	}
+}
+
+func TestLoginThrottleLimits(t *testing.T) {
+	store := newFakeLoginAttemptStore()
+	ipLimit := LoginLimit{Window: time.Minute, Lockout: entities.LockoutPolicy{MaxAttempts: 2, Duration: 10 * time.Minute}}
+	usernameLimit := LoginLimit{Window: time.Minute, Lockout: entities.LockoutPolicy{MaxAttempts: 5, Duration: time.Minute}}
+	throttle := NewLoginThrottle(store, ipLimit, usernameLimit)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if err := throttle.RecordFailure(ctx, "10.0.0.1", " JDoe "); err != nil {
+			t.Fatalf("RecordFailure() error = %v", err)
+		}
+	}
+
+	// The client IP is locked, the username is not, and the username ignores case and spaces
+	if got := store.entries[ipKey("10.0.0.1")].Lockouts; got != 1 {
+		t.Errorf("client IP lockouts = %d, want 1", got)
+	}
+	if got := store.entries[usernameKey("jdoe")].Failures; got != 2 {
+		t.Errorf("username failures = %d, want 2", got)
+	}
+
+	wait, err := throttle.Check(ctx, "10.0.0.1", "someone-else")
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if wait <= 9*time.Minute || wait > 10*time.Minute {
+		t.Errorf("Check() from the locked client IP = %v, want about 10m", wait)
+	}
+
+	wait, err = throttle.Check(ctx, "10.0.0.2", "jdoe")
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if wait != 0 {
+		t.Errorf("Check() from another client IP = %v, want 0", wait)
+	}
+
+	// Reset clears the username but keeps the client IP counters
+	if err := throttle.Reset(ctx, "JDOE"); err != nil {
+		t.Fatalf("Reset() error = %v", err)
+	}
+	if _, ok := store.entries[usernameKey("jdoe")]; ok {
+		t.Error("Reset() kept the username counters")
+	}
+	if _, ok := store.entries[ipKey("10.0.0.1")]; !ok {
+		t.Error("Reset() dropped the client IP counters")
+	}
+}
+
+func TestLoginThrottleDisabledLimit(t *testing.T) {
+	store := newFakeLoginAttemptStore()
+	usernameLimit := LoginLimit{Window: time.Minute, Lockout: entities.LockoutPolicy{MaxAttempts: 5, Duration: time.Minute}}
+	throttle := NewLoginThrottle(store, LoginLimit{}, usernameLimit)
+
+	if err := throttle.RecordFailure(context.Background(), "10.0.0.1", "jdoe"); err != nil {
+		t.Fatalf("RecordFailure() error = %v", err)
+	}
+	if _, ok := store.entries[ipKey("10.0.0.1")]; ok {
+		t.Error("RecordFailure() counted a client IP without a limit")
+	}
+	if throttle.AccountLockout() != usernameLimit.Lockout {
+		t.Errorf("AccountLockout() = %+v, want %+v", throttle.AccountLockout(), usernameLimit.Lockout)
+	}
+}
+
+func TestMemoryLoginAttemptStore(t *testing.T) {
+	store := NewMemoryLoginAttemptStore()
+	ctx := context.Background()
+	attempts := LoginAttempts{Failures: 2, WindowStart: time.Now()}
+
+	if got, _ := store.Get(ctx, "missing"); got != (LoginAttempts{}) {
+		t.Errorf("Get() of a missing key = %+v, want zero counters", got)
+	}
+
+	if err := store.Set(ctx, "live", attempts, time.Minute); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	if got, _ := store.Get(ctx, "live"); got != attempts {
+		t.Errorf("Get() = %+v, want %+v", got, attempts)
+	}
+
+	// An entry past its ttl reads as zero counters and is pruned by the next write
+	if err := store.Set(ctx, "expired", attempts, 0); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	if got, _ := store.Get(ctx, "expired"); got != (LoginAttempts{}) {
+		t.Errorf("Get() of an expired key = %+v, want zero counters", got)
+	}
+	if err := store.Set(ctx, "other", attempts, time.Minute); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	if _, ok := store.entries["expired"]; ok {
+		t.Error("Set() did not prune the expired entry")
+	}
+	if _, ok := store.entries["live"]; !ok {
+		t.Error("Set() pruned a live entry")
+	}
+
+	if err := store.Delete(ctx, "live"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if got, _ := store.Get(ctx, "live"); got != (LoginAttempts{}) {
+		t.Errorf("Get() after Delete() = %+v, want zero counters", got)
+	}
+}
@@ -0,0 +1,17 @@
+package services
+
+import "context"
+
+// MailMessage is a plain-text email
+type MailMessage struct {
+	To      []string
+	Subject string
+	Body    string
+}
+
+// MailSender delivers emails
+// Implementations live in internal/infrastructure/mail: SMTP for production and file or in-memory stand-ins for local use.
+type MailSender interface {
+	// Send delivers a message
+	Send(ctx context.Context, message MailMessage) error
+}
@@ -0,0 +1,137 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+
+	"github.com/google/uuid"
+)
+
+// Second factors accepted by VerifyCode
+const (
+	MFAMethodTOTP         = "totp"
+	MFAMethodRecoveryCode = "recovery_code"
+)
+
+// ErrInvalidMFACode is returned when a code matches neither the authenticator nor an unused recovery code
+var ErrInvalidMFACode = errors.New("invalid MFA code")
+
+// totpCodePattern tells authenticator codes from recovery codes
+var totpCodePattern = regexp.MustCompile(`^[0-9]{6}$`)
+
+// MFAService checks second factors against a user's TOTP enrollment and recovery codes
+type MFAService struct {
+	mfaRepo          repositories.UserMFARepository
+	recoveryCodeRepo repositories.MFARecoveryCodeRepository
+	totpService      *TOTPService
+	recoveryCodes    int
+}
+
+// NewMFAService creates a new MFA service issuing recoveryCodes codes per set
+func NewMFAService(mfaRepo repositories.UserMFARepository, recoveryCodeRepo repositories.MFARecoveryCodeRepository, totpService *TOTPService, recoveryCodes int) *MFAService {
+	return &MFAService{
+		mfaRepo:          mfaRepo,
+		recoveryCodeRepo: recoveryCodeRepo,
+		totpService:      totpService,
+		recoveryCodes:    recoveryCodes,
+	}
+}
+
+// Enrollment returns the TOTP enrollment of a user; enrolled is false when there is none
+// Lookup failures are returned as errors, so logins fail closed instead of skipping the second factor.
+func (s *MFAService) Enrollment(ctx context.Context, userID uuid.UUID) (*entities.UserMFA, bool, error) {
+	mfa, err := s.mfaRepo.GetByUserID(ctx, userID.String())
+	if errors.Is(err, repositories.ErrMFANotEnrolled) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return mfa, true, nil
+}
+
+// IsRequired reports whether logins of the user need a second factor
+func (s *MFAService) IsRequired(ctx context.Context, userID uuid.UUID) (bool, error) {
+	mfa, enrolled, err := s.Enrollment(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return enrolled && mfa.IsConfirmed(), nil
+}
+
+// VerifyCode accepts an authenticator code or an unused recovery code and returns which one it was
+// Accepted codes are used up: the TOTP time step is recorded, recovery codes are marked used.
+func (s *MFAService) VerifyCode(ctx context.Context, mfa *entities.UserMFA, code string) (string, error) {
+	if totpCodePattern.MatchString(code) {
+		secret, err := s.totpService.Open(mfa.Secret)
+		if err != nil {
+			return "", err
+		}
+
+		step, ok := s.totpService.Validate(secret, code, time.Now())
+		if !ok || step <= mfa.LastUsedStep {
+			return "", ErrInvalidMFACode
+		}
+
+		advanced, err := s.mfaRepo.AdvanceStep(ctx, mfa.ID.String(), step)
+		if err != nil {
+			return "", err
+		}
+		if !advanced {
+			return "", ErrInvalidMFACode
+		}
+		mfa.LastUsedStep = step
+
+		return MFAMethodTOTP, nil
+	}
+
+	used, err := s.recoveryCodeRepo.Use(ctx, mfa.UserID.String(), s.totpService.HashRecoveryCode(code))
+	if err != nil {
+		return "", err
+	}
+	if !used {
+		return "", ErrInvalidMFACode
+	}
+
+	return MFAMethodRecoveryCode, nil
+}
+
+// IssueRecoveryCodes replaces the recovery codes of a user and returns the new ones in plain text
+// The caller runs it in a transaction, so a failure keeps the previous codes.
+func (s *MFAService) IssueRecoveryCodes(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	codes, err := s.totpService.GenerateRecoveryCodes(s.recoveryCodes)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.recoveryCodeRepo.DeleteAllForUser(ctx, userID.String()); err != nil {
+		return nil, err
+	}
+
+	for _, code := range codes {
+		if err := s.recoveryCodeRepo.Create(ctx, entities.NewMFARecoveryCode(userID, s.totpService.HashRecoveryCode(code))); err != nil {
+			return nil, fmt.Errorf("failed to save recovery code: %w", err)
+		}
+	}
+
+	return codes, nil
+}
+
+// Disable removes the TOTP enrollment and recovery codes of a user
+func (s *MFAService) Disable(ctx context.Context, userID uuid.UUID) error {
+	if err := s.mfaRepo.DeleteByUserID(ctx, userID.String()); err != nil {
+		return err
+	}
+	return s.recoveryCodeRepo.DeleteAllForUser(ctx, userID.String())
+}
+
+// RemainingRecoveryCodes counts the recovery codes a user has left
+func (s *MFAService) RemainingRecoveryCodes(ctx context.Context, userID uuid.UUID) (int, error) {
+	return s.recoveryCodeRepo.CountUnused(ctx, userID.String())
+}
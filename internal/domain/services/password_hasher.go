@@ -0,0 +1,279 @@
+package services
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Supported password hashing algorithms
+const (
+	AlgorithmArgon2id     = "argon2id"
+	AlgorithmBcrypt       = "bcrypt"
+	AlgorithmLegacySHA256 = "sha256"
+)
+
+// ErrUnsupportedHash is returned when an encoded hash cannot be parsed
+var ErrUnsupportedHash = errors.New("unsupported password hash format")
+
+// Argon2id limits imposed by the SALT (size:32, hex) and PASSWORD_HASH (size:255) columns
+const (
+	maxArgon2SaltLength = 16
+	maxArgon2KeyLength  = 128
+)
+
+// PasswordHasher hashes and verifies passwords using a self-describing encoded format
+type PasswordHasher interface {
+	// Algorithm returns the algorithm identifier of the hasher
+	Algorithm() string
+
+	// Hash hashes a password and returns the encoded hash and the salt used
+	Hash(password string) (encoded, salt string, err error)
+
+	// Verify checks a password against an encoded hash
+	Verify(password, encoded string) (bool, error)
+
+	// NeedsRehash reports whether an encoded hash was produced with different parameters
+	NeedsRehash(encoded string) bool
+}
+
+// NewPasswordHasher creates the password hasher for the configured algorithm
+func NewPasswordHasher(algorithm string, argon2Params Argon2Params, bcryptCost int) (PasswordHasher, error) {
+	switch strings.ToLower(algorithm) {
+	case "", AlgorithmArgon2id:
+		if argon2Params.SaltLength > maxArgon2SaltLength {
+			return nil, fmt.Errorf("argon2 salt length %d exceeds the maximum of %d bytes", argon2Params.SaltLength, maxArgon2SaltLength)
+		}
+		if argon2Params.KeyLength > maxArgon2KeyLength {
+			return nil, fmt.Errorf("argon2 key length %d exceeds the maximum of %d bytes", argon2Params.KeyLength, maxArgon2KeyLength)
+		}
+		return NewArgon2idHasher(argon2Params), nil
+	case AlgorithmBcrypt:
+		return NewBcryptHasher(bcryptCost), nil
+	default:
+		return nil, fmt.Errorf("unsupported password hashing algorithm: %s", algorithm)
+	}
+}
+
+// DetectAlgorithm returns the algorithm an encoded hash was produced with
+func DetectAlgorithm(encoded string) string {
+	switch {
+	case strings.HasPrefix(encoded, "$argon2id$"):
+		return AlgorithmArgon2id
+	case strings.HasPrefix(encoded, "$2a$"), strings.HasPrefix(encoded, "$2b$"), strings.HasPrefix(encoded, "$2y$"):
+		return AlgorithmBcrypt
+	case isLegacySHA256Hash(encoded):
+		return AlgorithmLegacySHA256
+	default:
+		return ""
+	}
+}
+
+// Argon2Params holds Argon2id tuning parameters
+type Argon2Params struct {
+	Memory      uint32 // KiB
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// DefaultArgon2Params returns the recommended Argon2id parameters
+func DefaultArgon2Params() Argon2Params {
+	return Argon2Params{
+		Memory:      64 * 1024,
+		Iterations:  3,
+		Parallelism: 2,
+		SaltLength:  16,
+		KeyLength:   32,
+	}
+}
+
+// Argon2idHasher hashes passwords with Argon2id
+// Encoded format: $argon2id$v=19$m=<memory>,t=<iterations>,p=<parallelism>$<salt>$<key>
+type Argon2idHasher struct {
+	params Argon2Params
+}
+
+// NewArgon2idHasher creates a new Argon2id hasher, filling zero parameters with defaults
+func NewArgon2idHasher(params Argon2Params) *Argon2idHasher {
+	defaults := DefaultArgon2Params()
+	if params.Memory == 0 {
+		params.Memory = defaults.Memory
+	}
+	if params.Iterations == 0 {
+		params.Iterations = defaults.Iterations
+	}
+	if params.Parallelism == 0 {
+		params.Parallelism = defaults.Parallelism
+	}
+	if params.SaltLength == 0 {
+		params.SaltLength = defaults.SaltLength
+	}
+	if params.KeyLength == 0 {
+		params.KeyLength = defaults.KeyLength
+	}
+	return &Argon2idHasher{params: params}
+}
+
+// Algorithm returns the algorithm identifier
+func (h *Argon2idHasher) Algorithm() string {
+	return AlgorithmArgon2id
+}
+
+// Hash hashes a password with a random salt
+func (h *Argon2idHasher) Hash(password string) (string, string, error) {
+	saltBytes := make([]byte, h.params.SaltLength)
+	if _, err := rand.Read(saltBytes); err != nil {
+		return "", "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	key := argon2.IDKey([]byte(password), saltBytes, h.params.Iterations, h.params.Memory, h.params.Parallelism, h.params.KeyLength)
+
+	encoded := fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version,
+		h.params.Memory,
+		h.params.Iterations,
+		h.params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(saltBytes),
+		base64.RawStdEncoding.EncodeToString(key),
+	)
+
+	return encoded, hex.EncodeToString(saltBytes), nil
+}
+
+// Verify checks a password against an Argon2id encoded hash
+func (h *Argon2idHasher) Verify(password, encoded string) (bool, error) {
+	params, salt, key, err := decodeArgon2id(encoded)
+	if err != nil {
+		return false, err
+	}
+
+	computed := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, params.KeyLength)
+	return subtle.ConstantTimeCompare(key, computed) == 1, nil
+}
+
+// NeedsRehash reports whether the hash uses different parameters than the hasher
+func (h *Argon2idHasher) NeedsRehash(encoded string) bool {
+	params, _, _, err := decodeArgon2id(encoded)
+	if err != nil {
+		return true
+	}
+	return params.Memory != h.params.Memory ||
+		params.Iterations != h.params.Iterations ||
+		params.Parallelism != h.params.Parallelism ||
+		params.SaltLength != h.params.SaltLength ||
+		params.KeyLength != h.params.KeyLength
+}
+
+// decodeArgon2id parses an Argon2id encoded hash
+func decodeArgon2id(encoded string) (Argon2Params, []byte, []byte, error) {
+	var params Argon2Params
+
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[1] != AlgorithmArgon2id {
+		return params, nil, nil, ErrUnsupportedHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return params, nil, nil, ErrUnsupportedHash
+	}
+	if version != argon2.Version {
+		return params, nil, nil, fmt.Errorf("unsupported argon2 version: %d", version)
+	}
+
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return params, nil, nil, ErrUnsupportedHash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return params, nil, nil, ErrUnsupportedHash
+	}
+	params.SaltLength = uint32(len(salt))
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return params, nil, nil, ErrUnsupportedHash
+	}
+	params.KeyLength = uint32(len(key))
+
+	return params, salt, key, nil
+}
+
+// BcryptHasher hashes passwords with bcrypt
+// Note that bcrypt only considers the first 72 bytes of a password.
+type BcryptHasher struct {
+	cost int
+}
+
+// NewBcryptHasher creates a new bcrypt hasher
+func NewBcryptHasher(cost int) *BcryptHasher {
+	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		cost = bcrypt.DefaultCost
+	}
+	return &BcryptHasher{cost: cost}
+}
+
+// Algorithm returns the algorithm identifier
+func (h *BcryptHasher) Algorithm() string {
+	return AlgorithmBcrypt
+}
+
+// Hash hashes a password, bcrypt generates and embeds its own salt
+func (h *BcryptHasher) Hash(password string) (string, string, error) {
+	hashBytes, err := bcrypt.GenerateFromPassword([]byte(password), h.cost)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to hash password: %w", err)
+	}
+	encoded := string(hashBytes)
+
+	// $2a$<cost>$<22 char salt><31 char hash>
+	return encoded, encoded[7:29], nil
+}
+
+// Verify checks a password against a bcrypt hash
+func (h *BcryptHasher) Verify(password, encoded string) (bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(encoded), []byte(password))
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	}
+	return false, err
+}
+
+// NeedsRehash reports whether the hash uses a different cost than the hasher
+func (h *BcryptHasher) NeedsRehash(encoded string) bool {
+	cost, err := bcrypt.Cost([]byte(encoded))
+	if err != nil {
+		return true
+	}
+	return cost != h.cost
+}
+
+// isLegacySHA256Hash checks whether a stored hash is a legacy hex encoded sha256(password+salt)
+func isLegacySHA256Hash(hash string) bool {
+	if len(hash) != sha256.Size*2 {
+		return false
+	}
+	_, err := hex.DecodeString(hash)
+	return err == nil
+}
+
+// verifyLegacySHA256 verifies a password against a legacy sha256(password+salt) hash
+func verifyLegacySHA256(password, hash, salt string) bool {
+	hashBytes := sha256.Sum256([]byte(password + salt))
+	computedHash := hex.EncodeToString(hashBytes[:])
+	return subtle.ConstantTimeCompare([]byte(computedHash), []byte(strings.ToLower(hash))) == 1
+}
@@ -0,0 +1,218 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// testArgon2Params keeps Argon2id cheap enough for tests
+var testArgon2Params = Argon2Params{Memory: 1024, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+
+const testBcryptCost = 4
+
+func TestNewPasswordHasher(t *testing.T) {
+	tests := []struct {
+		name          string
+		algorithm     string
+		params        Argon2Params
+		wantAlgorithm string
+		wantErr       bool
+	}{
+		{name: "default", algorithm: "", params: testArgon2Params, wantAlgorithm: AlgorithmArgon2id},
+		{name: "argon2id ignores case", algorithm: "Argon2ID", params: testArgon2Params, wantAlgorithm: AlgorithmArgon2id},
+		{name: "bcrypt", algorithm: "bcrypt", params: testArgon2Params, wantAlgorithm: AlgorithmBcrypt},
+		{name: "unknown algorithm", algorithm: "scrypt", params: testArgon2Params, wantErr: true},
+		{name: "largest salt", algorithm: "argon2id", params: Argon2Params{SaltLength: 16}, wantAlgorithm: AlgorithmArgon2id},
+		{name: "salt overflowing the SALT column", algorithm: "argon2id", params: Argon2Params{SaltLength: 17}, wantErr: true},
+		{name: "largest key", algorithm: "argon2id", params: Argon2Params{KeyLength: 128}, wantAlgorithm: AlgorithmArgon2id},
+		{name: "key overflowing the PASSWORD_HASH column", algorithm: "argon2id", params: Argon2Params{KeyLength: 129}, wantErr: true},
+		{name: "argon2 limits do not apply to bcrypt", algorithm: "bcrypt", params: Argon2Params{SaltLength: 64}, wantAlgorithm: AlgorithmBcrypt},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hasher, err := NewPasswordHasher(tt.algorithm, tt.params, testBcryptCost)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("NewPasswordHasher() error = nil, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewPasswordHasher() error = %v", err)
+			}
+			if hasher.Algorithm() != tt.wantAlgorithm {
+				t.Errorf("Algorithm() = %s, want %s", hasher.Algorithm(), tt.wantAlgorithm)
+			}
+		})
+	}
+}
+
+func TestPasswordHasherHashVerify(t *testing.T) {
+	hashers := []PasswordHasher{
+		NewArgon2idHasher(testArgon2Params),
+		NewBcryptHasher(testBcryptCost),
+	}
+
+	for _, hasher := range hashers {
+		t.Run(hasher.Algorithm(), func(t *testing.T) {
+			encoded, salt, err := hasher.Hash("correct horse battery staple")
+			if err != nil {
+				t.Fatalf("Hash() error = %v", err)
+			}
+			if len(encoded) > 255 || salt == "" || len(salt) > 32 {
+				t.Errorf("Hash() = %d byte hash and %d byte salt, want them to fit PASSWORD_HASH and SALT", len(encoded), len(salt))
+			}
+			if DetectAlgorithm(encoded) != hasher.Algorithm() {
+				t.Errorf("DetectAlgorithm() = %q, want %q", DetectAlgorithm(encoded), hasher.Algorithm())
+			}
+
+			ok, err := hasher.Verify("correct horse battery staple", encoded)
+			if err != nil || !ok {
+				t.Errorf("Verify(correct password) = %v, %v, want true", ok, err)
+			}
+			ok, err = hasher.Verify("Correct horse battery staple", encoded)
+			if err != nil || ok {
+				t.Errorf("Verify(wrong password) = %v, %v, want false", ok, err)
+			}
+
+			again, againSalt, err := hasher.Hash("correct horse battery staple")
+			if err != nil {
+				t.Fatalf("Hash() error = %v", err)
+			}
+			if again == encoded || againSalt == salt {
+				t.Error("Hash() reused a salt")
+			}
+
+			if hasher.NeedsRehash(encoded) {
+				t.Error("NeedsRehash() = true for a hash with the current parameters")
+			}
+		})
+	}
+}
+
+func TestArgon2idHasherNeedsRehash(t *testing.T) {
+	encoded, _, err := NewArgon2idHasher(testArgon2Params).Hash("secret")
+	if err != nil {
+		t.Fatalf("Hash() error = %v", err)
+	}
+
+	changed := func(change func(*Argon2Params)) *Argon2idHasher {
+		params := testArgon2Params
+		change(&params)
+		return NewArgon2idHasher(params)
+	}
+
+	tests := []struct {
+		name    string
+		hasher  *Argon2idHasher
+		encoded string
+		want    bool
+	}{
+		{name: "same parameters", hasher: NewArgon2idHasher(testArgon2Params), encoded: encoded, want: false},
+		{name: "memory", hasher: changed(func(p *Argon2Params) { p.Memory = 2048 }), encoded: encoded, want: true},
+		{name: "iterations", hasher: changed(func(p *Argon2Params) { p.Iterations = 2 }), encoded: encoded, want: true},
+		{name: "parallelism", hasher: changed(func(p *Argon2Params) { p.Parallelism = 2 }), encoded: encoded, want: true},
+		{name: "salt length", hasher: changed(func(p *Argon2Params) { p.SaltLength = 8 }), encoded: encoded, want: true},
+		{name: "key length", hasher: changed(func(p *Argon2Params) { p.KeyLength = 64 }), encoded: encoded, want: true},
+		{name: "bcrypt hash", hasher: NewArgon2idHasher(testArgon2Params), encoded: "$2a$04$abcdefghijklmnopqrstuu", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.hasher.NeedsRehash(tt.encoded); got != tt.want {
+				t.Errorf("NeedsRehash() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestArgon2idHasherVerifyMalformed(t *testing.T) {
+	hasher := NewArgon2idHasher(testArgon2Params)
+	encoded, _, err := hasher.Hash("secret")
+	if err != nil {
+		t.Fatalf("Hash() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		encoded string
+	}{
+		{name: "bcrypt hash", encoded: "$2a$04$abcdefghijklmnopqrstuu"},
+		{name: "missing key", encoded: encoded[:strings.LastIndex(encoded, "$")]},
+		{name: "other version", encoded: strings.Replace(encoded, "v=19", "v=16", 1)},
+		{name: "bad parameters", encoded: strings.Replace(encoded, "m=1024", "m=x", 1)},
+		{name: "salt not base64", encoded: strings.Replace(encoded, "$"+strings.Split(encoded, "$")[4]+"$", "$%%%$", 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if ok, err := hasher.Verify("secret", tt.encoded); err == nil || ok {
+				t.Errorf("Verify() = %v, %v, want an error", ok, err)
+			}
+		})
+	}
+
+	if _, err := hasher.Verify("secret", "$2a$04$abcdefghijklmnopqrstuu"); !errors.Is(err, ErrUnsupportedHash) {
+		t.Errorf("Verify(bcrypt hash) error = %v, want %v", err, ErrUnsupportedHash)
+	}
+}
+
+func TestBcryptHasherNeedsRehash(t *testing.T) {
+	encoded, _, err := NewBcryptHasher(testBcryptCost).Hash("secret")
+	if err != nil {
+		t.Fatalf("Hash() error = %v", err)
+	}
+
+	if NewBcryptHasher(testBcryptCost).NeedsRehash(encoded) {
+		t.Error("NeedsRehash() = true for the same cost")
+	}
+	if !NewBcryptHasher(testBcryptCost + 1).NeedsRehash(encoded) {
+		t.Error("NeedsRehash() = false for a different cost")
+	}
+	if !NewBcryptHasher(testBcryptCost).NeedsRehash("$argon2id$v=19$m=1024,t=1,p=1$c2FsdA$a2V5") {
+		t.Error("NeedsRehash() = false for an Argon2id hash")
+	}
+
+	// Out of range costs fall back to the bcrypt default
+	if got := NewBcryptHasher(1).cost; got != 10 {
+		t.Errorf("NewBcryptHasher(1) cost = %d, want 10", got)
+	}
+}
+
+func TestDetectAlgorithm(t *testing.T) {
+	tests := []struct {
+		encoded string
+		want    string
+	}{
+		{encoded: "$argon2id$v=19$m=1024,t=1,p=1$c2FsdA$a2V5", want: AlgorithmArgon2id},
+		{encoded: "$2a$10$abcdefghijklmnopqrstuu", want: AlgorithmBcrypt},
+		{encoded: "$2b$10$abcdefghijklmnopqrstuu", want: AlgorithmBcrypt},
+		{encoded: "$2y$10$abcdefghijklmnopqrstuu", want: AlgorithmBcrypt},
+		{encoded: strings.Repeat("ab", 32), want: AlgorithmLegacySHA256},
+		{encoded: strings.Repeat("zz", 32), want: ""},
+		{encoded: "$argon2i$v=19$m=1024,t=1,p=1$c2FsdA$a2V5", want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := DetectAlgorithm(tt.encoded); got != tt.want {
+			t.Errorf("DetectAlgorithm(%q) = %q, want %q", tt.encoded, got, tt.want)
+		}
+	}
+}
+
+func TestVerifyLegacySHA256(t *testing.T) {
+	// sha256("password" + "salt")
+	const hash = "7a37b85c8918eac19a9089c0fa5a2ab4dce3f90528dcdeec108b23ddf3607b99"
+
+	if !verifyLegacySHA256("password", hash, "salt") {
+		t.Error("verifyLegacySHA256() rejected the password")
+	}
+	if !verifyLegacySHA256("password", strings.ToUpper(hash), "salt") {
+		t.Error("verifyLegacySHA256() rejected an upper case hash")
+	}
+	if verifyLegacySHA256("password", hash, "pepper") {
+		t.Error("verifyLegacySHA256() accepted a different salt")
+	}
+}
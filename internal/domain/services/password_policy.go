@@ -0,0 +1,174 @@
+package services
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+)
+
+// commonPasswords is the embedded list of passwords rejected for being too common
+//
+//go:embed common_passwords.txt
+var commonPasswords string
+
+// PasswordRules configures which passwords users may choose and how long they stay valid
+type PasswordRules struct {
+	MinLength     int
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool          // Any character that is not a letter or digit
+	History       int           // Previous passwords that cannot be chosen again, besides the current one
+	MaxAge        time.Duration // Passwords older than this must be changed at the next login, zero never expires
+}
+
+// PasswordPolicy checks new passwords against the password rules, a list of common passwords and the user's
+// previous passwords, and decides when passwords expire
+type PasswordPolicy struct {
+	rules           PasswordRules
+	historyRepo     repositories.PasswordHistoryRepository
+	passwordService *PasswordService
+	blocklist       map[string]struct{}
+}
+
+// NewPasswordPolicy creates a new password policy
+func NewPasswordPolicy(rules PasswordRules, historyRepo repositories.PasswordHistoryRepository, passwordService *PasswordService) *PasswordPolicy {
+	blocklist := make(map[string]struct{})
+	for _, line := range strings.Split(commonPasswords, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		blocklist[strings.ToLower(line)] = struct{}{}
+	}
+
+	return &PasswordPolicy{
+		rules:           rules,
+		historyRepo:     historyRepo,
+		passwordService: passwordService,
+		blocklist:       blocklist,
+	}
+}
+
+// Check returns the rules a new password breaks, empty when it is acceptable
+// The username and email are those of the account; an email is matched by its local part.
+func (p *PasswordPolicy) Check(password, username, email string) []string {
+	var violations []string
+
+	if utf8.RuneCountInString(password) < p.rules.MinLength {
+		violations = append(violations, fmt.Sprintf("must be at least %d characters long", p.rules.MinLength))
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case !unicode.IsLetter(r):
+			hasSymbol = true
+		}
+	}
+	if p.rules.RequireUpper && !hasUpper {
+		violations = append(violations, "must contain an uppercase letter")
+	}
+	if p.rules.RequireLower && !hasLower {
+		violations = append(violations, "must contain a lowercase letter")
+	}
+	if p.rules.RequireDigit && !hasDigit {
+		violations = append(violations, "must contain a digit")
+	}
+	if p.rules.RequireSymbol && !hasSymbol {
+		violations = append(violations, "must contain a symbol")
+	}
+
+	lower := strings.ToLower(password)
+	if containsIdentity(lower, username) {
+		violations = append(violations, "must not contain the username")
+	}
+	if localPart, _, _ := strings.Cut(email, "@"); containsIdentity(lower, localPart) {
+		violations = append(violations, "must not contain the email address")
+	}
+
+	if _, ok := p.blocklist[lower]; ok {
+		violations = append(violations, "is too common")
+	}
+
+	return violations
+}
+
+// Validate returns the rules a new password of an existing user breaks, including reuse of earlier passwords
+func (p *PasswordPolicy) Validate(ctx context.Context, user *entities.User, password string) ([]string, error) {
+	violations := p.Check(password, user.Username, user.Email)
+
+	reused, err := p.IsReused(ctx, user, password)
+	if err != nil {
+		return nil, err
+	}
+	if reused {
+		if p.rules.History > 0 {
+			violations = append(violations, fmt.Sprintf("must differ from the current and the last %d passwords", p.rules.History))
+		} else {
+			violations = append(violations, "must differ from the current password")
+		}
+	}
+
+	return violations, nil
+}
+
+// IsReused reports whether a password is the user's current one or one of the last History previous ones
+func (p *PasswordPolicy) IsReused(ctx context.Context, user *entities.User, password string) (bool, error) {
+	if p.passwordService.VerifyPassword(password, user.PasswordHash, user.Salt) {
+		return true, nil
+	}
+
+	if p.rules.History <= 0 {
+		return false, nil
+	}
+
+	entries, err := p.historyRepo.ListRecent(ctx, user.ID.String(), p.rules.History)
+	if err != nil {
+		return false, err
+	}
+	for _, entry := range entries {
+		if p.passwordService.VerifyPassword(password, entry.PasswordHash, entry.Salt) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Remember adds a replaced password to the user's history, dropping entries beyond History
+// The caller runs it in the transaction storing the new password.
+func (p *PasswordPolicy) Remember(ctx context.Context, entry *entities.PasswordHistory) error {
+	if p.rules.History <= 0 {
+		return nil
+	}
+
+	if err := p.historyRepo.Create(ctx, entry); err != nil {
+		return err
+	}
+	return p.historyRepo.DeleteAllButRecent(ctx, entry.UserID.String(), p.rules.History)
+}
+
+// IsExpired reports whether the user's password is older than MaxAge
+func (p *PasswordPolicy) IsExpired(user *entities.User) bool {
+	return p.rules.MaxAge > 0 && user.PasswordAge(time.Now()) >= p.rules.MaxAge
+}
+
+// containsIdentity reports whether a lowercased password contains an account identifier of three or more characters
+func containsIdentity(password, identity string) bool {
+	identity = strings.ToLower(strings.TrimSpace(identity))
+	return utf8.RuneCountInString(identity) >= 3 && strings.Contains(password, identity)
+}
@@ -2,43 +2,82 @@ package services
 
 import (
 	"crypto/rand"
-	"crypto/sha256"
-	"encoding/hex"
+	"encoding/base64"
 	"fmt"
+	"sync"
+
+	"golang.org/x/crypto/bcrypt"
 )
 
 // PasswordService handles password-related operations
-type PasswordService struct{}
+type PasswordService struct {
+	hasher    PasswordHasher
+	verifiers map[string]PasswordHasher
+
+	// dummyHash is verified against for unknown users, created on first use
+	dummyOnce sync.Once
+	dummyHash string
+}
 
 // NewPasswordService creates a new password service
-func NewPasswordService() *PasswordService {
-	return &PasswordService{}
+// New passwords are hashed with the given hasher; hashes produced by other
+// supported algorithms (including legacy SHA-256) can still be verified.
+func NewPasswordService(hasher PasswordHasher) *PasswordService {
+	verifiers := map[string]PasswordHasher{
+		AlgorithmArgon2id: NewArgon2idHasher(DefaultArgon2Params()),
+		AlgorithmBcrypt:   NewBcryptHasher(bcrypt.DefaultCost),
+	}
+	verifiers[hasher.Algorithm()] = hasher
+
+	return &PasswordService{
+		hasher:    hasher,
+		verifiers: verifiers,
+	}
 }
 
 // HashPassword hashes a password with a random salt
 func (ps *PasswordService) HashPassword(password string) (hash, salt string, err error) {
-	// Generate random salt
-	saltBytes := make([]byte, 16)
-	if _, err := rand.Read(saltBytes); err != nil {
-		return "", "", fmt.Errorf("failed to generate salt: %w", err)
+	return ps.hasher.Hash(password)
+}
+
+// VerifyPassword verifies a password against its hash and salt
+// The salt is only used for legacy SHA-256 hashes, other formats embed their salt.
+func (ps *PasswordService) VerifyPassword(password, hash, salt string) bool {
+	algorithm := DetectAlgorithm(hash)
+	if algorithm == AlgorithmLegacySHA256 {
+		return verifyLegacySHA256(password, hash, salt)
 	}
-	salt = hex.EncodeToString(saltBytes)
 
-	// Hash password with salt
-	hashBytes := sha256.Sum256([]byte(password + salt))
-	hash = hex.EncodeToString(hashBytes[:])
+	verifier, ok := ps.verifiers[algorithm]
+	if !ok {
+		return false
+	}
 
-	return hash, salt, nil
+	valid, err := verifier.Verify(password, hash)
+	if err != nil {
+		return false
+	}
+	return valid
 }
 
-// VerifyPassword verifies a password against its hash and salt
-func (ps *PasswordService) VerifyPassword(password, hash, salt string) bool {
-	// Hash the provided password with the stored salt
-	hashBytes := sha256.Sum256([]byte(password + salt))
-	computedHash := hex.EncodeToString(hashBytes[:])
+// SimulateVerify spends the time of verifying a password against a hash of the configured algorithm,
+// so a login of an unknown user takes as long as a wrong password
+func (ps *PasswordService) SimulateVerify(password string) {
+	ps.dummyOnce.Do(func() {
+		if hash, _, err := ps.hasher.Hash("bm-staff-dummy-password"); err == nil {
+			ps.dummyHash = hash
+		}
+	})
 
-	// Compare hashes
-	return computedHash == hash
+	ps.VerifyPassword(password, ps.dummyHash, "")
+}
+
+// NeedsRehash checks if a stored hash should be upgraded to the current algorithm and parameters
+func (ps *PasswordService) NeedsRehash(hash string) bool {
+	if DetectAlgorithm(hash) != ps.hasher.Algorithm() {
+		return true
+	}
+	return ps.hasher.NeedsRehash(hash)
 }
 
 // GenerateRandomPassword generates a random password
@@ -56,3 +95,13 @@ func (ps *PasswordService) GenerateRandomPassword(length int) (string, error) {
 
 	return string(password), nil
 }
+
+// GenerateResetToken generates an unguessable password reset token: 32 random bytes, base64url encoded
+func (ps *PasswordService) GenerateResetToken() (string, error) {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return "", fmt.Errorf("failed to generate reset token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
@@ -0,0 +1,258 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+
+	"github.com/google/uuid"
+)
+
+// Policy resources
+const (
+	PolicyResourceUsers = "users"
+)
+
+// Policy actions
+const (
+	PolicyActionRead           = "read"
+	PolicyActionUpdate         = "update"
+	PolicyActionUpdateIdentity = "update_identity" // Username and email, which lead to the account through a password reset
+	PolicyActionUpdateStatus   = "update_status"
+	PolicyActionDelete         = "delete"
+)
+
+// outrankingActions can only be performed on users whose role the subject could grant, whatever the policies allow
+var outrankingActions = map[string]bool{
+	PolicyActionUpdateIdentity: true,
+	PolicyActionUpdateStatus:   true,
+	PolicyActionDelete:         true,
+}
+
+// PolicyCondition is an attribute condition between the subject and the resource of a request
+type PolicyCondition string
+
+// Supported policy conditions
+const (
+	// ConditionNone always holds
+	ConditionNone PolicyCondition = ""
+	// ConditionSelf holds when the subject is the resource
+	ConditionSelf PolicyCondition = "self"
+	// ConditionManagerChain holds when the subject is in the resource's MANAGER_ID chain
+	ConditionManagerChain PolicyCondition = "manager_chain"
+	// ConditionDepartmentSubtree holds when the resource's department is the subject's department or below it
+	ConditionDepartmentSubtree PolicyCondition = "department_subtree"
+)
+
+// Policy allows an action on a resource type when the subject's role grants the
+// permission (if any) and the condition holds
+type Policy struct {
+	Resource   string
+	Action     string
+	Permission string
+	Condition  PolicyCondition
+}
+
+// DefaultPolicies returns the built-in user policies
+// Global permission holders may act on any user, managers on users reporting to them and
+// department admins, through the *_department permissions, on users of their department subtree.
+// Managers cannot change the username or email of their reports, which would let them reset the password.
+// Identity, status and delete actions additionally require the subject to outrank the user's role.
+func DefaultPolicies() []Policy {
+	return []Policy{
+		{Resource: PolicyResourceUsers, Action: PolicyActionRead, Condition: ConditionSelf},
+		{Resource: PolicyResourceUsers, Action: PolicyActionRead, Permission: entities.PermissionUsersRead},
+		{Resource: PolicyResourceUsers, Action: PolicyActionRead, Condition: ConditionManagerChain},
+		{Resource: PolicyResourceUsers, Action: PolicyActionRead, Permission: entities.PermissionUsersReadDepartment, Condition: ConditionDepartmentSubtree},
+		{Resource: PolicyResourceUsers, Action: PolicyActionUpdate, Permission: entities.PermissionUsersUpdate},
+		{Resource: PolicyResourceUsers, Action: PolicyActionUpdate, Condition: ConditionManagerChain},
+		{Resource: PolicyResourceUsers, Action: PolicyActionUpdate, Permission: entities.PermissionUsersUpdateDepartment, Condition: ConditionDepartmentSubtree},
+		{Resource: PolicyResourceUsers, Action: PolicyActionUpdateIdentity, Permission: entities.PermissionUsersUpdate},
+		{Resource: PolicyResourceUsers, Action: PolicyActionUpdateIdentity, Permission: entities.PermissionUsersUpdateDepartment, Condition: ConditionDepartmentSubtree},
+		{Resource: PolicyResourceUsers, Action: PolicyActionUpdateStatus, Permission: entities.PermissionUsersUpdate},
+		{Resource: PolicyResourceUsers, Action: PolicyActionUpdateStatus, Condition: ConditionManagerChain},
+		{Resource: PolicyResourceUsers, Action: PolicyActionUpdateStatus, Permission: entities.PermissionUsersUpdateDepartment, Condition: ConditionDepartmentSubtree},
+		{Resource: PolicyResourceUsers, Action: PolicyActionDelete, Permission: entities.PermissionUsersDelete},
+		{Resource: PolicyResourceUsers, Action: PolicyActionDelete, Permission: entities.PermissionUsersDeleteDepartment, Condition: ConditionDepartmentSubtree},
+	}
+}
+
+// PolicySubject holds the attributes of the user performing a request
+// DepartmentID is loaded on demand when a condition needs it.
+type PolicySubject struct {
+	UserID       uuid.UUID
+	RoleID       *uuid.UUID
+	DepartmentID *uuid.UUID
+
+	departmentLoaded bool
+}
+
+// PolicyResource holds the attributes of the resource a request acts on
+type PolicyResource struct {
+	Type         string
+	ID           uuid.UUID
+	DepartmentID *uuid.UUID
+	RoleID       *uuid.UUID
+}
+
+// UserPolicyResource describes a user as a policy resource
+func UserPolicyResource(user *entities.User) PolicyResource {
+	return PolicyResource{
+		Type:         PolicyResourceUsers,
+		ID:           user.ID,
+		DepartmentID: user.DepartmentID,
+		RoleID:       user.RoleID,
+	}
+}
+
+// DepartmentMemberPolicyResource describes any user of a department, for checks on users moving into it
+// No one manages such a user, so only permission and department conditions can hold.
+func DepartmentMemberPolicyResource(departmentID uuid.UUID) PolicyResource {
+	return PolicyResource{
+		Type:         PolicyResourceUsers,
+		DepartmentID: &departmentID,
+	}
+}
+
+// PolicyEngine evaluates attribute-based access policies
+type PolicyEngine struct {
+	policies             []Policy
+	authorizationService *AuthorizationService
+	userRepo             repositories.UserRepository
+	departmentRepo       repositories.DepartmentRepository
+	roleRepo             repositories.RoleRepository
+}
+
+// NewPolicyEngine creates a new policy engine with the default policies
+func NewPolicyEngine(authorizationService *AuthorizationService, userRepo repositories.UserRepository, departmentRepo repositories.DepartmentRepository, roleRepo repositories.RoleRepository) *PolicyEngine {
+	return &PolicyEngine{
+		policies:             DefaultPolicies(),
+		authorizationService: authorizationService,
+		userRepo:             userRepo,
+		departmentRepo:       departmentRepo,
+		roleRepo:             roleRepo,
+	}
+}
+
+// Authorize checks if any policy allows the subject to perform the action on the resource
+func (e *PolicyEngine) Authorize(ctx context.Context, subject *PolicySubject, action string, resource PolicyResource) (bool, error) {
+	if outrankingActions[action] {
+		outranks, err := e.outranks(ctx, subject, resource)
+		if err != nil || !outranks {
+			return false, err
+		}
+	}
+
+	for _, policy := range e.policies {
+		if policy.Resource != resource.Type || policy.Action != action {
+			continue
+		}
+
+		if policy.Permission != "" {
+			granted, err := e.authorizationService.HasPermission(ctx, subject.RoleID, policy.Permission)
+			if err != nil {
+				return false, err
+			}
+			if !granted {
+				continue
+			}
+		}
+
+		holds, err := e.evaluate(ctx, policy.Condition, subject, resource)
+		if err != nil {
+			return false, err
+		}
+		if holds {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// evaluate checks a policy condition against the subject and resource attributes
+func (e *PolicyEngine) evaluate(ctx context.Context, condition PolicyCondition, subject *PolicySubject, resource PolicyResource) (bool, error) {
+	switch condition {
+	case ConditionNone:
+		return true, nil
+
+	case ConditionSelf:
+		return subject.UserID == resource.ID, nil
+
+	case ConditionManagerChain:
+		if resource.Type != PolicyResourceUsers {
+			return false, nil
+		}
+		managers, err := e.userRepo.GetManagerChain(ctx, resource.ID)
+		if err != nil {
+			return false, fmt.Errorf("failed to resolve manager chain: %w", err)
+		}
+		for _, manager := range managers {
+			if manager.ID == subject.UserID {
+				return true, nil
+			}
+		}
+		return false, nil
+
+	case ConditionDepartmentSubtree:
+		if resource.DepartmentID == nil {
+			return false, nil
+		}
+		departmentID, err := e.subjectDepartment(ctx, subject)
+		if err != nil || departmentID == nil {
+			return false, err
+		}
+		departments, err := e.departmentRepo.GetHierarchy(ctx, *resource.DepartmentID)
+		if err != nil {
+			return false, fmt.Errorf("failed to resolve department hierarchy: %w", err)
+		}
+		for _, department := range departments {
+			if department.ID == *departmentID {
+				return true, nil
+			}
+		}
+		return false, nil
+
+	default:
+		return false, fmt.Errorf("unsupported policy condition: %s", condition)
+	}
+}
+
+// outranks checks that the subject's role could grant the resource's role
+// A resource without a role, or whose role no longer exists, grants nothing to outrank.
+func (e *PolicyEngine) outranks(ctx context.Context, subject *PolicySubject, resource PolicyResource) (bool, error) {
+	if resource.RoleID == nil {
+		return true, nil
+	}
+
+	role, err := e.roleRepo.GetByID(ctx, *resource.RoleID)
+	if err != nil {
+		return false, fmt.Errorf("failed to resolve role: %w", err)
+	}
+	if role == nil {
+		return true, nil
+	}
+
+	return e.authorizationService.CanGrantRole(ctx, subject.RoleID, role)
+}
+
+// subjectDepartment returns the subject's current department, loading it once per subject
+func (e *PolicyEngine) subjectDepartment(ctx context.Context, subject *PolicySubject) (*uuid.UUID, error) {
+	if subject.departmentLoaded {
+		return subject.DepartmentID, nil
+	}
+
+	user, err := e.userRepo.GetByID(ctx, subject.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve subject: %w", err)
+	}
+
+	subject.departmentLoaded = true
+	if user != nil {
+		subject.DepartmentID = user.DepartmentID
+	}
+
+	return subject.DepartmentID, nil
+}
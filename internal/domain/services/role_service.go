@@ -0,0 +1,61 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrRoleCycle is returned when assigning a parent role would make a role inherit from itself
+	ErrRoleCycle = errors.New("role hierarchy cycle")
+
+	// ErrParentRoleNotFound is returned when the parent role does not exist
+	ErrParentRoleNotFound = errors.New("parent role not found")
+)
+
+// RoleService handles role-related business logic
+type RoleService struct {
+	roleRepo repositories.RoleRepository
+}
+
+// NewRoleService creates a new role service
+func NewRoleService(roleRepo repositories.RoleRepository) *RoleService {
+	return &RoleService{
+		roleRepo: roleRepo,
+	}
+}
+
+// ValidateParent checks that a role can inherit from the given parent
+// The parent must exist and must not be the role itself or one of its descendants.
+func (s *RoleService) ValidateParent(ctx context.Context, role *entities.Role, parentID *uuid.UUID) error {
+	if parentID == nil {
+		return nil
+	}
+
+	if *parentID == role.ID {
+		return ErrRoleCycle
+	}
+
+	ancestors, err := s.roleRepo.GetHierarchy(ctx, *parentID)
+	if err != nil {
+		return fmt.Errorf("failed to resolve parent role hierarchy: %w", err)
+	}
+
+	if len(ancestors) == 0 {
+		return ErrParentRoleNotFound
+	}
+
+	for _, ancestor := range ancestors {
+		if ancestor.ID == role.ID {
+			return ErrRoleCycle
+		}
+	}
+
+	return nil
+}
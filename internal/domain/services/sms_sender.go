@@ -0,0 +1,10 @@
+package services
+
+import "context"
+
+// SMSSender delivers text messages
+// Implementations live in internal/infrastructure/sms: an HTTP gateway for production and a fake for local use.
+type SMSSender interface {
+	// Send delivers a text message to a phone number
+	Send(ctx context.Context, to, message string) error
+}
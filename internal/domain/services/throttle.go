@@ -0,0 +1,70 @@
+package services
+
+import (
+	"sync"
+	"time"
+)
+
+// Throttle limits how often an action may be repeated per key, e.g. sending mail to an address
+// An action is allowed once per interval and at most limit times per window. State is process-local.
+type Throttle struct {
+	mu       sync.Mutex
+	interval time.Duration
+	limit    int
+	window   time.Duration
+	attempts map[string][]time.Time
+}
+
+// NewThrottle creates a new in-memory throttle; a limit of zero disables the per-window cap
+func NewThrottle(interval time.Duration, limit int, window time.Duration) *Throttle {
+	return &Throttle{
+		interval: interval,
+		limit:    limit,
+		window:   window,
+		attempts: make(map[string][]time.Time),
+	}
+}
+
+// Allow records an attempt for the key if it is allowed, otherwise it returns how long to wait
+func (t *Throttle) Allow(key string) (bool, time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	t.pruneLocked(now)
+
+	attempts := t.attempts[key]
+	if len(attempts) > 0 {
+		if wait := attempts[len(attempts)-1].Add(t.interval).Sub(now); wait > 0 {
+			return false, wait
+		}
+	}
+	if t.limit > 0 {
+		inWindow := attempts
+		for len(inWindow) > 0 && !now.Before(inWindow[0].Add(t.window)) {
+			inWindow = inWindow[1:]
+		}
+		if len(inWindow) >= t.limit {
+			return false, inWindow[len(inWindow)-t.limit].Add(t.window).Sub(now)
+		}
+	}
+
+	t.attempts[key] = append(attempts, now)
+	return true, 0
+}
+
+// pruneLocked drops attempts that no longer count towards interval or window; the caller holds the lock
+func (t *Throttle) pruneLocked(now time.Time) {
+	keep := max(t.interval, t.window)
+	for key, attempts := range t.attempts {
+		first := 0
+		for first < len(attempts) && !now.Before(attempts[first].Add(keep)) {
+			first++
+		}
+		if first == len(attempts) {
+			delete(t.attempts, key)
+		} else if first > 0 {
+			t.attempts[key] = attempts[first:]
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TokenRevocationStore keeps revoked access tokens until they would have expired anyway
+// Implementations must be safe for concurrent use; entries may be dropped once expiresAt has passed.
+type TokenRevocationStore interface {
+	// RevokeToken denies a single access token by its jti
+	RevokeToken(ctx context.Context, jti string, expiresAt time.Time) error
+
+	// RevokeUser denies every access token of the user issued at or before issuedThrough, except the one
+	// whose jti is exemptJTI (if any). It replaces the user's earlier revocation and its exemption.
+	RevokeUser(ctx context.Context, userID uuid.UUID, issuedThrough time.Time, exemptJTI string, expiresAt time.Time) error
+
+	// IsRevoked reports whether an access token was revoked, individually or through its user
+	IsRevoked(ctx context.Context, jti string, userID uuid.UUID, issuedAt time.Time) (bool, error)
+}
+
+// TokenRevoker revokes access tokens before they expire
+type TokenRevoker struct {
+	store        TokenRevocationStore
+	accessExpiry time.Duration
+}
+
+// NewTokenRevoker creates a new token revoker; entries are kept for the access token lifetime
+func NewTokenRevoker(store TokenRevocationStore, accessExpiry time.Duration) *TokenRevoker {
+	return &TokenRevoker{
+		store:        store,
+		accessExpiry: accessExpiry,
+	}
+}
+
+// RevokeAccessToken revokes the access token the claims were read from
+func (tr *TokenRevoker) RevokeAccessToken(ctx context.Context, claims *JWTClaims) error {
+	if claims.ID == "" {
+		return fmt.Errorf("access token has no jti")
+	}
+
+	expiresAt := time.Now().Add(tr.accessExpiry)
+	if claims.ExpiresAt != nil {
+		expiresAt = claims.ExpiresAt.Time
+	}
+
+	return tr.store.RevokeToken(ctx, claims.ID, expiresAt)
+}
+
+// RevokeUser revokes every access token issued to the user so far
+func (tr *TokenRevoker) RevokeUser(ctx context.Context, userID uuid.UUID) error {
+	return tr.RevokeUserExcept(ctx, userID, "")
+}
+
+// RevokeUserExcept revokes every access token issued to the user so far but the one with the given jti
+// iat only has whole seconds, so tokens issued later within the current second are revoked as well;
+// exempting the token issued for the change, e.g. on a password change, keeps that one valid.
+func (tr *TokenRevoker) RevokeUserExcept(ctx context.Context, userID uuid.UUID, exemptJTI string) error {
+	issuedThrough := time.Now().Truncate(time.Second)
+	return tr.store.RevokeUser(ctx, userID, issuedThrough, exemptJTI, issuedThrough.Add(time.Second+tr.accessExpiry))
+}
+
+// IsRevoked reports whether an access token has been revoked
+func (tr *TokenRevoker) IsRevoked(ctx context.Context, claims *JWTClaims) (bool, error) {
+	var issuedAt time.Time
+	if claims.IssuedAt != nil {
+		issuedAt = claims.IssuedAt.Time
+	}
+
+	return tr.store.IsRevoked(ctx, claims.ID, claims.UserID, issuedAt)
+}
+
+// userRevocation denies the tokens of a user issued up to a point in time, but the exempt one
+type userRevocation struct {
+	issuedThrough time.Time
+	exemptJTI     string
+	expiresAt     time.Time
+}
+
+// MemoryTokenRevocationStore is a process-local TokenRevocationStore
+// Revocations are lost on restart and not shared between instances.
+type MemoryTokenRevocationStore struct {
+	mu     sync.RWMutex
+	tokens map[string]time.Time
+	users  map[uuid.UUID]userRevocation
+}
+
+// NewMemoryTokenRevocationStore creates a new in-memory token revocation store
+func NewMemoryTokenRevocationStore() *MemoryTokenRevocationStore {
+	return &MemoryTokenRevocationStore{
+		tokens: make(map[string]time.Time),
+		users:  make(map[uuid.UUID]userRevocation),
+	}
+}
+
+// RevokeToken denies a single access token by its jti
+func (s *MemoryTokenRevocationStore) RevokeToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pruneLocked(time.Now())
+	s.tokens[jti] = expiresAt
+	return nil
+}
+
+// RevokeUser denies every access token of the user issued at or before issuedThrough, except exemptJTI
+func (s *MemoryTokenRevocationStore) RevokeUser(ctx context.Context, userID uuid.UUID, issuedThrough time.Time, exemptJTI string, expiresAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pruneLocked(time.Now())
+	if existing, ok := s.users[userID]; ok && existing.issuedThrough.After(issuedThrough) {
+		// A later revocation already covers these tokens, and its exemption is not one of them
+		issuedThrough, expiresAt = existing.issuedThrough, existing.expiresAt
+		exemptJTI = ""
+	}
+	s.users[userID] = userRevocation{issuedThrough: issuedThrough, exemptJTI: exemptJTI, expiresAt: expiresAt}
+	return nil
+}
+
+// IsRevoked reports whether an access token was revoked, individually or through its user
+func (s *MemoryTokenRevocationStore) IsRevoked(ctx context.Context, jti string, userID uuid.UUID, issuedAt time.Time) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	now := time.Now()
+	if expiresAt, ok := s.tokens[jti]; ok && now.Before(expiresAt) {
+		return true, nil
+	}
+
+	if revocation, ok := s.users[userID]; ok && now.Before(revocation.expiresAt) {
+		if jti != "" && jti == revocation.exemptJTI {
+			return false, nil
+		}
+		return !issuedAt.After(revocation.issuedThrough), nil
+	}
+
+	return false, nil
+}
+
+// pruneLocked drops entries whose tokens have expired; the caller holds the write lock
+func (s *MemoryTokenRevocationStore) pruneLocked(now time.Time) {
+	for jti, expiresAt := range s.tokens {
+		if !now.Before(expiresAt) {
+			delete(s.tokens, jti)
+		}
+	}
+	for userID, revocation := range s.users {
+		if !now.Before(revocation.expiresAt) {
+			delete(s.users, userID)
+		}
+	}
+}
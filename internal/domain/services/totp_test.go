@@ -0,0 +1,229 @@
+package services
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"errors"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfcSecret is the SHA-1 seed of the RFC 4226 and RFC 6238 test vectors, "12345678901234567890"
+var rfcSecret = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+func TestHOTPVectors(t *testing.T) {
+	// RFC 4226 appendix D
+	want := []string{"755224", "287082", "359152", "969429", "338314", "254676", "287922", "162583", "399871", "520489"}
+	for counter, code := range want {
+		if got := hotp([]byte("12345678901234567890"), int64(counter)); got != code {
+			t.Errorf("hotp(counter %d) = %s, want %s", counter, got, code)
+		}
+	}
+}
+
+func TestTOTPValidateVectors(t *testing.T) {
+	// RFC 6238 appendix B (SHA-1), the last six of the eight published digits
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{unix: 59, code: "287082"},
+		{unix: 1111111109, code: "081804"},
+		{unix: 1111111111, code: "050471"},
+		{unix: 1234567890, code: "005924"},
+		{unix: 2000000000, code: "279037"},
+		{unix: 20000000000, code: "353130"},
+	}
+
+	s := NewTOTPService("BM Staff", "secret")
+	for _, tt := range tests {
+		step, ok := s.Validate(rfcSecret, tt.code, time.Unix(tt.unix, 0))
+		if !ok {
+			t.Errorf("Validate(%s at %d) rejected the code", tt.code, tt.unix)
+			continue
+		}
+		if want := tt.unix / totpPeriod; step != want {
+			t.Errorf("Validate(%s at %d) step = %d, want %d", tt.code, tt.unix, step, want)
+		}
+	}
+}
+
+func TestTOTPValidateSkew(t *testing.T) {
+	s := NewTOTPService("BM Staff", "secret")
+	now := time.Unix(1111111111, 0)
+	current := now.Unix() / totpPeriod
+	key := []byte("12345678901234567890")
+
+	tests := []struct {
+		name   string
+		code   string
+		wantOK bool
+	}{
+		{name: "previous step", code: hotp(key, current-1), wantOK: true},
+		{name: "current step", code: hotp(key, current), wantOK: true},
+		{name: "next step", code: hotp(key, current+1), wantOK: true},
+		{name: "two steps early", code: hotp(key, current-2), wantOK: false},
+		{name: "two steps late", code: hotp(key, current+2), wantOK: false},
+		{name: "wrong length", code: "12345", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, ok := s.Validate(rfcSecret, tt.code, now); ok != tt.wantOK {
+				t.Errorf("Validate() ok = %v, want %v", ok, tt.wantOK)
+			}
+		})
+	}
+
+	if _, ok := s.Validate("not base32!", "287082", time.Unix(59, 0)); ok {
+		t.Error("Validate() accepted a malformed secret")
+	}
+}
+
+func TestTOTPGenerateSecret(t *testing.T) {
+	s := NewTOTPService("BM Staff", "secret")
+
+	secret, err := s.GenerateSecret()
+	if err != nil {
+		t.Fatalf("GenerateSecret() error = %v", err)
+	}
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	if err != nil {
+		t.Fatalf("secret is not unpadded base32: %v", err)
+	}
+	if len(key) != totpSecretSize {
+		t.Errorf("secret has %d bytes, want %d", len(key), totpSecretSize)
+	}
+}
+
+func TestTOTPURI(t *testing.T) {
+	s := NewTOTPService("BM Staff", "secret")
+
+	uri, err := url.Parse(s.URI("JBSWY3DPEHPK3PXP", "jdoe@example.com"))
+	if err != nil {
+		t.Fatalf("URI is not a URL: %v", err)
+	}
+	if uri.Scheme != "otpauth" || uri.Host != "totp" || uri.Path != "/BM Staff:jdoe@example.com" {
+		t.Errorf("URI = %s, want otpauth://totp/BM Staff:jdoe@example.com", uri)
+	}
+	if strings.Contains(uri.RawQuery, "+") {
+		t.Errorf("URI query %q encodes spaces as +", uri.RawQuery)
+	}
+
+	query := uri.Query()
+	want := map[string]string{"secret": "JBSWY3DPEHPK3PXP", "issuer": "BM Staff", "algorithm": "SHA1", "digits": "6", "period": "30"}
+	for key, value := range want {
+		if got := query.Get(key); got != value {
+			t.Errorf("URI %s = %q, want %q", key, got, value)
+		}
+	}
+}
+
+func TestTOTPSealOpen(t *testing.T) {
+	s := NewTOTPService("BM Staff", "secret")
+
+	sealed, err := s.Seal("JBSWY3DPEHPK3PXP")
+	if err != nil {
+		t.Fatalf("Seal() error = %v", err)
+	}
+	if strings.Contains(sealed, "JBSWY3DPEHPK3PXP") {
+		t.Fatal("Seal() output contains the secret")
+	}
+
+	opened, err := s.Open(sealed)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	if opened != "JBSWY3DPEHPK3PXP" {
+		t.Errorf("Open() = %q, want %q", opened, "JBSWY3DPEHPK3PXP")
+	}
+
+	again, err := s.Seal("JBSWY3DPEHPK3PXP")
+	if err != nil {
+		t.Fatalf("Seal() error = %v", err)
+	}
+	if again == sealed {
+		t.Error("Seal() reused a nonce")
+	}
+}
+
+func TestTOTPOpenRejects(t *testing.T) {
+	s := NewTOTPService("BM Staff", "secret")
+	sealed, err := s.Seal("JBSWY3DPEHPK3PXP")
+	if err != nil {
+		t.Fatalf("Seal() error = %v", err)
+	}
+
+	raw, err := base64.RawStdEncoding.DecodeString(sealed)
+	if err != nil {
+		t.Fatalf("Seal() output is not raw base64: %v", err)
+	}
+	raw[len(raw)-1] ^= 0x01
+	tampered := base64.RawStdEncoding.EncodeToString(raw)
+
+	tests := []struct {
+		name    string
+		service *TOTPService
+		sealed  string
+	}{
+		{name: "tampered", service: s, sealed: tampered},
+		{name: "other key", service: NewTOTPService("BM Staff", "other secret"), sealed: sealed},
+		{name: "not base64", service: s, sealed: "%%%"},
+		{name: "shorter than a nonce", service: s, sealed: "AAAA"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.service.Open(tt.sealed); !errors.Is(err, ErrInvalidSealedSecret) {
+				t.Errorf("Open() error = %v, want %v", err, ErrInvalidSealedSecret)
+			}
+		})
+	}
+}
+
+func TestTOTPRecoveryCodes(t *testing.T) {
+	s := NewTOTPService("BM Staff", "secret")
+
+	codes, err := s.GenerateRecoveryCodes(10)
+	if err != nil {
+		t.Fatalf("GenerateRecoveryCodes() error = %v", err)
+	}
+	if len(codes) != 10 {
+		t.Fatalf("GenerateRecoveryCodes() returned %d codes, want 10", len(codes))
+	}
+
+	format := regexp.MustCompile(`^[a-z2-7]{5}-[a-z2-7]{5}$`)
+	seen := make(map[string]bool)
+	for _, code := range codes {
+		if !format.MatchString(code) {
+			t.Errorf("recovery code %q is not formatted as xxxxx-xxxxx", code)
+		}
+		if seen[code] {
+			t.Errorf("recovery code %q issued twice", code)
+		}
+		seen[code] = true
+	}
+}
+
+func TestTOTPHashRecoveryCode(t *testing.T) {
+	s := NewTOTPService("BM Staff", "secret")
+	hash := s.HashRecoveryCode("abcde-fghij")
+
+	if !regexp.MustCompile(`^[0-9a-f]{64}$`).MatchString(hash) {
+		t.Fatalf("HashRecoveryCode() = %q, want hex SHA-256", hash)
+	}
+	for _, typed := range []string{"ABCDE-FGHIJ", "abcdefghij", " abcde fghij "} {
+		if got := s.HashRecoveryCode(typed); got != hash {
+			t.Errorf("HashRecoveryCode(%q) differs from the normalized code", typed)
+		}
+	}
+	if s.HashRecoveryCode("abcde-fghik") == hash {
+		t.Error("HashRecoveryCode() matched a different code")
+	}
+	if NewTOTPService("BM Staff", "other secret").HashRecoveryCode("abcde-fghij") == hash {
+		t.Error("HashRecoveryCode() does not depend on the configured secret")
+	}
+}
@@ -2,10 +2,21 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"bm-staff/internal/domain/entities"
 	"bm-staff/internal/domain/repositories"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrManagerCycle is returned when assigning a manager would make a user report to themselves
+	ErrManagerCycle = errors.New("reporting line cycle")
+
+	// ErrManagerNotFound is returned when the manager does not exist
+	ErrManagerNotFound = errors.New("manager not found")
 )
 
 // UserService handles user-related business logic
@@ -70,3 +81,37 @@ func (s *UserService) CanDelete(ctx context.Context, user *entities.User) error
 
 	return nil
 }
+
+// ValidateManager checks that a user can report to the given manager
+// The manager must exist and must not be the user or anyone reporting to the user, directly or transitively.
+func (s *UserService) ValidateManager(ctx context.Context, user *entities.User, managerID *uuid.UUID) error {
+	if managerID == nil {
+		return nil
+	}
+
+	if *managerID == user.ID {
+		return ErrManagerCycle
+	}
+
+	manager, err := s.userRepo.GetByID(ctx, *managerID)
+	if err != nil {
+		return fmt.Errorf("failed to resolve manager: %w", err)
+	}
+
+	if manager == nil {
+		return ErrManagerNotFound
+	}
+
+	chain, err := s.userRepo.GetManagerChain(ctx, manager.ID)
+	if err != nil {
+		return fmt.Errorf("failed to resolve manager chain: %w", err)
+	}
+
+	for _, ancestor := range chain {
+		if ancestor.ID == user.ID {
+			return ErrManagerCycle
+		}
+	}
+
+	return nil
+}
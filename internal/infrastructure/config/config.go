@@ -8,10 +8,20 @@ import (
 
 // Config holds all configuration for the application
 type Config struct {
-	Server   ServerConfig   `mapstructure:"server"`
-	Database DatabaseConfig `mapstructure:"database"`
-	Logging  LoggingConfig  `mapstructure:"logging"`
-	JWT      JWTConfig      `mapstructure:"jwt"`
+	Server     ServerConfig     `mapstructure:"server"`
+	Database   DatabaseConfig   `mapstructure:"database"`
+	Logging    LoggingConfig    `mapstructure:"logging"`
+	JWT        JWTConfig        `mapstructure:"jwt"`
+	Password   PasswordConfig   `mapstructure:"password"`
+	Login      LoginConfig      `mapstructure:"login"`
+	Pagination PaginationConfig `mapstructure:"pagination"`
+	Mail       MailConfig       `mapstructure:"mail"`
+	SMS        SMSConfig        `mapstructure:"sms"`
+
+	PasswordReset     PasswordResetConfig     `mapstructure:"password_reset"`
+	EmailVerification EmailVerificationConfig `mapstructure:"email_verification"`
+	PhoneVerification PhoneVerificationConfig `mapstructure:"phone_verification"`
+	MFA               MFAConfig               `mapstructure:"mfa"`
 }
 
 // ServerConfig holds server configuration
@@ -21,6 +31,9 @@ type ServerConfig struct {
 	ReadTimeout  time.Duration `mapstructure:"read_timeout"`
 	WriteTimeout time.Duration `mapstructure:"write_timeout"`
 	IdleTimeout  time.Duration `mapstructure:"idle_timeout"`
+	// TrustedProxies lists the proxy IPs or CIDRs whose X-Forwarded-For and X-Real-IP headers are believed
+	// for the client IP; empty trusts none and uses the connection's remote address
+	TrustedProxies []string `mapstructure:"trusted_proxies"`
 }
 
 // DatabaseConfig holds database configuration
@@ -47,6 +60,150 @@ type JWTConfig struct {
 	SecretKey     string        `mapstructure:"secret_key"`
 	AccessExpiry  time.Duration `mapstructure:"access_expiry"`
 	RefreshExpiry time.Duration `mapstructure:"refresh_expiry"`
+
+	// RevokeAllOnReuse revokes every refresh token of the user, not only the token family,
+	// when a revoked refresh token is replayed
+	RevokeAllOnReuse bool `mapstructure:"revoke_all_on_reuse"`
+
+	// SigningKey is a PEM private key (RSA, ECDSA P-256 or Ed25519) signing tokens with
+	// RS256, ES256 or EdDSA instead of HS256 with SecretKey
+	SigningKey JWTKeyConfig `mapstructure:"signing_key"`
+
+	// VerificationKeys are further PEM keys whose tokens are still accepted and published in the JWKS,
+	// e.g. the previous signing key while it is rotated out
+	VerificationKeys []JWTKeyConfig `mapstructure:"verification_keys"`
+}
+
+// JWTKeyConfig references a PEM key file
+type JWTKeyConfig struct {
+	KeyID string `mapstructure:"kid"`  // Optional, defaults to the key's RFC 7638 thumbprint
+	File  string `mapstructure:"file"` // Path to the PEM file
+}
+
+// PasswordConfig holds password hashing and password policy configuration
+type PasswordConfig struct {
+	Algorithm         string `mapstructure:"algorithm"`
+	Argon2Memory      uint32 `mapstructure:"argon2_memory"`
+	Argon2Iterations  uint32 `mapstructure:"argon2_iterations"`
+	Argon2Parallelism uint8  `mapstructure:"argon2_parallelism"`
+	Argon2SaltLength  uint32 `mapstructure:"argon2_salt_length"` // At most 16 bytes
+	Argon2KeyLength   uint32 `mapstructure:"argon2_key_length"`  // At most 128 bytes
+	BcryptCost        int    `mapstructure:"bcrypt_cost"`
+
+	MinLength     int  `mapstructure:"min_length"`
+	RequireUpper  bool `mapstructure:"require_upper"`
+	RequireLower  bool `mapstructure:"require_lower"`
+	RequireDigit  bool `mapstructure:"require_digit"`
+	RequireSymbol bool `mapstructure:"require_symbol"`
+	History       int  `mapstructure:"history"` // Previous passwords that cannot be chosen again
+
+	// MaxAge expires passwords, zero never; expired passwords get a token only valid for changing the
+	// password at login, which lasts ChangeTokenExpiry
+	MaxAge            time.Duration `mapstructure:"max_age"`
+	ChangeTokenExpiry time.Duration `mapstructure:"change_token_expiry"`
+}
+
+// LoginConfig holds brute-force protection of password logins
+// MaxAttempts failures lock an account, or a username that does not exist, for LockoutDuration; every further
+// lockout doubles up to MaxLockoutDuration. Client IPs are locked the same way after IPMaxAttempts failures.
+type LoginConfig struct {
+	MaxAttempts        int           `mapstructure:"max_attempts"`
+	Window             time.Duration `mapstructure:"window"` // Username failures older than this are forgotten
+	LockoutDuration    time.Duration `mapstructure:"lockout_duration"`
+	MaxLockoutDuration time.Duration `mapstructure:"max_lockout_duration"`
+
+	IPMaxAttempts        int           `mapstructure:"ip_max_attempts"`
+	IPWindow             time.Duration `mapstructure:"ip_window"`
+	IPLockoutDuration    time.Duration `mapstructure:"ip_lockout_duration"`
+	IPMaxLockoutDuration time.Duration `mapstructure:"ip_max_lockout_duration"`
+}
+
+// PaginationConfig holds pagination configuration
+type PaginationConfig struct {
+	CursorSecret string `mapstructure:"cursor_secret"`
+}
+
+// MailConfig holds mail delivery configuration
+type MailConfig struct {
+	Driver     string     `mapstructure:"driver"` // smtp, file or memory
+	From       string     `mapstructure:"from"`
+	Dir        string     `mapstructure:"dir"` // Output directory of the file driver
+	OutboxSize int        `mapstructure:"outbox_size"`
+	SMTP       SMTPConfig `mapstructure:"smtp"`
+}
+
+// SMTPConfig holds the SMTP relay of the smtp mail driver
+// Port 465 uses implicit TLS, other ports upgrade with STARTTLS when the server offers it.
+type SMTPConfig struct {
+	Host     string `mapstructure:"host"`
+	Port     int    `mapstructure:"port"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+}
+
+// MFAConfig holds two-factor authentication configuration
+type MFAConfig struct {
+	Issuer        string        `mapstructure:"issuer"`         // Account label shown by authenticator apps
+	Secret        string        `mapstructure:"secret"`         // Key encrypting TOTP secrets and hashing recovery codes
+	TokenExpiry   time.Duration `mapstructure:"token_expiry"`   // Lifetime of the MFA token between the two login steps
+	RecoveryCodes int           `mapstructure:"recovery_codes"` // Recovery codes issued per set
+
+	// MaxAttempts caps the codes a user may enter per AttemptWindow, across login and settings
+	MaxAttempts   int           `mapstructure:"max_attempts"`
+	AttemptWindow time.Duration `mapstructure:"attempt_window"`
+}
+
+// SMSConfig holds text message delivery configuration
+// The http driver posts to an SMS gateway, the fake driver only logs messages and appends them to File.
+type SMSConfig struct {
+	Driver  string        `mapstructure:"driver"` // http or fake
+	URL     string        `mapstructure:"url"`
+	APIKey  string        `mapstructure:"api_key"`
+	Sender  string        `mapstructure:"sender"`
+	Timeout time.Duration `mapstructure:"timeout"`
+	File    string        `mapstructure:"file"`
+}
+
+// PasswordResetConfig holds password reset configuration
+type PasswordResetConfig struct {
+	TokenExpiry time.Duration `mapstructure:"token_expiry"`
+
+	// URL is the frontend page the emailed link points to, the token is appended as ?token=
+	URL string `mapstructure:"url"`
+}
+
+// EmailVerificationConfig holds email verification configuration
+type EmailVerificationConfig struct {
+	Secret      string        `mapstructure:"secret"` // HMAC key signing verification links
+	TokenExpiry time.Duration `mapstructure:"token_expiry"`
+
+	// URL is the frontend page the emailed link points to, the token is appended as ?token=
+	URL string `mapstructure:"url"`
+
+	// AutoActivate activates PENDING accounts once their email is verified
+	AutoActivate bool `mapstructure:"auto_activate"`
+
+	// ResendInterval is the minimum time between verification emails to one address,
+	// ResendLimit caps them per ResendWindow
+	ResendInterval time.Duration `mapstructure:"resend_interval"`
+	ResendLimit    int           `mapstructure:"resend_limit"`
+	ResendWindow   time.Duration `mapstructure:"resend_window"`
+}
+
+// PhoneVerificationConfig holds phone verification configuration
+type PhoneVerificationConfig struct {
+	Secret     string        `mapstructure:"secret"` // HMAC key hashing stored codes
+	CodeLength int           `mapstructure:"code_length"`
+	CodeExpiry time.Duration `mapstructure:"code_expiry"`
+
+	// MaxAttempts is the number of entries a code allows before a new one must be requested
+	MaxAttempts int `mapstructure:"max_attempts"`
+
+	// ResendInterval is the minimum time between codes to one user,
+	// ResendLimit caps them per ResendWindow
+	ResendInterval time.Duration `mapstructure:"resend_interval"`
+	ResendLimit    int           `mapstructure:"resend_limit"`
+	ResendWindow   time.Duration `mapstructure:"resend_window"`
 }
 
 // Load loads configuration from file and environment variables
@@ -86,6 +243,7 @@ func setDefaults() {
 	viper.SetDefault("server.read_timeout", "30s")
 	viper.SetDefault("server.write_timeout", "30s")
 	viper.SetDefault("server.idle_timeout", "120s")
+	viper.SetDefault("server.trusted_proxies", []string{})
 
 	// Database defaults
 	viper.SetDefault("database.host", "192.168.7.248")
@@ -106,4 +264,77 @@ func setDefaults() {
 	viper.SetDefault("jwt.secret_key", "bm-staff-secret-key-change-in-production")
 	viper.SetDefault("jwt.access_expiry", "15m")
 	viper.SetDefault("jwt.refresh_expiry", "168h") // 7 days = 168 hours
+	viper.SetDefault("jwt.revoke_all_on_reuse", false)
+
+	// Password hashing defaults
+	viper.SetDefault("password.algorithm", "argon2id")
+	viper.SetDefault("password.argon2_memory", 64*1024) // KiB
+	viper.SetDefault("password.argon2_iterations", 3)
+	viper.SetDefault("password.argon2_parallelism", 2)
+	viper.SetDefault("password.argon2_salt_length", 16)
+	viper.SetDefault("password.argon2_key_length", 32)
+	viper.SetDefault("password.bcrypt_cost", 12)
+	viper.SetDefault("password.min_length", 8)
+	viper.SetDefault("password.require_upper", true)
+	viper.SetDefault("password.require_lower", true)
+	viper.SetDefault("password.require_digit", true)
+	viper.SetDefault("password.require_symbol", false)
+	viper.SetDefault("password.history", 5)
+	viper.SetDefault("password.max_age", "0")
+	viper.SetDefault("password.change_token_expiry", "10m")
+
+	// Login brute-force protection defaults
+	viper.SetDefault("login.max_attempts", 5)
+	viper.SetDefault("login.window", "15m")
+	viper.SetDefault("login.lockout_duration", "30m")
+	viper.SetDefault("login.max_lockout_duration", "24h")
+	viper.SetDefault("login.ip_max_attempts", 20)
+	viper.SetDefault("login.ip_window", "15m")
+	viper.SetDefault("login.ip_lockout_duration", "15m")
+	viper.SetDefault("login.ip_max_lockout_duration", "24h")
+
+	// Pagination defaults
+	viper.SetDefault("pagination.cursor_secret", "bm-staff-cursor-secret-change-in-production")
+
+	// Mail defaults, messages are written to files until an SMTP relay is configured
+	viper.SetDefault("mail.driver", "file")
+	viper.SetDefault("mail.from", "BM Staff <no-reply@bm-staff.local>")
+	viper.SetDefault("mail.dir", "./tmp/mail")
+	viper.SetDefault("mail.outbox_size", 100)
+	viper.SetDefault("mail.smtp.port", 587)
+
+	// Password reset defaults
+	viper.SetDefault("password_reset.token_expiry", "30m")
+	viper.SetDefault("password_reset.url", "http://localhost:3000/reset-password")
+
+	// Email verification defaults
+	viper.SetDefault("email_verification.secret", "bm-staff-email-verification-secret-change-in-production")
+	viper.SetDefault("email_verification.token_expiry", "48h")
+	viper.SetDefault("email_verification.url", "http://localhost:3000/verify-email")
+	viper.SetDefault("email_verification.auto_activate", false)
+	viper.SetDefault("email_verification.resend_interval", "1m")
+	viper.SetDefault("email_verification.resend_limit", 5)
+	viper.SetDefault("email_verification.resend_window", "1h")
+
+	// SMS defaults, messages are only logged until a gateway is configured
+	viper.SetDefault("sms.driver", "fake")
+	viper.SetDefault("sms.timeout", "10s")
+	viper.SetDefault("sms.file", "./tmp/sms/messages.log")
+
+	// Phone verification defaults
+	viper.SetDefault("phone_verification.secret", "bm-staff-phone-verification-secret-change-in-production")
+	viper.SetDefault("phone_verification.code_length", 6)
+	viper.SetDefault("phone_verification.code_expiry", "5m")
+	viper.SetDefault("phone_verification.max_attempts", 5)
+	viper.SetDefault("phone_verification.resend_interval", "1m")
+	viper.SetDefault("phone_verification.resend_limit", 5)
+	viper.SetDefault("phone_verification.resend_window", "1h")
+
+	// Two-factor authentication defaults
+	viper.SetDefault("mfa.issuer", "BM Staff")
+	viper.SetDefault("mfa.secret", "bm-staff-mfa-secret-change-in-production")
+	viper.SetDefault("mfa.token_expiry", "5m")
+	viper.SetDefault("mfa.recovery_codes", 10)
+	viper.SetDefault("mfa.max_attempts", 5)
+	viper.SetDefault("mfa.attempt_window", "5m")
 }
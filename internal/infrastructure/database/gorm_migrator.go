@@ -2,12 +2,15 @@ package database
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 
 	"bm-staff/internal/domain/entities"
 
 	oracle "github.com/godoes/gorm-oracle"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 	"gorm.io/gorm/schema"
@@ -56,14 +59,26 @@ func NewGORMMigrator(dsn string, logger *zap.Logger) (*GORMMigrator, error) {
 func (m *GORMMigrator) AutoMigrate(ctx context.Context) error {
 	m.logger.Info("Starting GORM auto-migration...")
 
+	// Digest legacy plaintext refresh tokens first so BMSF_REFRESH_TOKEN.TOKEN can shrink to the digest size
+	if err := m.hashRefreshTokens(ctx); err != nil {
+		return err
+	}
+
 	// Auto-migrate all entities - GORM handles everything automatically!
 	err := m.db.WithContext(ctx).AutoMigrate(
 		&entities.User{},
 		&entities.Department{},
 		&entities.Role{},
 		&entities.Permission{},
+		&entities.RolePermission{},
 		&entities.AuditLog{},
+		&entities.AuditChainHead{},
 		&entities.RefreshToken{},
+		&entities.PasswordResetToken{},
+		&entities.PhoneVerification{},
+		&entities.UserMFA{},
+		&entities.MFARecoveryCode{},
+		&entities.PasswordHistory{},
 		// Add new entities here - no code changes needed!
 	)
 
@@ -78,10 +93,211 @@ func (m *GORMMigrator) AutoMigrate(ctx context.Context) error {
 		}
 	}
 
+	if err := m.addForeignKeys(ctx); err != nil {
+		return err
+	}
+
 	m.logger.Info("GORM auto-migration completed successfully")
 	return nil
 }
 
+// addForeignKeys creates the foreign keys GORM skips because of DisableForeignKeyConstraintWhenMigrating
+func (m *GORMMigrator) addForeignKeys(ctx context.Context) error {
+	constraints := []string{
+		`ALTER TABLE BMSF_ROLE ADD CONSTRAINT FK_BMSF_ROLE_PARENT
+			FOREIGN KEY (PARENT_ID) REFERENCES BMSF_ROLE (ID)`,
+		`ALTER TABLE BMSF_ROLE_PERMISSION ADD CONSTRAINT FK_BMSF_ROLE_PERM_ROLE
+			FOREIGN KEY (ROLE_ID) REFERENCES BMSF_ROLE (ID)`,
+		`ALTER TABLE BMSF_ROLE_PERMISSION ADD CONSTRAINT FK_BMSF_ROLE_PERM_PERMISSION
+			FOREIGN KEY (PERMISSION_ID) REFERENCES BMSF_PERMISSION (ID)`,
+		`ALTER TABLE BMSF_DEPARTMENT ADD CONSTRAINT FK_BMSF_DEPARTMENT_PARENT
+			FOREIGN KEY (PARENT_ID) REFERENCES BMSF_DEPARTMENT (ID)`,
+	}
+
+	for _, constraint := range constraints {
+		if err := m.db.WithContext(ctx).Exec(constraint).Error; err != nil && !m.isExistingObjectError(err) {
+			return fmt.Errorf("failed to add foreign key: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// hashRefreshTokens replaces refresh tokens stored in plaintext with their SHA-256 digest
+// Rows already holding a digest are left untouched, so the step is safe to re-run.
+func (m *GORMMigrator) hashRefreshTokens(ctx context.Context) error {
+	db := m.db.WithContext(ctx)
+
+	if !db.Migrator().HasTable("BMSF_REFRESH_TOKEN") {
+		return nil
+	}
+
+	var legacyTokens []struct {
+		ID    uuid.UUID `gorm:"column:ID"`
+		Token string    `gorm:"column:TOKEN"`
+	}
+	if err := db.Raw(`SELECT ID, TOKEN FROM BMSF_REFRESH_TOKEN WHERE LENGTH(TOKEN) > ?`, entities.RefreshTokenHashLength).Scan(&legacyTokens).Error; err != nil {
+		return fmt.Errorf("failed to read plaintext refresh tokens: %w", err)
+	}
+	if len(legacyTokens) == 0 {
+		return nil
+	}
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		for _, legacy := range legacyTokens {
+			if err := tx.Exec(`UPDATE BMSF_REFRESH_TOKEN SET TOKEN = ? WHERE ID = ?`,
+				entities.HashRefreshToken(legacy.Token), legacy.ID).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to hash refresh tokens: %w", err)
+	}
+
+	m.logger.Info("Plaintext refresh tokens replaced with digests",
+		zap.Int("count", len(legacyTokens)))
+	return nil
+}
+
+// SeedDefaults creates the built-in permissions and system roles if they do not exist yet
+func (m *GORMMigrator) SeedDefaults(ctx context.Context) error {
+	db := m.db.WithContext(ctx)
+
+	permissions := []*entities.Permission{
+		entities.NewPermission("All permissions", entities.PermissionWildcard, entities.PermissionWildcard, entities.PermissionWildcard, "Grants every permission"),
+		entities.NewPermission("Create users", entities.PermissionUsersCreate, "users", "create", "Create user accounts"),
+		entities.NewPermission("Read users", entities.PermissionUsersRead, "users", "read", "View user accounts"),
+		entities.NewPermission("Update users", entities.PermissionUsersUpdate, "users", "update", "Update user accounts"),
+		entities.NewPermission("Delete users", entities.PermissionUsersDelete, "users", "delete", "Delete user accounts"),
+		entities.NewPermission("Unlock users", entities.PermissionUsersUnlock, "users", "unlock", "Lift lockouts after failed logins"),
+		entities.NewPermission("Read department users", entities.PermissionUsersReadDepartment, "users", "read_department", "View user accounts in the holder's department subtree"),
+		entities.NewPermission("Update department users", entities.PermissionUsersUpdateDepartment, "users", "update_department", "Update user accounts in the holder's department subtree"),
+		entities.NewPermission("Delete department users", entities.PermissionUsersDeleteDepartment, "users", "delete_department", "Delete user accounts in the holder's department subtree"),
+		entities.NewPermission("Create roles", entities.PermissionRolesCreate, "roles", "create", "Create roles"),
+		entities.NewPermission("Read roles", entities.PermissionRolesRead, "roles", "read", "View roles"),
+		entities.NewPermission("Update roles", entities.PermissionRolesUpdate, "roles", "update", "Update roles and their permissions"),
+		entities.NewPermission("Delete roles", entities.PermissionRolesDelete, "roles", "delete", "Delete roles"),
+		entities.NewPermission("Assign roles", entities.PermissionRolesAssign, "roles", "assign", "Assign roles to users"),
+		entities.NewPermission("Create permissions", entities.PermissionPermissionsCreate, "permissions", "create", "Create permissions"),
+		entities.NewPermission("Read permissions", entities.PermissionPermissionsRead, "permissions", "read", "View permissions"),
+		entities.NewPermission("Update permissions", entities.PermissionPermissionsUpdate, "permissions", "update", "Update permissions"),
+		entities.NewPermission("Delete permissions", entities.PermissionPermissionsDelete, "permissions", "delete", "Delete permissions"),
+		entities.NewPermission("Create departments", entities.PermissionDepartmentsCreate, "departments", "create", "Create departments"),
+		entities.NewPermission("Read departments", entities.PermissionDepartmentsRead, "departments", "read", "View departments and the department tree"),
+		entities.NewPermission("Update departments", entities.PermissionDepartmentsUpdate, "departments", "update", "Update and move departments"),
+		entities.NewPermission("Delete departments", entities.PermissionDepartmentsDelete, "departments", "delete", "Delete departments"),
+		entities.NewPermission("Read audit logs", entities.PermissionAuditLogsRead, "audit_logs", "read", "View, export and diff audit log records"),
+	}
+	for _, permission := range permissions {
+		if err := db.Where(&entities.Permission{Code: permission.Code}).Attrs(permission).FirstOrCreate(&entities.Permission{}).Error; err != nil {
+			return fmt.Errorf("failed to seed permission %s: %w", permission.Code, err)
+		}
+	}
+
+	var superAdmin entities.Role
+	defaults := entities.NewRole("Super Administrator", entities.RoleCodeSuperAdmin, "Full access to every resource", nil, true)
+	if err := db.Where(&entities.Role{Code: defaults.Code}).Attrs(defaults).FirstOrCreate(&superAdmin).Error; err != nil {
+		return fmt.Errorf("failed to seed role %s: %w", defaults.Code, err)
+	}
+
+	if err := m.grantPermission(db, superAdmin.ID, entities.PermissionWildcard); err != nil {
+		return fmt.Errorf("failed to seed role %s: %w", defaults.Code, err)
+	}
+
+	m.logger.Info("Default roles and permissions seeded")
+	return nil
+}
+
+// MigrateRolePermissions moves grants from the legacy BMSF_ROLE.PERMISSIONS JSON column into BMSF_ROLE_PERMISSION
+// Legacy "resource:*" wildcards are registered as permissions. The column is dropped once every
+// role has been migrated; unknown codes are logged and the column is kept so the migration can be re-run.
+func (m *GORMMigrator) MigrateRolePermissions(ctx context.Context) error {
+	db := m.db.WithContext(ctx)
+
+	if !db.Migrator().HasColumn(&entities.Role{}, "PERMISSIONS") {
+		return nil
+	}
+
+	var legacyRoles []struct {
+		ID          uuid.UUID `gorm:"column:ID"`
+		Permissions string    `gorm:"column:PERMISSIONS"`
+	}
+	if err := db.Raw(`SELECT ID, PERMISSIONS FROM BMSF_ROLE WHERE PERMISSIONS IS NOT NULL`).Scan(&legacyRoles).Error; err != nil {
+		return fmt.Errorf("failed to read legacy role permissions: %w", err)
+	}
+
+	unresolved := 0
+	err := db.Transaction(func(tx *gorm.DB) error {
+		for _, legacy := range legacyRoles {
+			var codes []string
+			if err := json.Unmarshal([]byte(legacy.Permissions), &codes); err != nil {
+				m.logger.Warn("Skipping role with malformed permissions JSON",
+					zap.String("role_id", legacy.ID.String()),
+					zap.Error(err))
+				unresolved++
+				continue
+			}
+
+			for _, code := range codes {
+				if resource, action, ok := strings.Cut(code, ":"); ok && resource != "" && action == entities.PermissionWildcard {
+					wildcard := entities.NewPermission("All "+resource+" permissions", code, resource, action, "Grants every action on "+resource)
+					if err := tx.Where(&entities.Permission{Code: code}).Attrs(wildcard).FirstOrCreate(&entities.Permission{}).Error; err != nil {
+						return fmt.Errorf("failed to register wildcard permission %s: %w", code, err)
+					}
+				}
+
+				err := m.grantPermission(tx, legacy.ID, code)
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					m.logger.Warn("Skipping unknown permission code in legacy role permissions",
+						zap.String("role_id", legacy.ID.String()),
+						zap.String("code", code))
+					unresolved++
+					continue
+				}
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to migrate role permissions: %w", err)
+	}
+
+	if unresolved > 0 {
+		m.logger.Warn("Keeping legacy BMSF_ROLE.PERMISSIONS column until unresolved codes are registered",
+			zap.Int("unresolved", unresolved))
+		return nil
+	}
+
+	if err := db.Migrator().DropColumn(&entities.Role{}, "PERMISSIONS"); err != nil {
+		return fmt.Errorf("failed to drop legacy role permissions column: %w", err)
+	}
+
+	m.logger.Info("Role permissions migrated to BMSF_ROLE_PERMISSION",
+		zap.Int("roles", len(legacyRoles)))
+	return nil
+}
+
+// grantPermission grants a registered permission code to a role if it is not granted yet
+// Returns gorm.ErrRecordNotFound when the permission code is not registered.
+func (m *GORMMigrator) grantPermission(db *gorm.DB, roleID uuid.UUID, code string) error {
+	var permission entities.Permission
+	if err := db.Where("CODE = ? AND DELETED_AT IS NULL", code).Take(&permission).Error; err != nil {
+		return err
+	}
+
+	grant := entities.NewRolePermission(roleID, permission.ID, nil)
+	if err := db.Where(&entities.RolePermission{RoleID: roleID, PermissionID: permission.ID}).FirstOrCreate(grant).Error; err != nil {
+		return fmt.Errorf("failed to grant permission %s: %w", code, err)
+	}
+
+	return nil
+}
+
 // isExistingObjectError checks if the error is due to existing database objects
 func (m *GORMMigrator) isExistingObjectError(err error) bool {
 	if err == nil {
@@ -95,6 +311,8 @@ func (m *GORMMigrator) isExistingObjectError(err error) bool {
 		"ORA-01408", // such column list already indexed
 		"ORA-00942", // table or view does not exist (for drop operations)
 		"ORA-02429", // cannot drop unique/primary key constraint
+		"ORA-02264", // name already used by an existing constraint
+		"ORA-02275", // such a referential constraint already exists in the table
 	}
 
 	for _, errorCode := range existingObjectErrors {
@@ -6,6 +6,8 @@ import (
 	"net/http"
 	"time"
 
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/services"
 	"bm-staff/internal/infrastructure/config"
 	"bm-staff/internal/interfaces/http/handlers"
 	"bm-staff/internal/interfaces/http/middleware"
@@ -25,7 +27,7 @@ type Server struct {
 }
 
 // NewServer creates a new HTTP server
-func NewServer(config *config.Config, logger *zap.Logger, userHandler *handlers.UserHandler, authHandler *handlers.AuthHandler, authMiddleware *middleware.AuthMiddleware) *Server {
+func NewServer(config *config.Config, logger *zap.Logger, userHandler *handlers.UserHandler, roleHandler *handlers.RoleHandler, permissionHandler *handlers.PermissionHandler, departmentHandler *handlers.DepartmentHandler, auditLogHandler *handlers.AuditLogHandler, sessionHandler *handlers.SessionHandler, meHandler *handlers.MeHandler, passwordResetHandler *handlers.PasswordResetHandler, emailVerificationHandler *handlers.EmailVerificationHandler, mfaHandler *handlers.MFAHandler, authHandler *handlers.AuthHandler, authMiddleware *middleware.AuthMiddleware) (*Server, error) {
 	// Set Gin mode
 	if config.Logging.Level == "debug" {
 		gin.SetMode(gin.DebugMode)
@@ -36,22 +38,27 @@ func NewServer(config *config.Config, logger *zap.Logger, userHandler *handlers.
 	// Create Gin engine
 	engine := gin.New()
 
+	// Client IPs key the login throttle, only take them from headers set by our own proxies
+	if err := engine.SetTrustedProxies(config.Server.TrustedProxies); err != nil {
+		return nil, fmt.Errorf("invalid server.trusted_proxies: %w", err)
+	}
+
 	// Add middleware
 	engine.Use(gin.Recovery())
 	engine.Use(LoggerMiddleware(logger))
 
 	// Setup routes
-	setupRoutes(engine, userHandler, authHandler, authMiddleware)
+	setupRoutes(engine, userHandler, roleHandler, permissionHandler, departmentHandler, auditLogHandler, sessionHandler, meHandler, passwordResetHandler, emailVerificationHandler, mfaHandler, authHandler, authMiddleware)
 
 	return &Server{
 		config:  config,
 		logger:  logger,
 		handler: engine,
-	}
+	}, nil
 }
 
 // setupRoutes sets up all HTTP routes
-func setupRoutes(engine *gin.Engine, userHandler *handlers.UserHandler, authHandler *handlers.AuthHandler, authMiddleware *middleware.AuthMiddleware) {
+func setupRoutes(engine *gin.Engine, userHandler *handlers.UserHandler, roleHandler *handlers.RoleHandler, permissionHandler *handlers.PermissionHandler, departmentHandler *handlers.DepartmentHandler, auditLogHandler *handlers.AuditLogHandler, sessionHandler *handlers.SessionHandler, meHandler *handlers.MeHandler, passwordResetHandler *handlers.PasswordResetHandler, emailVerificationHandler *handlers.EmailVerificationHandler, mfaHandler *handlers.MFAHandler, authHandler *handlers.AuthHandler, authMiddleware *middleware.AuthMiddleware) {
 	// Swagger documentation
 	engine.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
@@ -63,6 +70,9 @@ func setupRoutes(engine *gin.Engine, userHandler *handlers.UserHandler, authHand
 		})
 	})
 
+	// Public keys for verifying our tokens
+	engine.GET("/.well-known/jwks.json", authHandler.JWKS)
+
 	// API v1 routes
 	v1 := engine.Group("/api/v1")
 	{
@@ -70,19 +80,109 @@ func setupRoutes(engine *gin.Engine, userHandler *handlers.UserHandler, authHand
 		auth := v1.Group("/auth")
 		{
 			auth.POST("/login", authHandler.Login)
+			auth.POST("/login/mfa", authHandler.LoginMFA)
 			auth.POST("/logout", authHandler.Logout)
 			auth.POST("/refresh", authHandler.RefreshToken)
+			auth.POST("/password/forgot", passwordResetHandler.ForgotPassword)
+			auth.POST("/password/reset", passwordResetHandler.ResetPassword)
+			auth.POST("/email/verify", emailVerificationHandler.VerifyEmail)
+			auth.POST("/email/resend", emailVerificationHandler.ResendVerificationEmail)
+
+			// Sessions of the current user (protected)
+			auth.GET("/sessions", authMiddleware.RequireAuth(), sessionHandler.ListMySessions)
+			auth.DELETE("/sessions", authMiddleware.RequireAuth(), sessionHandler.RevokeAllMySessions)
+			auth.DELETE("/sessions/:id", authMiddleware.RequireAuth(), sessionHandler.RevokeMySession)
+		}
+
+		// Expired passwords are changed with the restricted token handed out at login
+		v1.PUT("/me/password", authMiddleware.RequireAuth(services.TokenScopePasswordChange), meHandler.ChangeMyPassword)
+
+		// Self-service routes of the current user (protected)
+		me := v1.Group("/me")
+		me.Use(authMiddleware.RequireAuth())
+		{
+			me.GET("", meHandler.GetMe)
+			me.PATCH("", meHandler.UpdateMe)
+			me.PUT("/preferences", meHandler.UpdateMyPreferences)
+			me.POST("/phone/verification", meHandler.SendMyPhoneVerification)
+			me.POST("/phone/verify", meHandler.VerifyMyPhone)
+			me.GET("/mfa", mfaHandler.GetMFAStatus)
+			me.POST("/mfa/totp", mfaHandler.EnrollTOTP)
+			me.POST("/mfa/totp/confirm", mfaHandler.ConfirmTOTP)
+			me.POST("/mfa/recovery-codes", mfaHandler.RegenerateRecoveryCodes)
+			me.POST("/mfa/disable", mfaHandler.DisableMFA)
 		}
 
 		// User routes (protected)
 		users := v1.Group("/users")
 		users.Use(authMiddleware.RequireAuth()) // Require authentication
 		{
-			users.POST("", userHandler.CreateUser)
+			users.POST("", authMiddleware.RequirePermission(entities.PermissionUsersCreate), userHandler.CreateUser)
 			users.GET("/:id", userHandler.GetUser)
-			users.PUT("/:id", userHandler.UpdateUser)
-			users.DELETE("/:id", userHandler.DeleteUser)
-			users.GET("", userHandler.ListUsers)
+			users.PUT("/:id", userHandler.UpdateUser)    // Access decided by user policies
+			users.DELETE("/:id", userHandler.DeleteUser) // Access decided by user policies
+			users.GET("", authMiddleware.RequirePermission(entities.PermissionUsersRead), userHandler.ListUsers)
+			users.PUT("/:id/role", authMiddleware.RequirePermission(entities.PermissionRolesAssign), userHandler.AssignRole)
+			users.PUT("/:id/organization", userHandler.UpdateOrganization) // Access decided by user policies
+			users.PUT("/:id/status", userHandler.UpdateUserStatus)         // Access decided by user policies
+			users.POST("/:id/unlock", authMiddleware.RequirePermission(entities.PermissionUsersUnlock), userHandler.UnlockUser)
+			users.GET("/:id/reports", userHandler.GetReports)
+			users.GET("/:id/manager-chain", userHandler.GetManagerChain)
+			users.GET("/:id/sessions", sessionHandler.ListUserSessions)                 // Access decided by user policies
+			users.DELETE("/:id/sessions", sessionHandler.RevokeAllUserSessions)         // Access decided by user policies
+			users.DELETE("/:id/sessions/:session_id", sessionHandler.RevokeUserSession) // Access decided by user policies
+			users.GET("/:id/history", authMiddleware.RequirePermission(entities.PermissionAuditLogsRead), auditLogHandler.GetUserHistory)
+		}
+
+		// Org chart export (protected)
+		v1.GET("/org-chart", authMiddleware.RequireAuth(), authMiddleware.RequirePermission(entities.PermissionUsersRead), userHandler.GetOrgChart)
+
+		// Role routes (protected)
+		roles := v1.Group("/roles")
+		roles.Use(authMiddleware.RequireAuth())
+		{
+			roles.POST("", authMiddleware.RequirePermission(entities.PermissionRolesCreate), roleHandler.CreateRole)
+			roles.GET("/:id", authMiddleware.RequirePermission(entities.PermissionRolesRead), roleHandler.GetRole)
+			roles.PUT("/:id", authMiddleware.RequirePermission(entities.PermissionRolesUpdate), roleHandler.UpdateRole)
+			roles.DELETE("/:id", authMiddleware.RequirePermission(entities.PermissionRolesDelete), roleHandler.DeleteRole)
+			roles.GET("", authMiddleware.RequirePermission(entities.PermissionRolesRead), roleHandler.ListRoles)
+			roles.PUT("/:id/permissions", authMiddleware.RequirePermission(entities.PermissionRolesUpdate), roleHandler.SetRolePermissions)
+			roles.DELETE("/:id/permissions/:code", authMiddleware.RequirePermission(entities.PermissionRolesUpdate), roleHandler.RevokeRolePermission)
+			roles.GET("/:id/effective-permissions", authMiddleware.RequirePermission(entities.PermissionRolesRead), roleHandler.GetEffectivePermissions)
+		}
+
+		// Permission routes (protected)
+		permissions := v1.Group("/permissions")
+		permissions.Use(authMiddleware.RequireAuth())
+		{
+			permissions.POST("", authMiddleware.RequirePermission(entities.PermissionPermissionsCreate), permissionHandler.CreatePermission)
+			permissions.GET("/:id", authMiddleware.RequirePermission(entities.PermissionPermissionsRead), permissionHandler.GetPermission)
+			permissions.PUT("/:id", authMiddleware.RequirePermission(entities.PermissionPermissionsUpdate), permissionHandler.UpdatePermission)
+			permissions.DELETE("/:id", authMiddleware.RequirePermission(entities.PermissionPermissionsDelete), permissionHandler.DeletePermission)
+			permissions.GET("", authMiddleware.RequirePermission(entities.PermissionPermissionsRead), permissionHandler.ListPermissions)
+		}
+
+		// Department routes (protected)
+		departments := v1.Group("/departments")
+		departments.Use(authMiddleware.RequireAuth())
+		{
+			departments.POST("", authMiddleware.RequirePermission(entities.PermissionDepartmentsCreate), departmentHandler.CreateDepartment)
+			departments.GET("/:id", authMiddleware.RequirePermission(entities.PermissionDepartmentsRead), departmentHandler.GetDepartment)
+			departments.PUT("/:id", authMiddleware.RequirePermission(entities.PermissionDepartmentsUpdate), departmentHandler.UpdateDepartment)
+			departments.DELETE("/:id", authMiddleware.RequirePermission(entities.PermissionDepartmentsDelete), departmentHandler.DeleteDepartment)
+			departments.GET("", authMiddleware.RequirePermission(entities.PermissionDepartmentsRead), departmentHandler.ListDepartments)
+			departments.GET("/:id/children", authMiddleware.RequirePermission(entities.PermissionDepartmentsRead), departmentHandler.ListDepartmentChildren)
+			departments.GET("/:id/subtree", authMiddleware.RequirePermission(entities.PermissionDepartmentsRead), departmentHandler.GetDepartmentSubtree)
+			departments.PUT("/:id/parent", authMiddleware.RequirePermission(entities.PermissionDepartmentsUpdate), departmentHandler.MoveDepartment)
+		}
+
+		// Audit log routes (protected)
+		auditLogs := v1.Group("/audit-logs")
+		auditLogs.Use(authMiddleware.RequireAuth(), authMiddleware.RequirePermission(entities.PermissionAuditLogsRead))
+		{
+			auditLogs.GET("", auditLogHandler.ListAuditLogs)
+			auditLogs.GET("/export", auditLogHandler.ExportAuditLogs)
+			auditLogs.GET("/verify", auditLogHandler.VerifyAuditChain)
 		}
 	}
 }
@@ -0,0 +1,58 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"bm-staff/internal/domain/services"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// FileSender writes each message as an .eml file instead of delivering it, for local development
+type FileSender struct {
+	dir    string
+	from   string
+	logger *zap.Logger
+}
+
+// NewFileSender creates a new file mail sender writing into dir
+func NewFileSender(dir, from string, logger *zap.Logger) (*FileSender, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("mail directory is required for the file driver")
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create mail directory: %w", err)
+	}
+
+	return &FileSender{
+		dir:    dir,
+		from:   from,
+		logger: logger,
+	}, nil
+}
+
+// Send writes the message to <dir>/<timestamp>-<id>.eml
+func (s *FileSender) Send(ctx context.Context, message services.MailMessage) error {
+	data, err := buildMessage(s.from, message)
+	if err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%s-%s.eml", time.Now().Format("20060102T150405.000"), uuid.New())
+	path := filepath.Join(s.dir, name)
+	if err := os.WriteFile(path, data, 0o640); err != nil {
+		return fmt.Errorf("failed to write mail file: %w", err)
+	}
+
+	s.logger.Info("Mail written to file",
+		zap.String("path", path),
+		zap.Int("recipients", len(message.To)),
+	)
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package mail
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	netmail "net/mail"
+	"strings"
+	"time"
+
+	"bm-staff/internal/domain/services"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Supported mail drivers
+const (
+	DriverSMTP   = "smtp"
+	DriverFile   = "file"
+	DriverMemory = "memory"
+)
+
+// Config holds mail delivery configuration
+type Config struct {
+	Driver   string
+	From     string
+	Host     string
+	Port     int
+	Username string
+	Password string
+	Dir      string // Output directory of the file driver
+}
+
+// NewSender creates the mail sender for the configured driver
+func NewSender(config Config, logger *zap.Logger) (services.MailSender, error) {
+	if _, err := netmail.ParseAddress(config.From); err != nil {
+		return nil, fmt.Errorf("invalid mail sender address %q: %w", config.From, err)
+	}
+
+	switch strings.ToLower(config.Driver) {
+	case DriverSMTP:
+		return NewSMTPSender(config), nil
+	case "", DriverFile:
+		return NewFileSender(config.Dir, config.From, logger)
+	case DriverMemory:
+		return NewMemorySender(logger), nil
+	default:
+		return nil, fmt.Errorf("unsupported mail driver: %s", config.Driver)
+	}
+}
+
+// buildMessage renders a message in RFC 5322 format with a quoted-printable UTF-8 body
+func buildMessage(from string, message services.MailMessage) ([]byte, error) {
+	if len(message.To) == 0 {
+		return nil, fmt.Errorf("mail message has no recipients")
+	}
+
+	// Parsing the recipients also keeps CR/LF out of the headers
+	recipients := make([]string, 0, len(message.To))
+	for _, to := range message.To {
+		address, err := netmail.ParseAddress(to)
+		if err != nil {
+			return nil, fmt.Errorf("invalid recipient address %q: %w", to, err)
+		}
+		recipients = append(recipients, address.String())
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", strings.Join(recipients, ", "))
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", message.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(&buf, "Message-ID: <%s@%s>\r\n", uuid.New(), domainOf(from))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n")
+	buf.WriteString("\r\n")
+
+	body := quotedprintable.NewWriter(&buf)
+	if _, err := body.Write([]byte(message.Body)); err != nil {
+		return nil, fmt.Errorf("failed to encode mail body: %w", err)
+	}
+	if err := body.Close(); err != nil {
+		return nil, fmt.Errorf("failed to encode mail body: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// domainOf returns the domain part of an email address, used for Message-ID
+func domainOf(address string) string {
+	if parsed, err := netmail.ParseAddress(address); err == nil {
+		address = parsed.Address
+	}
+	if _, domain, ok := strings.Cut(address, "@"); ok {
+		return domain
+	}
+	return "localhost"
+}
@@ -0,0 +1,45 @@
+package mail
+
+import (
+	"context"
+	"sync"
+
+	"bm-staff/internal/domain/services"
+
+	"go.uber.org/zap"
+)
+
+// MemorySender keeps messages in memory instead of delivering them, for tests and local runs
+type MemorySender struct {
+	mu       sync.Mutex
+	messages []services.MailMessage
+	logger   *zap.Logger
+}
+
+// NewMemorySender creates a new in-memory mail sender
+func NewMemorySender(logger *zap.Logger) *MemorySender {
+	return &MemorySender{logger: logger}
+}
+
+// Send stores the message
+func (s *MemorySender) Send(ctx context.Context, message services.MailMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.messages = append(s.messages, message)
+	s.logger.Debug("Mail kept in memory",
+		zap.String("subject", message.Subject),
+		zap.Int("recipients", len(message.To)),
+	)
+	return nil
+}
+
+// Messages returns the messages sent so far, oldest first
+func (s *MemorySender) Messages() []services.MailMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	messages := make([]services.MailMessage, len(s.messages))
+	copy(messages, s.messages)
+	return messages
+}
@@ -0,0 +1,115 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"bm-staff/internal/domain/services"
+
+	"go.uber.org/zap"
+)
+
+// Outbox delivery tuning
+const (
+	outboxSendTimeout = 30 * time.Second
+	outboxMaxAttempts = 3
+	outboxRetryDelay  = 2 * time.Second
+)
+
+// Outbox queues messages and delivers them in the background through another sender
+// Callers return without waiting on the mail server, so response times do not reveal whether a
+// message was sent; delivery failures are retried and then logged.
+type Outbox struct {
+	sender services.MailSender
+	logger *zap.Logger
+	queue  chan services.MailMessage
+	mu     sync.RWMutex
+	closed bool
+	done   chan struct{}
+}
+
+// NewOutbox creates a new outbox holding up to size pending messages and starts its worker
+func NewOutbox(sender services.MailSender, logger *zap.Logger, size int) *Outbox {
+	outbox := &Outbox{
+		sender: sender,
+		logger: logger,
+		queue:  make(chan services.MailMessage, size),
+		done:   make(chan struct{}),
+	}
+	go outbox.run()
+	return outbox
+}
+
+// Send queues the message for delivery, failing only when the outbox is full or closed
+func (o *Outbox) Send(ctx context.Context, message services.MailMessage) error {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	if o.closed {
+		return fmt.Errorf("mail outbox is closed")
+	}
+
+	select {
+	case o.queue <- message:
+		return nil
+	default:
+		return fmt.Errorf("mail outbox is full")
+	}
+}
+
+// Close stops accepting messages and waits until the queued ones are delivered or ctx is done
+func (o *Outbox) Close(ctx context.Context) error {
+	o.mu.Lock()
+	if !o.closed {
+		o.closed = true
+		close(o.queue)
+	}
+	o.mu.Unlock()
+
+	select {
+	case <-o.done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("mail outbox closed with pending messages: %w", ctx.Err())
+	}
+}
+
+// run delivers queued messages until the outbox is closed
+func (o *Outbox) run() {
+	defer close(o.done)
+
+	for message := range o.queue {
+		o.deliver(message)
+	}
+}
+
+// deliver sends one message, retrying failed attempts
+// The body is never logged since it may carry tokens.
+func (o *Outbox) deliver(message services.MailMessage) {
+	var err error
+	for attempt := 1; attempt <= outboxMaxAttempts; attempt++ {
+		ctx, cancel := context.WithTimeout(context.Background(), outboxSendTimeout)
+		err = o.sender.Send(ctx, message)
+		cancel()
+		if err == nil {
+			return
+		}
+
+		o.logger.Warn("Failed to send mail",
+			zap.String("subject", message.Subject),
+			zap.Int("attempt", attempt),
+			zap.Error(err),
+		)
+		if attempt < outboxMaxAttempts {
+			time.Sleep(outboxRetryDelay * time.Duration(attempt))
+		}
+	}
+
+	o.logger.Error("Giving up on mail delivery",
+		zap.String("subject", message.Subject),
+		zap.Int("recipients", len(message.To)),
+		zap.Error(err),
+	)
+}
@@ -0,0 +1,123 @@
+package mail
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	netmail "net/mail"
+	"net/smtp"
+	"strconv"
+
+	"bm-staff/internal/domain/services"
+)
+
+// smtpsPort is the port of SMTP over implicit TLS; other ports upgrade with STARTTLS when offered
+const smtpsPort = 465
+
+// SMTPSender delivers mail through an SMTP relay
+type SMTPSender struct {
+	config Config
+}
+
+// NewSMTPSender creates a new SMTP mail sender
+func NewSMTPSender(config Config) *SMTPSender {
+	return &SMTPSender{config: config}
+}
+
+// Send delivers a message through the SMTP relay
+func (s *SMTPSender) Send(ctx context.Context, message services.MailMessage) error {
+	data, err := buildMessage(s.config.From, message)
+	if err != nil {
+		return err
+	}
+
+	client, err := s.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if s.config.Port != smtpsPort {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err := client.StartTLS(&tls.Config{ServerName: s.config.Host}); err != nil {
+				return fmt.Errorf("failed to start TLS: %w", err)
+			}
+		}
+	}
+
+	if s.config.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", s.config.Username, s.config.Password, s.config.Host)); err != nil {
+			return fmt.Errorf("failed to authenticate with SMTP server: %w", err)
+		}
+	}
+
+	from, err := parseAddress(s.config.From)
+	if err != nil {
+		return err
+	}
+	if err := client.Mail(from); err != nil {
+		return fmt.Errorf("failed to set mail sender: %w", err)
+	}
+	for _, to := range message.To {
+		recipient, err := parseAddress(to)
+		if err != nil {
+			return err
+		}
+		if err := client.Rcpt(recipient); err != nil {
+			return fmt.Errorf("failed to add mail recipient: %w", err)
+		}
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("failed to start mail data: %w", err)
+	}
+	if _, err := writer.Write(data); err != nil {
+		return fmt.Errorf("failed to write mail data: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("failed to send mail: %w", err)
+	}
+
+	return client.Quit()
+}
+
+// dial connects to the SMTP relay, bounded by the context deadline
+func (s *SMTPSender) dial(ctx context.Context) (*smtp.Client, error) {
+	address := net.JoinHostPort(s.config.Host, strconv.Itoa(s.config.Port))
+
+	var conn net.Conn
+	var err error
+	if s.config.Port == smtpsPort {
+		dialer := &tls.Dialer{Config: &tls.Config{ServerName: s.config.Host}}
+		conn, err = dialer.DialContext(ctx, "tcp", address)
+	} else {
+		var dialer net.Dialer
+		conn, err = dialer.DialContext(ctx, "tcp", address)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to SMTP server: %w", err)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, s.config.Host)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to start SMTP session: %w", err)
+	}
+
+	return client, nil
+}
+
+// parseAddress returns the bare address of an RFC 5322 address for the SMTP envelope
+func parseAddress(address string) (string, error) {
+	parsed, err := netmail.ParseAddress(address)
+	if err != nil {
+		return "", fmt.Errorf("invalid mail address %q: %w", address, err)
+	}
+	return parsed.Address, nil
+}
@@ -0,0 +1,71 @@
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// FakeSender logs text messages instead of sending them, for local development and tests
+// With a file, each message is also appended to it as one JSON line.
+type FakeSender struct {
+	mu     sync.Mutex
+	file   string
+	logger *zap.Logger
+}
+
+// fakeMessage is the JSON line written for each message
+type fakeMessage struct {
+	To      string    `json:"to"`
+	Message string    `json:"message"`
+	SentAt  time.Time `json:"sent_at"`
+}
+
+// NewFakeSender creates a new fake SMS sender
+func NewFakeSender(file string, logger *zap.Logger) *FakeSender {
+	return &FakeSender{
+		file:   file,
+		logger: logger,
+	}
+}
+
+// Send logs the message and appends it to the file, if any
+func (s *FakeSender) Send(ctx context.Context, to, message string) error {
+	s.logger.Info("Fake SMS sent",
+		zap.String("to", to),
+		zap.String("message", message),
+	)
+
+	if s.file == "" {
+		return nil
+	}
+
+	line, err := json.Marshal(fakeMessage{To: to, Message: message, SentAt: time.Now()})
+	if err != nil {
+		return fmt.Errorf("failed to encode SMS: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(s.file), 0o750); err != nil {
+		return fmt.Errorf("failed to create SMS directory: %w", err)
+	}
+	f, err := os.OpenFile(s.file, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o640)
+	if err != nil {
+		return fmt.Errorf("failed to open SMS file: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write SMS file: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package sms
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// HTTPGateway sends text messages through a JSON HTTP gateway
+// Request: POST {url} with Authorization: Bearer {api key} and body {"to", "from", "message"}; any 2xx is success.
+type HTTPGateway struct {
+	config Config
+	client *http.Client
+}
+
+// gatewayRequest is the JSON body posted to the gateway
+type gatewayRequest struct {
+	To      string `json:"to"`
+	From    string `json:"from,omitempty"`
+	Message string `json:"message"`
+}
+
+// NewHTTPGateway creates a new HTTP SMS gateway client
+func NewHTTPGateway(config Config) *HTTPGateway {
+	return &HTTPGateway{
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+	}
+}
+
+// Send posts a text message to the gateway
+func (g *HTTPGateway) Send(ctx context.Context, to, message string) error {
+	body, err := json.Marshal(gatewayRequest{To: to, From: g.config.Sender, Message: message})
+	if err != nil {
+		return fmt.Errorf("failed to encode SMS request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, g.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create SMS request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if g.config.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+g.config.APIKey)
+	}
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach SMS gateway: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("SMS gateway returned %d: %s", resp.StatusCode, bytes.TrimSpace(detail))
+	}
+
+	return nil
+}
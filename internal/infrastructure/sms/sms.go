@@ -0,0 +1,42 @@
+package sms
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"bm-staff/internal/domain/services"
+
+	"go.uber.org/zap"
+)
+
+// Supported SMS drivers
+const (
+	DriverHTTP = "http"
+	DriverFake = "fake"
+)
+
+// Config holds SMS delivery configuration
+type Config struct {
+	Driver  string
+	URL     string        // Gateway endpoint of the http driver
+	APIKey  string        // Sent as a bearer token to the gateway
+	Sender  string        // Sender name or number passed to the gateway
+	Timeout time.Duration // Gateway request timeout
+	File    string        // Output file of the fake driver; messages are only logged when empty
+}
+
+// NewSender creates the SMS sender for the configured driver
+func NewSender(config Config, logger *zap.Logger) (services.SMSSender, error) {
+	switch strings.ToLower(config.Driver) {
+	case DriverHTTP:
+		if config.URL == "" {
+			return nil, fmt.Errorf("SMS gateway URL is required for the http driver")
+		}
+		return NewHTTPGateway(config), nil
+	case "", DriverFake:
+		return NewFakeSender(config.File, logger), nil
+	default:
+		return nil, fmt.Errorf("unsupported SMS driver: %s", config.Driver)
+	}
+}
@@ -0,0 +1,241 @@
+package handlers
+
+import (
+	"net/http"
+
+	"bm-staff/internal/domain/services"
+	"bm-staff/internal/usecases/audit"
+	"bm-staff/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+// AuditLogHandler handles HTTP requests for audit log operations
+type AuditLogHandler struct {
+	listAuditLogsUseCase      *audit.ListAuditLogsUseCase
+	exportAuditLogsUseCase    *audit.ExportAuditLogsUseCase
+	getResourceHistoryUseCase *audit.GetResourceHistoryUseCase
+	verifyAuditChainUseCase   *audit.VerifyAuditChainUseCase
+	validator                 *validator.Validate
+	logger                    *zap.Logger
+}
+
+// NewAuditLogHandler creates a new audit log handler
+func NewAuditLogHandler(
+	listAuditLogsUseCase *audit.ListAuditLogsUseCase,
+	exportAuditLogsUseCase *audit.ExportAuditLogsUseCase,
+	getResourceHistoryUseCase *audit.GetResourceHistoryUseCase,
+	verifyAuditChainUseCase *audit.VerifyAuditChainUseCase,
+	validator *validator.Validate,
+	logger *zap.Logger,
+) *AuditLogHandler {
+	return &AuditLogHandler{
+		listAuditLogsUseCase:      listAuditLogsUseCase,
+		exportAuditLogsUseCase:    exportAuditLogsUseCase,
+		getResourceHistoryUseCase: getResourceHistoryUseCase,
+		verifyAuditChainUseCase:   verifyAuditChainUseCase,
+		validator:                 validator,
+		logger:                    logger,
+	}
+}
+
+// ListAuditLogs handles GET /api/v1/audit-logs
+// @Summary      List audit logs
+// @Description  List audit log records, newest first by default, with filters and keyset pagination
+// @Tags         audit-logs
+// @Accept       json
+// @Produce      json
+// @Param        user_id query string false "Acting user ID"
+// @Param        resource query string false "Resource, e.g. users"
+// @Param        resource_id query string false "Resource ID"
+// @Param        action query string false "Action, e.g. UPDATE"
+// @Param        from query string false "Earliest timestamp (RFC3339)"
+// @Param        to query string false "Latest timestamp (RFC3339)"
+// @Param        sort_order query string false "Sort order" Enums(asc, desc) default(desc)
+// @Param        limit query int false "Page size" default(10)
+// @Param        cursor query string false "Opaque next_cursor of the previous page"
+// @Success      200 {object} map[string]interface{} "Audit logs retrieved successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - invalid filters or cursor"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /audit-logs [get]
+func (h *AuditLogHandler) ListAuditLogs(c *gin.Context) {
+	var req audit.ListAuditLogsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.logger.Error("Failed to bind query", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid query parameters", err)
+		return
+	}
+
+	// Validate request
+	if err := h.validator.Struct(&req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationRange, "Validation failed", err)
+		return
+	}
+
+	// Execute use case
+	resp, err := h.listAuditLogsUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resp,
+	})
+}
+
+// ExportAuditLogs handles GET /api/v1/audit-logs/export
+// @Summary      Export audit logs
+// @Description  Stream every audit log record matching the filters as CSV or newline-delimited JSON
+// @Tags         audit-logs
+// @Accept       json
+// @Produce      text/csv
+// @Produce      application/x-ndjson
+// @Param        user_id query string false "Acting user ID"
+// @Param        resource query string false "Resource, e.g. users"
+// @Param        resource_id query string false "Resource ID"
+// @Param        action query string false "Action, e.g. UPDATE"
+// @Param        from query string false "Earliest timestamp (RFC3339)"
+// @Param        to query string false "Latest timestamp (RFC3339)"
+// @Param        sort_order query string false "Sort order" Enums(asc, desc) default(desc)
+// @Param        format query string false "Export format" Enums(csv, ndjson) default(csv)
+// @Success      200 {string} string "Audit log export"
+// @Failure      400 {object} map[string]interface{} "Bad request - invalid filters or format"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /audit-logs/export [get]
+func (h *AuditLogHandler) ExportAuditLogs(c *gin.Context) {
+	var req audit.ExportAuditLogsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.logger.Error("Failed to bind query", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid query parameters", err)
+		return
+	}
+
+	// Validate request
+	if err := h.validator.Struct(&req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Validation failed", err)
+		return
+	}
+
+	// Execute use case
+	resp, err := h.exportAuditLogsUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Header("Content-Type", resp.ContentType)
+	c.Header("Content-Disposition", `attachment; filename="`+resp.FileName+`"`)
+	c.Status(http.StatusOK)
+
+	// The status line is already sent, a failure can only cut the stream short
+	if err := resp.Write(c.Request.Context(), c.Writer); err != nil {
+		h.logger.Error("Failed to stream audit log export",
+			zap.String("format", resp.Format),
+			zap.Error(err),
+		)
+	}
+}
+
+// VerifyAuditChain handles GET /api/v1/audit-logs/verify
+// @Summary      Verify audit hash chain
+// @Description  Walk the tamper-evident hash chain of a tenant's audit records and report the first broken link
+// @Tags         audit-logs
+// @Accept       json
+// @Produce      json
+// @Param        tenant_id query string false "Tenant ID, records without a tenant when omitted"
+// @Success      200 {object} map[string]interface{} "Chain verified, see valid and broken_link"
+// @Failure      400 {object} map[string]interface{} "Bad request - invalid tenant ID"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /audit-logs/verify [get]
+func (h *AuditLogHandler) VerifyAuditChain(c *gin.Context) {
+	var req audit.VerifyAuditChainRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.logger.Error("Failed to bind query", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid query parameters", err)
+		return
+	}
+
+	// Validate request
+	if err := h.validator.Struct(&req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid tenant ID format", err)
+		return
+	}
+
+	// Execute use case
+	resp, err := h.verifyAuditChainUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	if !resp.Valid {
+		h.logger.Warn("Audit chain verification failed",
+			zap.String("chain_key", resp.ChainKey),
+			zap.Int64("sequence", resp.BrokenLink.Sequence),
+			zap.String("reason", resp.BrokenLink.Reason),
+		)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resp,
+	})
+}
+
+// GetUserHistory handles GET /api/v1/users/:id/history
+// @Summary      Get user change history
+// @Description  Retrieve the audited changes of a user, newest first, with field-level diffs
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "User ID"
+// @Param        limit query int false "Page size" default(10)
+// @Param        cursor query string false "Opaque next_cursor of the previous page"
+// @Success      200 {object} map[string]interface{} "User history retrieved successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - invalid user ID or cursor"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /users/{id}/history [get]
+func (h *AuditLogHandler) GetUserHistory(c *gin.Context) {
+	var req audit.GetResourceHistoryRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.logger.Error("Failed to bind query", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid query parameters", err)
+		return
+	}
+
+	// Set resource and ID from URL parameter
+	req.Resource = services.AuditResourceUsers
+	req.ID = c.Param("id")
+
+	// Validate request
+	if err := h.validator.Struct(&req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Validation failed", err)
+		return
+	}
+
+	// Execute use case
+	resp, err := h.getResourceHistoryUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resp,
+	})
+}
+
+// handleError handles application errors and returns appropriate HTTP responses
+func (h *AuditLogHandler) handleError(c *gin.Context, err error) {
+	respondError(c, h.logger, err)
+}
@@ -17,6 +17,7 @@ type AuthHandler struct {
 	loginUseCase        *auth.LoginUseCase
 	logoutUseCase       *auth.LogoutUseCase
 	refreshTokenUseCase *auth.RefreshTokenUseCase
+	getJWKSUseCase      *auth.GetJWKSUseCase
 	validator           *validator.Validate
 	logger              *zap.Logger
 }
@@ -26,6 +27,7 @@ func NewAuthHandler(
 	loginUseCase *auth.LoginUseCase,
 	logoutUseCase *auth.LogoutUseCase,
 	refreshTokenUseCase *auth.RefreshTokenUseCase,
+	getJWKSUseCase *auth.GetJWKSUseCase,
 	validator *validator.Validate,
 	logger *zap.Logger,
 ) *AuthHandler {
@@ -33,6 +35,7 @@ func NewAuthHandler(
 		loginUseCase:        loginUseCase,
 		logoutUseCase:       logoutUseCase,
 		refreshTokenUseCase: refreshTokenUseCase,
+		getJWKSUseCase:      getJWKSUseCase,
 		validator:           validator,
 		logger:              logger,
 	}
@@ -40,7 +43,7 @@ func NewAuthHandler(
 
 // Login handles POST /api/v1/auth/login
 // @Summary      User login
-// @Description  Authenticate user with username and password
+// @Description  Authenticate user with username and password; users with two-factor authentication get an MFA token to complete the login with instead of tokens, and users with an expired password get a token that can only change it
 // @Tags         auth
 // @Accept       json
 // @Produce      json
@@ -48,7 +51,7 @@ func NewAuthHandler(
 // @Success      200 {object} map[string]interface{} "Login successful"
 // @Failure      400 {object} map[string]interface{} "Bad request - validation error"
 // @Failure      401 {object} map[string]interface{} "Unauthorized - invalid credentials"
-// @Failure      423 {object} map[string]interface{} "Account locked"
+// @Failure      429 {object} map[string]interface{} "Too many failed logins or account locked"
 // @Failure      500 {object} map[string]interface{} "Internal server error"
 // @Router       /auth/login [post]
 func (h *AuthHandler) Login(c *gin.Context) {
@@ -79,32 +82,87 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	response, err := h.loginUseCase.Execute(c.Request.Context(), &req, ipAddress, userAgent)
 	if err != nil {
 		h.logger.Error("Login failed", zap.Error(err))
+		h.respondLoginError(c, err)
+		return
+	}
 
-		if appErr, ok := err.(*errors.AppError); ok {
-			switch appErr.Code {
-			case "AUTH_001":
-				c.JSON(http.StatusUnauthorized, gin.H{
-					"error": appErr.Message,
-				})
-			case "AUTH_002":
-				c.JSON(http.StatusLocked, gin.H{
-					"error":   appErr.Message,
-					"details": appErr.Details,
-				})
-			case "AUTH_003":
-				c.JSON(http.StatusUnauthorized, gin.H{
-					"error": appErr.Message,
-				})
-			default:
-				c.JSON(http.StatusInternalServerError, gin.H{
-					"error": "Internal server error",
-				})
-			}
-		} else {
-			c.JSON(http.StatusInternalServerError, gin.H{
-				"error": "Internal server error",
-			})
-		}
+	// Users with two-factor authentication continue with POST /auth/login/mfa
+	if response.MFAToken != "" {
+		c.JSON(http.StatusOK, gin.H{
+			"message": "Two-factor authentication required",
+			"data": gin.H{
+				"mfa_required": true,
+				"mfa_token":    response.MFAToken,
+				"expires_in":   response.ExpiresIn,
+			},
+		})
+		return
+	}
+
+	h.respondLogin(c, response)
+}
+
+// LoginMFA handles POST /api/v1/auth/login/mfa
+// @Summary      Complete login with a second factor
+// @Description  Exchange the MFA token of a password login and an authenticator or recovery code for tokens
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        mfa body auth.LoginMFARequest true "MFA token and code"
+// @Success      200 {object} map[string]interface{} "Login successful"
+// @Failure      400 {object} map[string]interface{} "Bad request - validation error"
+// @Failure      401 {object} map[string]interface{} "Unauthorized - invalid MFA token or code"
+// @Failure      429 {object} map[string]interface{} "Too many codes entered or account locked"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /auth/login/mfa [post]
+func (h *AuthHandler) LoginMFA(c *gin.Context) {
+	var req auth.LoginMFARequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Failed to bind JSON", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid request format",
+		})
+		return
+	}
+
+	// Validate request
+	if err := h.validator.Struct(&req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Validation failed",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	// Get client IP and User-Agent
+	ipAddress := c.ClientIP()
+	userAgent := c.GetHeader("User-Agent")
+
+	response, err := h.loginUseCase.CompleteMFA(c.Request.Context(), &req, ipAddress, userAgent)
+	if err != nil {
+		h.logger.Error("MFA login failed", zap.Error(err))
+		h.respondLoginError(c, err)
+		return
+	}
+
+	h.respondLogin(c, response)
+}
+
+// respondLogin sets the refresh token cookie and writes the tokens of a completed login
+func (h *AuthHandler) respondLogin(c *gin.Context, response *auth.LoginResponse) {
+	// Users whose password expired continue with PUT /me/password
+	if response.PasswordChangeToken != "" {
+		c.JSON(http.StatusOK, gin.H{
+			"message": "Password expired, change it to continue",
+			"data": gin.H{
+				"password_change_required": true,
+				"access_token":             response.PasswordChangeToken,
+				"token_type":               "Bearer",
+				"scope":                    response.Scope,
+				"expires_in":               response.ExpiresIn,
+			},
+		})
 		return
 	}
 
@@ -119,24 +177,55 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		true, // httpOnly
 	)
 
+	data := gin.H{
+		"user": gin.H{
+			"id":         response.User.ID,
+			"username":   response.User.Username,
+			"email":      response.User.Email,
+			"first_name": response.User.FirstName,
+			"last_name":  response.User.LastName,
+			"status":     response.User.Status,
+		},
+		"access_token": response.Tokens.AccessToken,
+		"token_type":   response.Tokens.TokenType,
+		"expires_in":   response.ExpiresIn,
+	}
+	if response.RecoveryCodesLeft != nil {
+		data["recovery_codes_left"] = *response.RecoveryCodesLeft
+	}
+
 	c.JSON(http.StatusOK, gin.H{
 		"message": "Login successful",
-		"data": gin.H{
-			"user": gin.H{
-				"id":         response.User.ID,
-				"username":   response.User.Username,
-				"email":      response.User.Email,
-				"first_name": response.User.FirstName,
-				"last_name":  response.User.LastName,
-				"status":     response.User.Status,
-			},
-			"access_token": response.Tokens.AccessToken,
-			"token_type":   response.Tokens.TokenType,
-			"expires_in":   response.ExpiresIn,
-		},
+		"data":    data,
 	})
 }
 
+// respondLoginError maps login errors to HTTP responses
+func (h *AuthHandler) respondLoginError(c *gin.Context, err error) {
+	if appErr, ok := err.(*errors.AppError); ok {
+		switch appErr.Code {
+		case "AUTH_001":
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"error": appErr.Message,
+			})
+		case "AUTH_003":
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"error": appErr.Message,
+			})
+		case "BIZ_003":
+			respondError(c, h.logger, err)
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Internal server error",
+			})
+		}
+	} else {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Internal server error",
+		})
+	}
+}
+
 // Logout handles POST /api/v1/auth/logout
 // @Summary      User logout
 // @Description  Logout user and revoke refresh token
@@ -169,8 +258,17 @@ func (h *AuthHandler) Logout(c *gin.Context) {
 		return
 	}
 
+	// Get client IP and User-Agent
+	ipAddress := c.ClientIP()
+	userAgent := c.GetHeader("User-Agent")
+
+	// Revoke the access token too when the caller sends it
+	if accessToken, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer "); ok {
+		req.AccessToken = accessToken
+	}
+
 	// Execute logout use case
-	response, err := h.logoutUseCase.Execute(c.Request.Context(), &req)
+	response, err := h.logoutUseCase.Execute(c.Request.Context(), &req, ipAddress, userAgent)
 	if err != nil {
 		h.logger.Error("Logout failed", zap.Error(err))
 
@@ -294,6 +392,19 @@ func (h *AuthHandler) RefreshToken(c *gin.Context) {
 	})
 }
 
+// JWKS handles GET /.well-known/jwks.json
+// @Summary      JSON Web Key Set
+// @Description  Public keys for verifying access and refresh tokens, selected by the token's kid header
+// @Tags         auth
+// @Produce      json
+// @Success      200 {object} map[string]interface{} "JSON Web Key Set"
+// @Router       /.well-known/jwks.json [get]
+func (h *AuthHandler) JWKS(c *gin.Context) {
+	// Let verifiers cache the keys, rotation publishes new keys ahead of signing with them
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, h.getJWKSUseCase.Execute(c.Request.Context()))
+}
+
 // GetClientIP extracts client IP from request
 func GetClientIP(c *gin.Context) string {
 	// Check X-Forwarded-For header first
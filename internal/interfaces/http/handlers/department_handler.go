@@ -0,0 +1,370 @@
+package handlers
+
+import (
+	"net/http"
+
+	"bm-staff/internal/usecases/department"
+	"bm-staff/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+// DepartmentHandler handles HTTP requests for department operations
+type DepartmentHandler struct {
+	createDepartmentUseCase       *department.CreateDepartmentUseCase
+	getDepartmentUseCase          *department.GetDepartmentUseCase
+	updateDepartmentUseCase       *department.UpdateDepartmentUseCase
+	deleteDepartmentUseCase       *department.DeleteDepartmentUseCase
+	listDepartmentsUseCase        *department.ListDepartmentsUseCase
+	listDepartmentChildrenUseCase *department.ListDepartmentChildrenUseCase
+	getDepartmentSubtreeUseCase   *department.GetDepartmentSubtreeUseCase
+	moveDepartmentUseCase         *department.MoveDepartmentUseCase
+	validator                     *validator.Validate
+	logger                        *zap.Logger
+}
+
+// NewDepartmentHandler creates a new department handler
+func NewDepartmentHandler(
+	createDepartmentUseCase *department.CreateDepartmentUseCase,
+	getDepartmentUseCase *department.GetDepartmentUseCase,
+	updateDepartmentUseCase *department.UpdateDepartmentUseCase,
+	deleteDepartmentUseCase *department.DeleteDepartmentUseCase,
+	listDepartmentsUseCase *department.ListDepartmentsUseCase,
+	listDepartmentChildrenUseCase *department.ListDepartmentChildrenUseCase,
+	getDepartmentSubtreeUseCase *department.GetDepartmentSubtreeUseCase,
+	moveDepartmentUseCase *department.MoveDepartmentUseCase,
+	validator *validator.Validate,
+	logger *zap.Logger,
+) *DepartmentHandler {
+	return &DepartmentHandler{
+		createDepartmentUseCase:       createDepartmentUseCase,
+		getDepartmentUseCase:          getDepartmentUseCase,
+		updateDepartmentUseCase:       updateDepartmentUseCase,
+		deleteDepartmentUseCase:       deleteDepartmentUseCase,
+		listDepartmentsUseCase:        listDepartmentsUseCase,
+		listDepartmentChildrenUseCase: listDepartmentChildrenUseCase,
+		getDepartmentSubtreeUseCase:   getDepartmentSubtreeUseCase,
+		moveDepartmentUseCase:         moveDepartmentUseCase,
+		validator:                     validator,
+		logger:                        logger,
+	}
+}
+
+// CreateDepartment handles POST /api/v1/departments
+// @Summary      Create a new department
+// @Description  Create a new department, optionally under a parent department and with a manager
+// @Tags         departments
+// @Accept       json
+// @Produce      json
+// @Param        department body department.CreateDepartmentRequest true "Department information"
+// @Success      201 {object} map[string]interface{} "Department created successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - validation error"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
+// @Failure      404 {object} map[string]interface{} "Parent department or manager not found"
+// @Failure      409 {object} map[string]interface{} "Conflict - department code already exists or parent is inactive"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /departments [post]
+func (h *DepartmentHandler) CreateDepartment(c *gin.Context) {
+	var req department.CreateDepartmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Failed to bind JSON", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid request format", err)
+		return
+	}
+
+	// Validate request
+	if err := h.validator.Struct(&req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationRequired, "Validation failed", err)
+		return
+	}
+
+	// Execute use case
+	resp, err := h.createDepartmentUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"data": resp.Department,
+	})
+}
+
+// GetDepartment handles GET /api/v1/departments/:id
+// @Summary      Get department by ID
+// @Description  Retrieve a department by its unique identifier
+// @Tags         departments
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Department ID"
+// @Success      200 {object} map[string]interface{} "Department retrieved successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - invalid department ID"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
+// @Failure      404 {object} map[string]interface{} "Department not found"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /departments/{id} [get]
+func (h *DepartmentHandler) GetDepartment(c *gin.Context) {
+	req := &department.GetDepartmentRequest{ID: c.Param("id")}
+
+	// Validate request
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid department ID format", err)
+		return
+	}
+
+	// Execute use case
+	resp, err := h.getDepartmentUseCase.Execute(c.Request.Context(), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resp.Department,
+	})
+}
+
+// UpdateDepartment handles PUT /api/v1/departments/:id
+// @Summary      Update department
+// @Description  Update a department's name, description, manager and active state, departments with active users cannot be deactivated
+// @Tags         departments
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Department ID"
+// @Param        department body department.UpdateDepartmentRequest true "Updated department information"
+// @Success      200 {object} map[string]interface{} "Department updated successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - validation error"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
+// @Failure      404 {object} map[string]interface{} "Department or manager not found"
+// @Failure      409 {object} map[string]interface{} "Conflict - department still has active users"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /departments/{id} [put]
+func (h *DepartmentHandler) UpdateDepartment(c *gin.Context) {
+	var req department.UpdateDepartmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Failed to bind JSON", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid request format", err)
+		return
+	}
+
+	// Set ID from URL parameter
+	req.ID = c.Param("id")
+
+	// Validate request
+	if err := h.validator.Struct(&req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationRequired, "Validation failed", err)
+		return
+	}
+
+	// Execute use case
+	resp, err := h.updateDepartmentUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resp.Department,
+	})
+}
+
+// DeleteDepartment handles DELETE /api/v1/departments/:id
+// @Summary      Delete department
+// @Description  Soft delete a department, departments with child departments or users cannot be deleted
+// @Tags         departments
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Department ID"
+// @Success      200 {object} map[string]interface{} "Department deleted successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - invalid department ID"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
+// @Failure      404 {object} map[string]interface{} "Department not found"
+// @Failure      409 {object} map[string]interface{} "Conflict - department in use"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /departments/{id} [delete]
+func (h *DepartmentHandler) DeleteDepartment(c *gin.Context) {
+	req := &department.DeleteDepartmentRequest{ID: c.Param("id")}
+
+	// Validate request
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid department ID format", err)
+		return
+	}
+
+	// Execute use case
+	resp, err := h.deleteDepartmentUseCase.Execute(c.Request.Context(), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resp,
+	})
+}
+
+// ListDepartments handles GET /api/v1/departments
+// @Summary      List departments
+// @Description  Retrieve a paginated list of departments ordered by name
+// @Tags         departments
+// @Accept       json
+// @Produce      json
+// @Param        limit query int false "Number of departments to return" default(10) minimum(1) maximum(100)
+// @Param        offset query int false "Number of departments to skip" default(0) minimum(0)
+// @Success      200 {object} map[string]interface{} "Departments retrieved successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - invalid pagination parameters"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /departments [get]
+func (h *DepartmentHandler) ListDepartments(c *gin.Context) {
+	var req department.ListDepartmentsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.logger.Error("Failed to bind query", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid query parameters", err)
+		return
+	}
+
+	// Validate request
+	if err := h.validator.Struct(&req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationRange, "Validation failed", err)
+		return
+	}
+
+	// Execute use case
+	resp, err := h.listDepartmentsUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resp,
+	})
+}
+
+// ListDepartmentChildren handles GET /api/v1/departments/:id/children
+// @Summary      List child departments
+// @Description  Retrieve the direct child departments of a department ordered by name
+// @Tags         departments
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Department ID"
+// @Success      200 {object} map[string]interface{} "Child departments retrieved successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - invalid department ID"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
+// @Failure      404 {object} map[string]interface{} "Department not found"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /departments/{id}/children [get]
+func (h *DepartmentHandler) ListDepartmentChildren(c *gin.Context) {
+	req := &department.ListDepartmentChildrenRequest{ID: c.Param("id")}
+
+	// Validate request
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid department ID format", err)
+		return
+	}
+
+	// Execute use case
+	resp, err := h.listDepartmentChildrenUseCase.Execute(c.Request.Context(), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resp,
+	})
+}
+
+// GetDepartmentSubtree handles GET /api/v1/departments/:id/subtree
+// @Summary      Get department subtree
+// @Description  Retrieve a department with all of its descendants nested under their parents
+// @Tags         departments
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Department ID"
+// @Success      200 {object} map[string]interface{} "Department subtree retrieved successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - invalid department ID"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
+// @Failure      404 {object} map[string]interface{} "Department not found"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /departments/{id}/subtree [get]
+func (h *DepartmentHandler) GetDepartmentSubtree(c *gin.Context) {
+	req := &department.GetDepartmentSubtreeRequest{ID: c.Param("id")}
+
+	// Validate request
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid department ID format", err)
+		return
+	}
+
+	// Execute use case
+	resp, err := h.getDepartmentSubtreeUseCase.Execute(c.Request.Context(), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resp,
+	})
+}
+
+// MoveDepartment handles PUT /api/v1/departments/:id/parent
+// @Summary      Move department
+// @Description  Move a department with its subtree under a new parent, an empty parent_id makes it a root department
+// @Tags         departments
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Department ID"
+// @Param        parent body department.MoveDepartmentRequest true "New parent department"
+// @Success      200 {object} map[string]interface{} "Department moved successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - validation error"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
+// @Failure      404 {object} map[string]interface{} "Department or parent department not found"
+// @Failure      409 {object} map[string]interface{} "Conflict - move creates a cycle or parent is inactive"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /departments/{id}/parent [put]
+func (h *DepartmentHandler) MoveDepartment(c *gin.Context) {
+	var req department.MoveDepartmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Failed to bind JSON", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid request format", err)
+		return
+	}
+
+	// Set ID from URL parameter
+	req.ID = c.Param("id")
+
+	// Validate request
+	if err := h.validator.Struct(&req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationRequired, "Validation failed", err)
+		return
+	}
+
+	// Execute use case
+	resp, err := h.moveDepartmentUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resp.Department,
+	})
+}
+
+// handleError handles application errors and returns appropriate HTTP responses
+func (h *DepartmentHandler) handleError(c *gin.Context, err error) {
+	respondError(c, h.logger, err)
+}
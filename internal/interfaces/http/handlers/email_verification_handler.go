@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"net/http"
+
+	"bm-staff/internal/usecases/auth"
+	"bm-staff/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+// EmailVerificationHandler handles the email verification HTTP requests
+type EmailVerificationHandler struct {
+	verifyEmailUseCase             *auth.VerifyEmailUseCase
+	resendVerificationEmailUseCase *auth.ResendVerificationEmailUseCase
+	validator                      *validator.Validate
+	logger                         *zap.Logger
+}
+
+// NewEmailVerificationHandler creates a new email verification handler
+func NewEmailVerificationHandler(
+	verifyEmailUseCase *auth.VerifyEmailUseCase,
+	resendVerificationEmailUseCase *auth.ResendVerificationEmailUseCase,
+	validator *validator.Validate,
+	logger *zap.Logger,
+) *EmailVerificationHandler {
+	return &EmailVerificationHandler{
+		verifyEmailUseCase:             verifyEmailUseCase,
+		resendVerificationEmailUseCase: resendVerificationEmailUseCase,
+		validator:                      validator,
+		logger:                         logger,
+	}
+}
+
+// VerifyEmail handles POST /api/v1/auth/email/verify
+// @Summary      Verify email address
+// @Description  Confirm an email address with the token of an emailed verification link; pending accounts may be activated
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        request body auth.VerifyEmailRequest true "Verification token"
+// @Success      200 {object} map[string]interface{} "Email verified"
+// @Failure      400 {object} map[string]interface{} "Bad request - validation error or invalid token"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /auth/email/verify [post]
+func (h *EmailVerificationHandler) VerifyEmail(c *gin.Context) {
+	var req auth.VerifyEmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Failed to bind JSON", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid request format", err)
+		return
+	}
+
+	// Validate request
+	if err := h.validator.Struct(&req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationRequired, "Validation failed", err)
+		return
+	}
+
+	resp, err := h.verifyEmailUseCase.Execute(c.Request.Context(), &req, c.ClientIP(), c.GetHeader("User-Agent"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": resp.Message,
+		"data": gin.H{
+			"activated": resp.Activated,
+		},
+	})
+}
+
+// ResendVerificationEmail handles POST /api/v1/auth/email/resend
+// @Summary      Resend verification email
+// @Description  Email a new verification link; the response is the same whether or not the account exists, and requests are throttled per address
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        request body auth.ResendVerificationEmailRequest true "Account email"
+// @Success      202 {object} map[string]interface{} "Verification email requested"
+// @Failure      400 {object} map[string]interface{} "Bad request - validation error"
+// @Failure      429 {object} map[string]interface{} "Too many requests"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /auth/email/resend [post]
+func (h *EmailVerificationHandler) ResendVerificationEmail(c *gin.Context) {
+	var req auth.ResendVerificationEmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Failed to bind JSON", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid request format", err)
+		return
+	}
+
+	// Validate request
+	if err := h.validator.Struct(&req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationRequired, "Validation failed", err)
+		return
+	}
+
+	resp, err := h.resendVerificationEmailUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"message": resp.Message,
+	})
+}
+
+// handleError handles application errors and returns appropriate HTTP responses
+func (h *EmailVerificationHandler) handleError(c *gin.Context, err error) {
+	respondError(c, h.logger, err)
+}
@@ -0,0 +1,282 @@
+package handlers
+
+import (
+	"net/http"
+
+	"bm-staff/internal/usecases/user"
+	"bm-staff/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+// MeHandler handles self-service HTTP requests of the authenticated user
+type MeHandler struct {
+	getUserUseCase               *user.GetUserUseCase
+	updateProfileUseCase         *user.UpdateProfileUseCase
+	updatePreferencesUseCase     *user.UpdatePreferencesUseCase
+	changePasswordUseCase        *user.ChangePasswordUseCase
+	sendPhoneVerificationUseCase *user.SendPhoneVerificationUseCase
+	verifyPhoneUseCase           *user.VerifyPhoneUseCase
+	validator                    *validator.Validate
+	logger                       *zap.Logger
+}
+
+// NewMeHandler creates a new self-service handler
+func NewMeHandler(
+	getUserUseCase *user.GetUserUseCase,
+	updateProfileUseCase *user.UpdateProfileUseCase,
+	updatePreferencesUseCase *user.UpdatePreferencesUseCase,
+	changePasswordUseCase *user.ChangePasswordUseCase,
+	sendPhoneVerificationUseCase *user.SendPhoneVerificationUseCase,
+	verifyPhoneUseCase *user.VerifyPhoneUseCase,
+	validator *validator.Validate,
+	logger *zap.Logger,
+) *MeHandler {
+	return &MeHandler{
+		getUserUseCase:               getUserUseCase,
+		updateProfileUseCase:         updateProfileUseCase,
+		updatePreferencesUseCase:     updatePreferencesUseCase,
+		changePasswordUseCase:        changePasswordUseCase,
+		sendPhoneVerificationUseCase: sendPhoneVerificationUseCase,
+		verifyPhoneUseCase:           verifyPhoneUseCase,
+		validator:                    validator,
+		logger:                       logger,
+	}
+}
+
+// GetMe handles GET /api/v1/me
+// @Summary      Get my user
+// @Description  Retrieve the authenticated user's own record
+// @Tags         me
+// @Produce      json
+// @Success      200 {object} map[string]interface{} "User retrieved successfully"
+// @Failure      401 {object} map[string]interface{} "Unauthorized"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /me [get]
+func (h *MeHandler) GetMe(c *gin.Context) {
+	req := &user.GetUserRequest{ID: currentUserID(c)}
+
+	resp, err := h.getUserUseCase.Execute(c.Request.Context(), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resp.User,
+	})
+}
+
+// UpdateMe handles PATCH /api/v1/me
+// @Summary      Update my profile
+// @Description  Partially update the authenticated user's name, phone and personal details; omitted fields are unchanged
+// @Tags         me
+// @Accept       json
+// @Produce      json
+// @Param        profile body user.UpdateProfileRequest true "Profile fields to change"
+// @Success      200 {object} map[string]interface{} "Profile updated successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - validation error"
+// @Failure      401 {object} map[string]interface{} "Unauthorized"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /me [patch]
+func (h *MeHandler) UpdateMe(c *gin.Context) {
+	var req user.UpdateProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Failed to bind JSON", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid request format", err)
+		return
+	}
+
+	req.UserID = currentUserID(c)
+
+	// Validate request
+	if err := h.validator.Struct(&req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationRequired, "Validation failed", err)
+		return
+	}
+
+	resp, err := h.updateProfileUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resp.User,
+	})
+}
+
+// UpdateMyPreferences handles PUT /api/v1/me/preferences
+// @Summary      Update my preferences
+// @Description  Set the authenticated user's language, IANA timezone and notification preference
+// @Tags         me
+// @Accept       json
+// @Produce      json
+// @Param        preferences body user.UpdatePreferencesRequest true "Preferences"
+// @Success      200 {object} map[string]interface{} "Preferences updated successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - validation error"
+// @Failure      401 {object} map[string]interface{} "Unauthorized"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /me/preferences [put]
+func (h *MeHandler) UpdateMyPreferences(c *gin.Context) {
+	var req user.UpdatePreferencesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Failed to bind JSON", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid request format", err)
+		return
+	}
+
+	req.UserID = currentUserID(c)
+
+	// Validate request
+	if err := h.validator.Struct(&req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationRequired, "Validation failed", err)
+		return
+	}
+
+	resp, err := h.updatePreferencesUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resp.User,
+	})
+}
+
+// ChangeMyPassword handles PUT /api/v1/me/password
+// @Summary      Change my password
+// @Description  Verify the current password and set a new one that meets the password policy; other sessions are signed out and a new token pair is returned. Also accepts the restricted token issued at login for an expired password
+// @Tags         me
+// @Accept       json
+// @Produce      json
+// @Param        password body user.ChangePasswordRequest true "Current and new password"
+// @Success      200 {object} map[string]interface{} "Password changed successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - validation error, policy violations or wrong current password"
+// @Failure      401 {object} map[string]interface{} "Unauthorized"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /me/password [put]
+func (h *MeHandler) ChangeMyPassword(c *gin.Context) {
+	var req user.ChangePasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Failed to bind JSON", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid request format", err)
+		return
+	}
+
+	req.UserID = currentUserID(c)
+	req.IPAddress = c.ClientIP()
+	req.UserAgent = c.GetHeader("User-Agent")
+
+	// Validate request
+	if err := h.validator.Struct(&req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationRequired, "Validation failed", err)
+		return
+	}
+
+	resp, err := h.changePasswordUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	// Replace the refresh token cookie, the previous one has been revoked
+	c.SetCookie(
+		"refresh_token",
+		resp.Tokens.RefreshToken,
+		int(resp.Tokens.ExpiresIn),
+		"/",
+		"",
+		true, // secure
+		true, // httpOnly
+	)
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Password changed successfully",
+		"data": gin.H{
+			"access_token": resp.Tokens.AccessToken,
+			"token_type":   resp.Tokens.TokenType,
+			"expires_in":   resp.Tokens.ExpiresIn,
+		},
+	})
+}
+
+// SendMyPhoneVerification handles POST /api/v1/me/phone/verification
+// @Summary      Send a phone verification code
+// @Description  Text a one-time code to the authenticated user's phone number; earlier codes stop working
+// @Tags         me
+// @Produce      json
+// @Success      202 {object} map[string]interface{} "Verification code sent"
+// @Failure      400 {object} map[string]interface{} "Bad request - no phone number"
+// @Failure      401 {object} map[string]interface{} "Unauthorized"
+// @Failure      409 {object} map[string]interface{} "Phone number already verified"
+// @Failure      429 {object} map[string]interface{} "Too many codes requested"
+// @Failure      502 {object} map[string]interface{} "SMS delivery failed"
+// @Router       /me/phone/verification [post]
+func (h *MeHandler) SendMyPhoneVerification(c *gin.Context) {
+	req := &user.SendPhoneVerificationRequest{UserID: currentUserID(c)}
+
+	resp, err := h.sendPhoneVerificationUseCase.Execute(c.Request.Context(), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"message": "Verification code sent",
+		"data":    resp,
+	})
+}
+
+// VerifyMyPhone handles POST /api/v1/me/phone/verify
+// @Summary      Verify my phone number
+// @Description  Confirm the authenticated user's phone number with the texted code; each code allows a limited number of attempts
+// @Tags         me
+// @Accept       json
+// @Produce      json
+// @Param        code body user.VerifyPhoneRequest true "Verification code"
+// @Success      200 {object} map[string]interface{} "Phone number verified"
+// @Failure      400 {object} map[string]interface{} "Bad request - invalid or expired code"
+// @Failure      401 {object} map[string]interface{} "Unauthorized"
+// @Failure      429 {object} map[string]interface{} "Too many wrong codes"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /me/phone/verify [post]
+func (h *MeHandler) VerifyMyPhone(c *gin.Context) {
+	var req user.VerifyPhoneRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Failed to bind JSON", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid request format", err)
+		return
+	}
+
+	req.UserID = currentUserID(c)
+
+	// Validate request
+	if err := h.validator.Struct(&req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationRequired, "Validation failed", err)
+		return
+	}
+
+	resp, err := h.verifyPhoneUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Phone number verified",
+		"data":    resp.User,
+	})
+}
+
+// handleError handles application errors and returns appropriate HTTP responses
+func (h *MeHandler) handleError(c *gin.Context, err error) {
+	respondError(c, h.logger, err)
+}
@@ -0,0 +1,225 @@
+package handlers
+
+import (
+	"net/http"
+
+	"bm-staff/internal/usecases/user"
+	"bm-staff/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+// MFAHandler handles two-factor authentication settings of the authenticated user
+type MFAHandler struct {
+	getMFAStatusUseCase            *user.GetMFAStatusUseCase
+	enrollTOTPUseCase              *user.EnrollTOTPUseCase
+	confirmTOTPUseCase             *user.ConfirmTOTPUseCase
+	regenerateRecoveryCodesUseCase *user.RegenerateRecoveryCodesUseCase
+	disableMFAUseCase              *user.DisableMFAUseCase
+	validator                      *validator.Validate
+	logger                         *zap.Logger
+}
+
+// NewMFAHandler creates a new two-factor authentication handler
+func NewMFAHandler(
+	getMFAStatusUseCase *user.GetMFAStatusUseCase,
+	enrollTOTPUseCase *user.EnrollTOTPUseCase,
+	confirmTOTPUseCase *user.ConfirmTOTPUseCase,
+	regenerateRecoveryCodesUseCase *user.RegenerateRecoveryCodesUseCase,
+	disableMFAUseCase *user.DisableMFAUseCase,
+	validator *validator.Validate,
+	logger *zap.Logger,
+) *MFAHandler {
+	return &MFAHandler{
+		getMFAStatusUseCase:            getMFAStatusUseCase,
+		enrollTOTPUseCase:              enrollTOTPUseCase,
+		confirmTOTPUseCase:             confirmTOTPUseCase,
+		regenerateRecoveryCodesUseCase: regenerateRecoveryCodesUseCase,
+		disableMFAUseCase:              disableMFAUseCase,
+		validator:                      validator,
+		logger:                         logger,
+	}
+}
+
+// GetMFAStatus handles GET /api/v1/me/mfa
+// @Summary      Get my two-factor authentication status
+// @Description  Whether two-factor authentication is enabled and how many recovery codes are left
+// @Tags         me
+// @Produce      json
+// @Success      200 {object} map[string]interface{} "Two-factor authentication status"
+// @Failure      401 {object} map[string]interface{} "Unauthorized"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /me/mfa [get]
+func (h *MFAHandler) GetMFAStatus(c *gin.Context) {
+	req := &user.GetMFAStatusRequest{UserID: currentUserID(c)}
+
+	resp, err := h.getMFAStatusUseCase.Execute(c.Request.Context(), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resp,
+	})
+}
+
+// EnrollTOTP handles POST /api/v1/me/mfa/totp
+// @Summary      Start authenticator enrollment
+// @Description  Generate a TOTP secret with its otpauth:// URI and QR code PNG; logins are protected once the enrollment is confirmed
+// @Tags         me
+// @Produce      json
+// @Success      201 {object} map[string]interface{} "Enrollment started"
+// @Failure      401 {object} map[string]interface{} "Unauthorized"
+// @Failure      409 {object} map[string]interface{} "Two-factor authentication already enabled"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /me/mfa/totp [post]
+func (h *MFAHandler) EnrollTOTP(c *gin.Context) {
+	req := &user.EnrollTOTPRequest{UserID: currentUserID(c)}
+
+	resp, err := h.enrollTOTPUseCase.Execute(c.Request.Context(), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Scan the QR code with an authenticator app and confirm with a code",
+		"data":    resp,
+	})
+}
+
+// ConfirmTOTP handles POST /api/v1/me/mfa/totp/confirm
+// @Summary      Confirm authenticator enrollment
+// @Description  Enable two-factor authentication with a code from the authenticator app; returns recovery codes that are only shown once
+// @Tags         me
+// @Accept       json
+// @Produce      json
+// @Param        code body user.ConfirmTOTPRequest true "Authenticator code"
+// @Success      200 {object} map[string]interface{} "Two-factor authentication enabled"
+// @Failure      400 {object} map[string]interface{} "Bad request - invalid code"
+// @Failure      401 {object} map[string]interface{} "Unauthorized"
+// @Failure      404 {object} map[string]interface{} "No enrollment started"
+// @Failure      409 {object} map[string]interface{} "Two-factor authentication already enabled"
+// @Failure      429 {object} map[string]interface{} "Too many codes entered"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /me/mfa/totp/confirm [post]
+func (h *MFAHandler) ConfirmTOTP(c *gin.Context) {
+	var req user.ConfirmTOTPRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Failed to bind JSON", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid request format", err)
+		return
+	}
+
+	req.UserID = currentUserID(c)
+
+	// Validate request
+	if err := h.validator.Struct(&req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationRequired, "Validation failed", err)
+		return
+	}
+
+	resp, err := h.confirmTOTPUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Two-factor authentication enabled, store the recovery codes in a safe place",
+		"data":    resp,
+	})
+}
+
+// RegenerateRecoveryCodes handles POST /api/v1/me/mfa/recovery-codes
+// @Summary      Regenerate recovery codes
+// @Description  Replace the recovery codes after checking an authenticator or recovery code; the previous codes stop working
+// @Tags         me
+// @Accept       json
+// @Produce      json
+// @Param        code body user.RegenerateRecoveryCodesRequest true "Authenticator or recovery code"
+// @Success      200 {object} map[string]interface{} "Recovery codes regenerated"
+// @Failure      400 {object} map[string]interface{} "Bad request - invalid code"
+// @Failure      401 {object} map[string]interface{} "Unauthorized"
+// @Failure      404 {object} map[string]interface{} "Two-factor authentication not enabled"
+// @Failure      429 {object} map[string]interface{} "Too many codes entered"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /me/mfa/recovery-codes [post]
+func (h *MFAHandler) RegenerateRecoveryCodes(c *gin.Context) {
+	var req user.RegenerateRecoveryCodesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Failed to bind JSON", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid request format", err)
+		return
+	}
+
+	req.UserID = currentUserID(c)
+
+	// Validate request
+	if err := h.validator.Struct(&req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationRequired, "Validation failed", err)
+		return
+	}
+
+	resp, err := h.regenerateRecoveryCodesUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Recovery codes regenerated",
+		"data":    resp,
+	})
+}
+
+// DisableMFA handles POST /api/v1/me/mfa/disable
+// @Summary      Disable two-factor authentication
+// @Description  Remove the authenticator and recovery codes after checking an authenticator or recovery code
+// @Tags         me
+// @Accept       json
+// @Produce      json
+// @Param        code body user.DisableMFARequest true "Authenticator or recovery code"
+// @Success      200 {object} map[string]interface{} "Two-factor authentication disabled"
+// @Failure      400 {object} map[string]interface{} "Bad request - invalid code"
+// @Failure      401 {object} map[string]interface{} "Unauthorized"
+// @Failure      404 {object} map[string]interface{} "Two-factor authentication not enabled"
+// @Failure      429 {object} map[string]interface{} "Too many codes entered"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /me/mfa/disable [post]
+func (h *MFAHandler) DisableMFA(c *gin.Context) {
+	var req user.DisableMFARequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Failed to bind JSON", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid request format", err)
+		return
+	}
+
+	req.UserID = currentUserID(c)
+
+	// Validate request
+	if err := h.validator.Struct(&req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationRequired, "Validation failed", err)
+		return
+	}
+
+	if _, err := h.disableMFAUseCase.Execute(c.Request.Context(), &req); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Two-factor authentication disabled",
+	})
+}
+
+// handleError handles application errors and returns appropriate HTTP responses
+func (h *MFAHandler) handleError(c *gin.Context, err error) {
+	respondError(c, h.logger, err)
+}
@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"net/http"
+
+	"bm-staff/internal/usecases/auth"
+	"bm-staff/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+// PasswordResetHandler handles the forgot password and reset password HTTP requests
+type PasswordResetHandler struct {
+	forgotPasswordUseCase *auth.ForgotPasswordUseCase
+	resetPasswordUseCase  *auth.ResetPasswordUseCase
+	validator             *validator.Validate
+	logger                *zap.Logger
+}
+
+// NewPasswordResetHandler creates a new password reset handler
+func NewPasswordResetHandler(
+	forgotPasswordUseCase *auth.ForgotPasswordUseCase,
+	resetPasswordUseCase *auth.ResetPasswordUseCase,
+	validator *validator.Validate,
+	logger *zap.Logger,
+) *PasswordResetHandler {
+	return &PasswordResetHandler{
+		forgotPasswordUseCase: forgotPasswordUseCase,
+		resetPasswordUseCase:  resetPasswordUseCase,
+		validator:             validator,
+		logger:                logger,
+	}
+}
+
+// ForgotPassword handles POST /api/v1/auth/password/forgot
+// @Summary      Request a password reset
+// @Description  Email a single-use reset link to the account with the email; the response is the same whether or not the account exists
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        request body auth.ForgotPasswordRequest true "Account email"
+// @Success      202 {object} map[string]interface{} "Reset requested"
+// @Failure      400 {object} map[string]interface{} "Bad request - validation error"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /auth/password/forgot [post]
+func (h *PasswordResetHandler) ForgotPassword(c *gin.Context) {
+	var req auth.ForgotPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Failed to bind JSON", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid request format", err)
+		return
+	}
+
+	// Validate request
+	if err := h.validator.Struct(&req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationRequired, "Validation failed", err)
+		return
+	}
+
+	resp, err := h.forgotPasswordUseCase.Execute(c.Request.Context(), &req, c.ClientIP(), c.GetHeader("User-Agent"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"message": resp.Message,
+	})
+}
+
+// ResetPassword handles POST /api/v1/auth/password/reset
+// @Summary      Reset password
+// @Description  Set a new password with an emailed reset token; the token works once and every session of the user is signed out
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        request body auth.ResetPasswordRequest true "Reset token and new password"
+// @Success      200 {object} map[string]interface{} "Password reset successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - validation error or invalid token"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /auth/password/reset [post]
+func (h *PasswordResetHandler) ResetPassword(c *gin.Context) {
+	var req auth.ResetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Failed to bind JSON", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid request format", err)
+		return
+	}
+
+	// Validate request
+	if err := h.validator.Struct(&req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationRequired, "Validation failed", err)
+		return
+	}
+
+	resp, err := h.resetPasswordUseCase.Execute(c.Request.Context(), &req, c.ClientIP(), c.GetHeader("User-Agent"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": resp.Message,
+	})
+}
+
+// handleError handles application errors and returns appropriate HTTP responses
+func (h *PasswordResetHandler) handleError(c *gin.Context, err error) {
+	respondError(c, h.logger, err)
+}
@@ -0,0 +1,243 @@
+package handlers
+
+import (
+	"net/http"
+
+	"bm-staff/internal/usecases/permission"
+	"bm-staff/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+// PermissionHandler handles HTTP requests for permission operations
+type PermissionHandler struct {
+	createPermissionUseCase *permission.CreatePermissionUseCase
+	getPermissionUseCase    *permission.GetPermissionUseCase
+	updatePermissionUseCase *permission.UpdatePermissionUseCase
+	deletePermissionUseCase *permission.DeletePermissionUseCase
+	listPermissionsUseCase  *permission.ListPermissionsUseCase
+	validator               *validator.Validate
+	logger                  *zap.Logger
+}
+
+// NewPermissionHandler creates a new permission handler
+func NewPermissionHandler(
+	createPermissionUseCase *permission.CreatePermissionUseCase,
+	getPermissionUseCase *permission.GetPermissionUseCase,
+	updatePermissionUseCase *permission.UpdatePermissionUseCase,
+	deletePermissionUseCase *permission.DeletePermissionUseCase,
+	listPermissionsUseCase *permission.ListPermissionsUseCase,
+	validator *validator.Validate,
+	logger *zap.Logger,
+) *PermissionHandler {
+	return &PermissionHandler{
+		createPermissionUseCase: createPermissionUseCase,
+		getPermissionUseCase:    getPermissionUseCase,
+		updatePermissionUseCase: updatePermissionUseCase,
+		deletePermissionUseCase: deletePermissionUseCase,
+		listPermissionsUseCase:  listPermissionsUseCase,
+		validator:               validator,
+		logger:                  logger,
+	}
+}
+
+// CreatePermission handles POST /api/v1/permissions
+// @Summary      Create a new permission
+// @Description  Create a new permission, its code is derived as resource:action
+// @Tags         permissions
+// @Accept       json
+// @Produce      json
+// @Param        permission body permission.CreatePermissionRequest true "Permission information"
+// @Success      201 {object} map[string]interface{} "Permission created successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - validation error"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
+// @Failure      409 {object} map[string]interface{} "Conflict - permission code already exists"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /permissions [post]
+func (h *PermissionHandler) CreatePermission(c *gin.Context) {
+	var req permission.CreatePermissionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Failed to bind JSON", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid request format", err)
+		return
+	}
+
+	// Validate request
+	if err := h.validator.Struct(&req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationRequired, "Validation failed", err)
+		return
+	}
+
+	// Execute use case
+	resp, err := h.createPermissionUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"data": resp.Permission,
+	})
+}
+
+// GetPermission handles GET /api/v1/permissions/:id
+// @Summary      Get permission by ID
+// @Description  Retrieve a permission by its unique identifier
+// @Tags         permissions
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Permission ID"
+// @Success      200 {object} map[string]interface{} "Permission retrieved successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - invalid permission ID"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
+// @Failure      404 {object} map[string]interface{} "Permission not found"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /permissions/{id} [get]
+func (h *PermissionHandler) GetPermission(c *gin.Context) {
+	req := &permission.GetPermissionRequest{ID: c.Param("id")}
+
+	// Validate request
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid permission ID format", err)
+		return
+	}
+
+	// Execute use case
+	resp, err := h.getPermissionUseCase.Execute(c.Request.Context(), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resp.Permission,
+	})
+}
+
+// UpdatePermission handles PUT /api/v1/permissions/:id
+// @Summary      Update permission
+// @Description  Update a permission's name, description and active state, deactivating it denies it for every role
+// @Tags         permissions
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Permission ID"
+// @Param        permission body permission.UpdatePermissionRequest true "Updated permission information"
+// @Success      200 {object} map[string]interface{} "Permission updated successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - validation error"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
+// @Failure      404 {object} map[string]interface{} "Permission not found"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /permissions/{id} [put]
+func (h *PermissionHandler) UpdatePermission(c *gin.Context) {
+	var req permission.UpdatePermissionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Failed to bind JSON", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid request format", err)
+		return
+	}
+
+	// Set ID from URL parameter
+	req.ID = c.Param("id")
+
+	// Validate request
+	if err := h.validator.Struct(&req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationRequired, "Validation failed", err)
+		return
+	}
+
+	// Execute use case
+	resp, err := h.updatePermissionUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resp.Permission,
+	})
+}
+
+// DeletePermission handles DELETE /api/v1/permissions/:id
+// @Summary      Delete permission
+// @Description  Soft delete a permission, roles granting its code no longer grant it
+// @Tags         permissions
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Permission ID"
+// @Success      200 {object} map[string]interface{} "Permission deleted successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - invalid permission ID"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
+// @Failure      404 {object} map[string]interface{} "Permission not found"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /permissions/{id} [delete]
+func (h *PermissionHandler) DeletePermission(c *gin.Context) {
+	req := &permission.DeletePermissionRequest{ID: c.Param("id")}
+
+	// Validate request
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid permission ID format", err)
+		return
+	}
+
+	// Execute use case
+	resp, err := h.deletePermissionUseCase.Execute(c.Request.Context(), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resp,
+	})
+}
+
+// ListPermissions handles GET /api/v1/permissions
+// @Summary      List permissions
+// @Description  Retrieve a paginated list of permissions ordered by code
+// @Tags         permissions
+// @Accept       json
+// @Produce      json
+// @Param        limit query int false "Number of permissions to return" default(10) minimum(1) maximum(100)
+// @Param        offset query int false "Number of permissions to skip" default(0) minimum(0)
+// @Success      200 {object} map[string]interface{} "Permissions retrieved successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - invalid pagination parameters"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /permissions [get]
+func (h *PermissionHandler) ListPermissions(c *gin.Context) {
+	var req permission.ListPermissionsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.logger.Error("Failed to bind query", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid query parameters", err)
+		return
+	}
+
+	// Validate request
+	if err := h.validator.Struct(&req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationRange, "Validation failed", err)
+		return
+	}
+
+	// Execute use case
+	resp, err := h.listPermissionsUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resp,
+	})
+}
+
+// handleError handles application errors and returns appropriate HTTP responses
+func (h *PermissionHandler) handleError(c *gin.Context, err error) {
+	respondError(c, h.logger, err)
+}
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"bm-staff/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// respondError writes an application error as a JSON error response
+func respondError(c *gin.Context, logger *zap.Logger, err error) {
+	logger.Error("Handler error", zap.Error(err))
+
+	if appErr, ok := err.(*errors.AppError); ok {
+		statusCode := getStatusCodeFromErrorCode(appErr.Code)
+		if retryAfter, ok := appErr.Details["retry_after"].(int); ok && statusCode == http.StatusTooManyRequests {
+			c.Header("Retry-After", strconv.Itoa(retryAfter))
+		}
+		c.JSON(statusCode, gin.H{
+			"error": gin.H{
+				"code":      appErr.Code,
+				"message":   appErr.Message,
+				"details":   appErr.Details,
+				"timestamp": appErr.Timestamp,
+			},
+		})
+		return
+	}
+
+	// Generic error
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"error": gin.H{
+			"code":    errors.ErrSystemInternal,
+			"message": "Internal server error",
+		},
+	})
+}
+
+// respondBadRequest writes a 400 response for a request that failed binding or validation
+func respondBadRequest(c *gin.Context, code, message string, err error) {
+	c.JSON(http.StatusBadRequest, gin.H{
+		"error": gin.H{
+			"code":    code,
+			"message": message,
+			"details": gin.H{"error": err.Error()},
+		},
+	})
+}
+
+// getStatusCodeFromErrorCode maps error codes to HTTP status codes
+func getStatusCodeFromErrorCode(code string) int {
+	switch code {
+	case errors.ErrValidationRequired, errors.ErrValidationFormat, errors.ErrValidationRange:
+		return http.StatusBadRequest
+	case errors.ErrAuthInvalidToken, errors.ErrAuthExpiredToken:
+		return http.StatusUnauthorized
+	case errors.ErrAuthInsufficient:
+		return http.StatusForbidden
+	case errors.ErrBusinessNotFound:
+		return http.StatusNotFound
+	case errors.ErrBusinessConflict:
+		return http.StatusConflict
+	case errors.ErrBusinessLimit:
+		return http.StatusTooManyRequests
+	case errors.ErrExternalTimeout, errors.ErrExternalUnavailable, errors.ErrExternalInvalid:
+		return http.StatusBadGateway
+	default:
+		return http.StatusInternalServerError
+	}
+}
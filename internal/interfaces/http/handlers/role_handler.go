@@ -0,0 +1,374 @@
+package handlers
+
+import (
+	"net/http"
+
+	"bm-staff/internal/usecases/role"
+	"bm-staff/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+// RoleHandler handles HTTP requests for role operations
+type RoleHandler struct {
+	createRoleUseCase              *role.CreateRoleUseCase
+	getRoleUseCase                 *role.GetRoleUseCase
+	updateRoleUseCase              *role.UpdateRoleUseCase
+	deleteRoleUseCase              *role.DeleteRoleUseCase
+	listRolesUseCase               *role.ListRolesUseCase
+	setRolePermissionsUseCase      *role.SetRolePermissionsUseCase
+	revokeRolePermissionUseCase    *role.RevokeRolePermissionUseCase
+	getEffectivePermissionsUseCase *role.GetEffectivePermissionsUseCase
+	validator                      *validator.Validate
+	logger                         *zap.Logger
+}
+
+// NewRoleHandler creates a new role handler
+func NewRoleHandler(
+	createRoleUseCase *role.CreateRoleUseCase,
+	getRoleUseCase *role.GetRoleUseCase,
+	updateRoleUseCase *role.UpdateRoleUseCase,
+	deleteRoleUseCase *role.DeleteRoleUseCase,
+	listRolesUseCase *role.ListRolesUseCase,
+	setRolePermissionsUseCase *role.SetRolePermissionsUseCase,
+	revokeRolePermissionUseCase *role.RevokeRolePermissionUseCase,
+	getEffectivePermissionsUseCase *role.GetEffectivePermissionsUseCase,
+	validator *validator.Validate,
+	logger *zap.Logger,
+) *RoleHandler {
+	return &RoleHandler{
+		createRoleUseCase:              createRoleUseCase,
+		getRoleUseCase:                 getRoleUseCase,
+		updateRoleUseCase:              updateRoleUseCase,
+		deleteRoleUseCase:              deleteRoleUseCase,
+		listRolesUseCase:               listRolesUseCase,
+		setRolePermissionsUseCase:      setRolePermissionsUseCase,
+		revokeRolePermissionUseCase:    revokeRolePermissionUseCase,
+		getEffectivePermissionsUseCase: getEffectivePermissionsUseCase,
+		validator:                      validator,
+		logger:                         logger,
+	}
+}
+
+// CreateRole handles POST /api/v1/roles
+// @Summary      Create a new role
+// @Description  Create a new role granting the given permission codes
+// @Tags         roles
+// @Accept       json
+// @Produce      json
+// @Param        role body role.CreateRoleRequest true "Role information"
+// @Success      201 {object} map[string]interface{} "Role created successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - validation error or unknown permission"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
+// @Failure      409 {object} map[string]interface{} "Conflict - role code already exists"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /roles [post]
+func (h *RoleHandler) CreateRole(c *gin.Context) {
+	var req role.CreateRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Failed to bind JSON", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid request format", err)
+		return
+	}
+
+	// Validate request
+	if err := h.validator.Struct(&req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationRequired, "Validation failed", err)
+		return
+	}
+
+	// Execute use case
+	resp, err := h.createRoleUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"data": resp.Role,
+	})
+}
+
+// GetRole handles GET /api/v1/roles/:id
+// @Summary      Get role by ID
+// @Description  Retrieve a role by its unique identifier
+// @Tags         roles
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Role ID"
+// @Success      200 {object} map[string]interface{} "Role retrieved successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - invalid role ID"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
+// @Failure      404 {object} map[string]interface{} "Role not found"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /roles/{id} [get]
+func (h *RoleHandler) GetRole(c *gin.Context) {
+	req := &role.GetRoleRequest{ID: c.Param("id")}
+
+	// Validate request
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid role ID format", err)
+		return
+	}
+
+	// Execute use case
+	resp, err := h.getRoleUseCase.Execute(c.Request.Context(), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resp.Role,
+	})
+}
+
+// UpdateRole handles PUT /api/v1/roles/:id
+// @Summary      Update role
+// @Description  Update a role's name, description, parent role and active state, system roles cannot be deactivated
+// @Tags         roles
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Role ID"
+// @Param        role body role.UpdateRoleRequest true "Updated role information"
+// @Success      200 {object} map[string]interface{} "Role updated successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - validation error"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
+// @Failure      404 {object} map[string]interface{} "Role not found"
+// @Failure      409 {object} map[string]interface{} "Conflict - system role cannot be deactivated or parent creates a cycle"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /roles/{id} [put]
+func (h *RoleHandler) UpdateRole(c *gin.Context) {
+	var req role.UpdateRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Failed to bind JSON", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid request format", err)
+		return
+	}
+
+	// Set ID from URL parameter
+	req.ID = c.Param("id")
+
+	// Validate request
+	if err := h.validator.Struct(&req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationRequired, "Validation failed", err)
+		return
+	}
+
+	// Execute use case
+	resp, err := h.updateRoleUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resp.Role,
+	})
+}
+
+// DeleteRole handles DELETE /api/v1/roles/:id
+// @Summary      Delete role
+// @Description  Soft delete a role, system roles, roles assigned to users and parent roles cannot be deleted
+// @Tags         roles
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Role ID"
+// @Success      200 {object} map[string]interface{} "Role deleted successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - invalid role ID"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
+// @Failure      404 {object} map[string]interface{} "Role not found"
+// @Failure      409 {object} map[string]interface{} "Conflict - system role or role in use"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /roles/{id} [delete]
+func (h *RoleHandler) DeleteRole(c *gin.Context) {
+	req := &role.DeleteRoleRequest{ID: c.Param("id")}
+
+	// Validate request
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid role ID format", err)
+		return
+	}
+
+	// Execute use case
+	resp, err := h.deleteRoleUseCase.Execute(c.Request.Context(), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resp,
+	})
+}
+
+// ListRoles handles GET /api/v1/roles
+// @Summary      List roles
+// @Description  Retrieve a paginated list of roles ordered by name
+// @Tags         roles
+// @Accept       json
+// @Produce      json
+// @Param        limit query int false "Number of roles to return" default(10) minimum(1) maximum(100)
+// @Param        offset query int false "Number of roles to skip" default(0) minimum(0)
+// @Success      200 {object} map[string]interface{} "Roles retrieved successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - invalid pagination parameters"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /roles [get]
+func (h *RoleHandler) ListRoles(c *gin.Context) {
+	var req role.ListRolesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.logger.Error("Failed to bind query", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid query parameters", err)
+		return
+	}
+
+	// Validate request
+	if err := h.validator.Struct(&req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationRange, "Validation failed", err)
+		return
+	}
+
+	// Execute use case
+	resp, err := h.listRolesUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resp,
+	})
+}
+
+// SetRolePermissions handles PUT /api/v1/roles/:id/permissions
+// @Summary      Set role permissions
+// @Description  Replace the permission codes granted by a role, system roles cannot lose permissions
+// @Tags         roles
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Role ID"
+// @Param        permissions body role.SetRolePermissionsRequest true "Permission codes"
+// @Success      200 {object} map[string]interface{} "Role permissions updated successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - validation error or unknown permission"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
+// @Failure      404 {object} map[string]interface{} "Role not found"
+// @Failure      409 {object} map[string]interface{} "Conflict - permissions cannot be removed from a system role"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /roles/{id}/permissions [put]
+func (h *RoleHandler) SetRolePermissions(c *gin.Context) {
+	var req role.SetRolePermissionsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Failed to bind JSON", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid request format", err)
+		return
+	}
+
+	// Set ID from URL parameter
+	req.ID = c.Param("id")
+
+	// Validate request
+	if err := h.validator.Struct(&req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationRequired, "Validation failed", err)
+		return
+	}
+
+	// Execute use case
+	resp, err := h.setRolePermissionsUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resp.Role,
+	})
+}
+
+// RevokeRolePermission handles DELETE /api/v1/roles/:id/permissions/:code
+// @Summary      Revoke role permission
+// @Description  Revoke a single permission code from a role, not allowed for system roles
+// @Tags         roles
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Role ID"
+// @Param        code path string true "Permission code"
+// @Success      200 {object} map[string]interface{} "Permission revoked successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - invalid role ID"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
+// @Failure      404 {object} map[string]interface{} "Role not found or permission not granted"
+// @Failure      409 {object} map[string]interface{} "Conflict - permissions cannot be removed from a system role"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /roles/{id}/permissions/{code} [delete]
+func (h *RoleHandler) RevokeRolePermission(c *gin.Context) {
+	req := &role.RevokeRolePermissionRequest{
+		ID:             c.Param("id"),
+		PermissionCode: c.Param("code"),
+	}
+
+	// Validate request
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid request parameters", err)
+		return
+	}
+
+	// Execute use case
+	resp, err := h.revokeRolePermissionUseCase.Execute(c.Request.Context(), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resp.Role,
+	})
+}
+
+// GetEffectivePermissions handles GET /api/v1/roles/:id/effective-permissions
+// @Summary      Get effective role permissions
+// @Description  Retrieve the permissions a role grants directly and inherits from its parent roles
+// @Tags         roles
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Role ID"
+// @Success      200 {object} map[string]interface{} "Effective permissions retrieved successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - invalid role ID"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
+// @Failure      404 {object} map[string]interface{} "Role not found"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /roles/{id}/effective-permissions [get]
+func (h *RoleHandler) GetEffectivePermissions(c *gin.Context) {
+	req := &role.GetEffectivePermissionsRequest{ID: c.Param("id")}
+
+	// Validate request
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid role ID format", err)
+		return
+	}
+
+	// Execute use case
+	resp, err := h.getEffectivePermissionsUseCase.Execute(c.Request.Context(), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resp,
+	})
+}
+
+// handleError handles application errors and returns appropriate HTTP responses
+func (h *RoleHandler) handleError(c *gin.Context, err error) {
+	respondError(c, h.logger, err)
+}
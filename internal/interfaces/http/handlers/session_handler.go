@@ -0,0 +1,204 @@
+package handlers
+
+import (
+	"net/http"
+
+	"bm-staff/internal/usecases/user"
+	"bm-staff/pkg/actor"
+	"bm-staff/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+// SessionHandler handles HTTP requests for the sessions of the current user and, for admins, of any user
+type SessionHandler struct {
+	listSessionsUseCase      *user.ListSessionsUseCase
+	revokeSessionUseCase     *user.RevokeSessionUseCase
+	revokeAllSessionsUseCase *user.RevokeAllSessionsUseCase
+	validator                *validator.Validate
+	logger                   *zap.Logger
+}
+
+// NewSessionHandler creates a new session handler
+func NewSessionHandler(
+	listSessionsUseCase *user.ListSessionsUseCase,
+	revokeSessionUseCase *user.RevokeSessionUseCase,
+	revokeAllSessionsUseCase *user.RevokeAllSessionsUseCase,
+	validator *validator.Validate,
+	logger *zap.Logger,
+) *SessionHandler {
+	return &SessionHandler{
+		listSessionsUseCase:      listSessionsUseCase,
+		revokeSessionUseCase:     revokeSessionUseCase,
+		revokeAllSessionsUseCase: revokeAllSessionsUseCase,
+		validator:                validator,
+		logger:                   logger,
+	}
+}
+
+// ListMySessions handles GET /api/v1/auth/sessions
+// @Summary      List my sessions
+// @Description  Active sessions of the current user with device, browser, IP and last use, most recently used first
+// @Tags         sessions
+// @Produce      json
+// @Success      200 {object} map[string]interface{} "Sessions retrieved successfully"
+// @Failure      401 {object} map[string]interface{} "Unauthorized"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /auth/sessions [get]
+func (h *SessionHandler) ListMySessions(c *gin.Context) {
+	h.listSessions(c, currentUserID(c))
+}
+
+// RevokeMySession handles DELETE /api/v1/auth/sessions/:id
+// @Summary      Revoke my session
+// @Description  Sign the current user out of one session; its access tokens expire on their own
+// @Tags         sessions
+// @Produce      json
+// @Param        id path string true "Session ID"
+// @Success      200 {object} map[string]interface{} "Session revoked successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - invalid session ID"
+// @Failure      401 {object} map[string]interface{} "Unauthorized"
+// @Failure      404 {object} map[string]interface{} "Session not found"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /auth/sessions/{id} [delete]
+func (h *SessionHandler) RevokeMySession(c *gin.Context) {
+	h.revokeSession(c, currentUserID(c), c.Param("id"))
+}
+
+// RevokeAllMySessions handles DELETE /api/v1/auth/sessions
+// @Summary      Log out everywhere
+// @Description  Revoke every session of the current user, including the access token of this request
+// @Tags         sessions
+// @Produce      json
+// @Success      200 {object} map[string]interface{} "Sessions revoked successfully"
+// @Failure      401 {object} map[string]interface{} "Unauthorized"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /auth/sessions [delete]
+func (h *SessionHandler) RevokeAllMySessions(c *gin.Context) {
+	h.revokeAllSessions(c, currentUserID(c))
+}
+
+// ListUserSessions handles GET /api/v1/users/:id/sessions
+// @Summary      List user sessions
+// @Description  Active sessions of a user with device, browser, IP and last use, most recently used first
+// @Tags         sessions
+// @Produce      json
+// @Param        id path string true "User ID"
+// @Success      200 {object} map[string]interface{} "Sessions retrieved successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - invalid user ID"
+// @Failure      403 {object} map[string]interface{} "Forbidden - denied by user access policies"
+// @Failure      404 {object} map[string]interface{} "User not found"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /users/{id}/sessions [get]
+func (h *SessionHandler) ListUserSessions(c *gin.Context) {
+	h.listSessions(c, c.Param("id"))
+}
+
+// RevokeUserSession handles DELETE /api/v1/users/:id/sessions/:session_id
+// @Summary      Revoke user session
+// @Description  Sign a user out of one session; its access tokens expire on their own
+// @Tags         sessions
+// @Produce      json
+// @Param        id path string true "User ID"
+// @Param        session_id path string true "Session ID"
+// @Success      200 {object} map[string]interface{} "Session revoked successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - invalid ID"
+// @Failure      403 {object} map[string]interface{} "Forbidden - denied by user access policies"
+// @Failure      404 {object} map[string]interface{} "User or session not found"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /users/{id}/sessions/{session_id} [delete]
+func (h *SessionHandler) RevokeUserSession(c *gin.Context) {
+	h.revokeSession(c, c.Param("id"), c.Param("session_id"))
+}
+
+// RevokeAllUserSessions handles DELETE /api/v1/users/:id/sessions
+// @Summary      Log a user out everywhere
+// @Description  Revoke every session and access token of a user
+// @Tags         sessions
+// @Produce      json
+// @Param        id path string true "User ID"
+// @Success      200 {object} map[string]interface{} "Sessions revoked successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - invalid user ID"
+// @Failure      403 {object} map[string]interface{} "Forbidden - denied by user access policies"
+// @Failure      404 {object} map[string]interface{} "User not found"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /users/{id}/sessions [delete]
+func (h *SessionHandler) RevokeAllUserSessions(c *gin.Context) {
+	h.revokeAllSessions(c, c.Param("id"))
+}
+
+// listSessions lists the sessions of a user
+func (h *SessionHandler) listSessions(c *gin.Context, userID string) {
+	req := &user.ListSessionsRequest{UserID: userID}
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid user ID format", err)
+		return
+	}
+
+	resp, err := h.listSessionsUseCase.Execute(c.Request.Context(), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resp.Sessions,
+	})
+}
+
+// revokeSession revokes one session of a user
+func (h *SessionHandler) revokeSession(c *gin.Context, userID, sessionID string) {
+	req := &user.RevokeSessionRequest{UserID: userID, SessionID: sessionID}
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid ID format", err)
+		return
+	}
+
+	resp, err := h.revokeSessionUseCase.Execute(c.Request.Context(), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resp,
+	})
+}
+
+// revokeAllSessions revokes every session of a user
+func (h *SessionHandler) revokeAllSessions(c *gin.Context, userID string) {
+	req := &user.RevokeAllSessionsRequest{UserID: userID}
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid user ID format", err)
+		return
+	}
+
+	resp, err := h.revokeAllSessionsUseCase.Execute(c.Request.Context(), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resp,
+	})
+}
+
+// handleError handles application errors and returns appropriate HTTP responses
+func (h *SessionHandler) handleError(c *gin.Context, err error) {
+	respondError(c, h.logger, err)
+}
+
+// currentUserID returns the authenticated user's ID, empty when the request is anonymous
+func currentUserID(c *gin.Context) string {
+	current, ok := actor.FromContext(c.Request.Context())
+	if !ok {
+		return ""
+	}
+	return current.UserID.String()
+}
@@ -2,7 +2,6 @@ package handlers
 
 import (
 	"net/http"
-	"strconv"
 
 	"bm-staff/internal/usecases/user"
 	"bm-staff/pkg/errors"
@@ -14,12 +13,20 @@ import (
 
 // UserHandler handles HTTP requests for user operations
 type UserHandler struct {
-	createUserUseCase *user.CreateUserUseCase
-	getUserUseCase    *user.GetUserUseCase
-	updateUserUseCase *user.UpdateUserUseCase
-	deleteUserUseCase *user.DeleteUserUseCase
-	validator         *validator.Validate
-	logger            *zap.Logger
+	createUserUseCase         *user.CreateUserUseCase
+	getUserUseCase            *user.GetUserUseCase
+	updateUserUseCase         *user.UpdateUserUseCase
+	deleteUserUseCase         *user.DeleteUserUseCase
+	listUsersUseCase          *user.ListUsersUseCase
+	assignRoleUseCase         *user.AssignRoleUseCase
+	updateOrganizationUseCase *user.UpdateOrganizationUseCase
+	updateUserStatusUseCase   *user.UpdateUserStatusUseCase
+	unlockUserUseCase         *user.UnlockUserUseCase
+	getReportsUseCase         *user.GetReportsUseCase
+	getManagerChainUseCase    *user.GetManagerChainUseCase
+	getOrgChartUseCase        *user.GetOrgChartUseCase
+	validator                 *validator.Validate
+	logger                    *zap.Logger
 }
 
 // NewUserHandler creates a new user handler
@@ -28,16 +35,32 @@ func NewUserHandler(
 	getUserUseCase *user.GetUserUseCase,
 	updateUserUseCase *user.UpdateUserUseCase,
 	deleteUserUseCase *user.DeleteUserUseCase,
+	listUsersUseCase *user.ListUsersUseCase,
+	assignRoleUseCase *user.AssignRoleUseCase,
+	updateOrganizationUseCase *user.UpdateOrganizationUseCase,
+	updateUserStatusUseCase *user.UpdateUserStatusUseCase,
+	unlockUserUseCase *user.UnlockUserUseCase,
+	getReportsUseCase *user.GetReportsUseCase,
+	getManagerChainUseCase *user.GetManagerChainUseCase,
+	getOrgChartUseCase *user.GetOrgChartUseCase,
 	validator *validator.Validate,
 	logger *zap.Logger,
 ) *UserHandler {
 	return &UserHandler{
-		createUserUseCase: createUserUseCase,
-		getUserUseCase:    getUserUseCase,
-		updateUserUseCase: updateUserUseCase,
-		deleteUserUseCase: deleteUserUseCase,
-		validator:         validator,
-		logger:            logger,
+		createUserUseCase:         createUserUseCase,
+		getUserUseCase:            getUserUseCase,
+		updateUserUseCase:         updateUserUseCase,
+		deleteUserUseCase:         deleteUserUseCase,
+		listUsersUseCase:          listUsersUseCase,
+		assignRoleUseCase:         assignRoleUseCase,
+		updateOrganizationUseCase: updateOrganizationUseCase,
+		updateUserStatusUseCase:   updateUserStatusUseCase,
+		unlockUserUseCase:         unlockUserUseCase,
+		getReportsUseCase:         getReportsUseCase,
+		getManagerChainUseCase:    getManagerChainUseCase,
+		getOrgChartUseCase:        getOrgChartUseCase,
+		validator:                 validator,
+		logger:                    logger,
 	}
 }
 
@@ -50,6 +73,7 @@ func NewUserHandler(
 // @Param        user body user.CreateUserRequest true "User information"
 // @Success      201 {object} map[string]interface{} "User created successfully"
 // @Failure      400 {object} map[string]interface{} "Bad request - validation error"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
 // @Failure      409 {object} map[string]interface{} "Conflict - user already exists"
 // @Failure      500 {object} map[string]interface{} "Internal server error"
 // @Router       /users [post]
@@ -101,6 +125,7 @@ func (h *UserHandler) CreateUser(c *gin.Context) {
 // @Param        id path string true "User ID"
 // @Success      200 {object} map[string]interface{} "User retrieved successfully"
 // @Failure      400 {object} map[string]interface{} "Bad request - invalid user ID"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
 // @Failure      404 {object} map[string]interface{} "User not found"
 // @Failure      500 {object} map[string]interface{} "Internal server error"
 // @Router       /users/{id} [get]
@@ -143,6 +168,7 @@ func (h *UserHandler) GetUser(c *gin.Context) {
 // @Param        user body user.UpdateUserRequest true "Updated user information"
 // @Success      200 {object} map[string]interface{} "User updated successfully"
 // @Failure      400 {object} map[string]interface{} "Bad request - validation error"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
 // @Failure      404 {object} map[string]interface{} "User not found"
 // @Failure      409 {object} map[string]interface{} "Conflict - username/email already exists"
 // @Failure      500 {object} map[string]interface{} "Internal server error"
@@ -200,6 +226,7 @@ func (h *UserHandler) UpdateUser(c *gin.Context) {
 // @Param        id path string true "User ID"
 // @Success      200 {object} map[string]interface{} "User deleted successfully"
 // @Failure      400 {object} map[string]interface{} "Bad request - invalid user ID"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
 // @Failure      404 {object} map[string]interface{} "User not found"
 // @Failure      500 {object} map[string]interface{} "Internal server error"
 // @Router       /users/{id} [delete]
@@ -234,86 +261,364 @@ func (h *UserHandler) DeleteUser(c *gin.Context) {
 
 // ListUsers handles GET /api/v1/users
 // @Summary      List users
-// @Description  Retrieve a paginated list of users
+// @Description  Retrieve a filtered, sorted and paginated list of users
 // @Tags         users
 // @Accept       json
 // @Produce      json
+// @Param        status query string false "Filter by status" Enums(ACTIVE, INACTIVE, PENDING, BLOCKED)
+// @Param        department_id query string false "Filter by department ID"
+// @Param        role_id query string false "Filter by role ID"
+// @Param        manager_id query string false "Filter by manager ID"
+// @Param        created_from query string false "Created at or after (RFC3339)"
+// @Param        created_to query string false "Created at or before (RFC3339)"
+// @Param        search query string false "Search username, email and name"
+// @Param        sort_by query string false "Sort field" Enums(created_at, updated_at, username, email, first_name, last_name, status, last_login_at)
+// @Param        sort_order query string false "Sort order" Enums(asc, desc)
 // @Param        limit query int false "Number of users to return" default(10) minimum(1) maximum(100)
 // @Param        offset query int false "Number of users to skip" default(0) minimum(0)
+// @Param        cursor query string false "Opaque keyset cursor from a previous next_cursor/prev_cursor"
 // @Success      200 {object} map[string]interface{} "Users retrieved successfully"
-// @Failure      400 {object} map[string]interface{} "Bad request - invalid pagination parameters"
+// @Failure      400 {object} map[string]interface{} "Bad request - invalid filter or pagination parameters"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
 // @Failure      500 {object} map[string]interface{} "Internal server error"
 // @Router       /users [get]
 func (h *UserHandler) ListUsers(c *gin.Context) {
-	// Parse pagination parameters
-	limitStr := c.DefaultQuery("limit", "10")
-	offsetStr := c.DefaultQuery("offset", "0")
+	var req user.ListUsersRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.logger.Error("Failed to bind query", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    errors.ErrValidationFormat,
+				"message": "Invalid query parameters",
+				"details": gin.H{"error": err.Error()},
+			},
+		})
+		return
+	}
 
-	limit, err := strconv.Atoi(limitStr)
-	if err != nil || limit <= 0 {
-		limit = 10
+	// Validate request
+	if err := h.validator.Struct(&req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    errors.ErrValidationRange,
+				"message": "Validation failed",
+				"details": gin.H{"error": err.Error()},
+			},
+		})
+		return
 	}
 
-	offset, err := strconv.Atoi(offsetStr)
-	if err != nil || offset < 0 {
-		offset = 0
+	// Execute use case
+	resp, err := h.listUsersUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		h.handleError(c, err)
+		return
 	}
 
-	// TODO: Implement ListUsers use case
 	c.JSON(http.StatusOK, gin.H{
-		"data": gin.H{
-			"users": []interface{}{},
-			"pagination": gin.H{
-				"limit":  limit,
-				"offset": offset,
-				"total":  0,
-			},
-		},
+		"data": resp,
 	})
 }
 
-// handleError handles application errors and returns appropriate HTTP responses
-func (h *UserHandler) handleError(c *gin.Context, err error) {
-	h.logger.Error("Handler error", zap.Error(err))
+// AssignRole handles PUT /api/v1/users/:id/role
+// @Summary      Assign role to user
+// @Description  Assign an active role to a user, an empty role_id removes the user's role. Callers cannot change their own role and must hold every permission of the current and the new role; the user's access tokens are revoked
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "User ID"
+// @Param        role body user.AssignRoleRequest true "Role assignment"
+// @Success      200 {object} map[string]interface{} "Role assigned successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - validation error"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
+// @Failure      404 {object} map[string]interface{} "User or role not found"
+// @Failure      409 {object} map[string]interface{} "Conflict - role is inactive"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /users/{id}/role [put]
+func (h *UserHandler) AssignRole(c *gin.Context) {
+	var req user.AssignRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Failed to bind JSON", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid request format", err)
+		return
+	}
 
-	if appErr, ok := err.(*errors.AppError); ok {
-		statusCode := h.getStatusCodeFromErrorCode(appErr.Code)
-		c.JSON(statusCode, gin.H{
-			"error": gin.H{
-				"code":      appErr.Code,
-				"message":   appErr.Message,
-				"details":   appErr.Details,
-				"timestamp": appErr.Timestamp,
-			},
-		})
+	// Set ID from URL parameter
+	req.ID = c.Param("id")
+
+	// Validate request
+	if err := h.validator.Struct(&req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationRequired, "Validation failed", err)
+		return
+	}
+
+	// Execute use case
+	resp, err := h.assignRoleUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resp.User,
+	})
+}
+
+// UpdateOrganization handles PUT /api/v1/users/:id/organization
+// @Summary      Update user organization
+// @Description  Set a user's department, manager and employee code, managers creating a reporting line cycle are rejected
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "User ID"
+// @Param        organization body user.UpdateOrganizationRequest true "Organization information"
+// @Success      200 {object} map[string]interface{} "Organization updated successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - validation error"
+// @Failure      403 {object} map[string]interface{} "Forbidden - denied by user access policies"
+// @Failure      404 {object} map[string]interface{} "User, department or manager not found"
+// @Failure      409 {object} map[string]interface{} "Conflict - reporting line cycle or inactive department"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /users/{id}/organization [put]
+func (h *UserHandler) UpdateOrganization(c *gin.Context) {
+	var req user.UpdateOrganizationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Failed to bind JSON", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid request format", err)
+		return
+	}
+
+	// Set ID from URL parameter
+	req.ID = c.Param("id")
+
+	// Validate request
+	if err := h.validator.Struct(&req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationRequired, "Validation failed", err)
+		return
+	}
+
+	// Execute use case
+	resp, err := h.updateOrganizationUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		h.handleError(c, err)
 		return
 	}
 
-	// Generic error
-	c.JSON(http.StatusInternalServerError, gin.H{
-		"error": gin.H{
-			"code":    errors.ErrSystemInternal,
-			"message": "Internal server error",
-		},
+	c.JSON(http.StatusOK, gin.H{
+		"data": resp.User,
 	})
 }
 
-// getStatusCodeFromErrorCode maps error codes to HTTP status codes
-func (h *UserHandler) getStatusCodeFromErrorCode(code string) int {
-	switch code {
-	case errors.ErrValidationRequired, errors.ErrValidationFormat, errors.ErrValidationRange:
-		return http.StatusBadRequest
-	case errors.ErrAuthInvalidToken, errors.ErrAuthExpiredToken, errors.ErrAuthInsufficient:
-		return http.StatusUnauthorized
-	case errors.ErrBusinessNotFound:
-		return http.StatusNotFound
-	case errors.ErrBusinessConflict:
-		return http.StatusConflict
-	case errors.ErrBusinessLimit:
-		return http.StatusTooManyRequests
-	case errors.ErrExternalTimeout, errors.ErrExternalUnavailable, errors.ErrExternalInvalid:
-		return http.StatusBadGateway
-	default:
-		return http.StatusInternalServerError
+// UpdateUserStatus handles PUT /api/v1/users/:id/status
+// @Summary      Update user status
+// @Description  Activate, deactivate or block a user; deactivated and blocked users lose their sessions and access tokens immediately
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "User ID"
+// @Param        status body user.UpdateUserStatusRequest true "New status"
+// @Success      200 {object} map[string]interface{} "Status updated successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - validation error"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
+// @Failure      404 {object} map[string]interface{} "User not found"
+// @Failure      409 {object} map[string]interface{} "Conflict - blocked users cannot be activated"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /users/{id}/status [put]
+func (h *UserHandler) UpdateUserStatus(c *gin.Context) {
+	var req user.UpdateUserStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Error("Failed to bind JSON", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid request format", err)
+		return
 	}
+
+	// Set ID from URL parameter
+	req.ID = c.Param("id")
+
+	// Validate request
+	if err := h.validator.Struct(&req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationRequired, "Validation failed", err)
+		return
+	}
+
+	// Execute use case
+	resp, err := h.updateUserStatusUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resp.User,
+	})
+}
+
+// UnlockUser handles POST /api/v1/users/:id/unlock
+// @Summary      Unlock user
+// @Description  Lift the lockout of a user after failed logins and reset their failed login count; requires users:unlock
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "User ID"
+// @Success      200 {object} map[string]interface{} "User unlocked successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - invalid user ID"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
+// @Failure      404 {object} map[string]interface{} "User not found"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /users/{id}/unlock [post]
+func (h *UserHandler) UnlockUser(c *gin.Context) {
+	req := &user.UnlockUserRequest{ID: c.Param("id")}
+
+	// Validate request
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid user ID format", err)
+		return
+	}
+
+	// Execute use case
+	resp, err := h.unlockUserUseCase.Execute(c.Request.Context(), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "User unlocked successfully",
+		"data":    resp.User,
+	})
+}
+
+// GetReports handles GET /api/v1/users/:id/reports
+// @Summary      Get user reports
+// @Description  Retrieve the users reporting to a user, directly or at any depth when transitive
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "User ID"
+// @Param        transitive query bool false "Include indirect reports" default(false)
+// @Success      200 {object} map[string]interface{} "Reports retrieved successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - invalid user ID"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
+// @Failure      404 {object} map[string]interface{} "User not found"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /users/{id}/reports [get]
+func (h *UserHandler) GetReports(c *gin.Context) {
+	var req user.GetReportsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.logger.Error("Failed to bind query", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid query parameters", err)
+		return
+	}
+
+	// Set ID from URL parameter
+	req.ID = c.Param("id")
+
+	// Validate request
+	if err := h.validator.Struct(&req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid user ID format", err)
+		return
+	}
+
+	// Execute use case
+	resp, err := h.getReportsUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resp,
+	})
+}
+
+// GetManagerChain handles GET /api/v1/users/:id/manager-chain
+// @Summary      Get user manager chain
+// @Description  Retrieve the managers of a user up to the top of the reporting line, nearest first
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "User ID"
+// @Success      200 {object} map[string]interface{} "Manager chain retrieved successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - invalid user ID"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
+// @Failure      404 {object} map[string]interface{} "User not found"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /users/{id}/manager-chain [get]
+func (h *UserHandler) GetManagerChain(c *gin.Context) {
+	req := &user.GetManagerChainRequest{ID: c.Param("id")}
+
+	// Validate request
+	if err := h.validator.Struct(req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid user ID format", err)
+		return
+	}
+
+	// Execute use case
+	resp, err := h.getManagerChainUseCase.Execute(c.Request.Context(), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resp,
+	})
+}
+
+// GetOrgChart handles GET /api/v1/org-chart
+// @Summary      Export org chart
+// @Description  Export the reporting lines as nested JSON or a Graphviz DOT digraph, optionally below a root user
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Produce      plain
+// @Param        root_id query string false "Root user ID, the whole organization when omitted"
+// @Param        format query string false "Export format" Enums(json, dot) default(json)
+// @Success      200 {object} map[string]interface{} "Org chart exported successfully"
+// @Failure      400 {object} map[string]interface{} "Bad request - invalid root ID or format"
+// @Failure      403 {object} map[string]interface{} "Forbidden - insufficient permissions"
+// @Failure      404 {object} map[string]interface{} "Root user not found"
+// @Failure      500 {object} map[string]interface{} "Internal server error"
+// @Router       /org-chart [get]
+func (h *UserHandler) GetOrgChart(c *gin.Context) {
+	var req user.GetOrgChartRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.logger.Error("Failed to bind query", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Invalid query parameters", err)
+		return
+	}
+
+	// Validate request
+	if err := h.validator.Struct(&req); err != nil {
+		h.logger.Error("Validation failed", zap.Error(err))
+		respondBadRequest(c, errors.ErrValidationFormat, "Validation failed", err)
+		return
+	}
+
+	// Execute use case
+	resp, err := h.getOrgChartUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	if req.Format == "dot" {
+		c.Data(http.StatusOK, "text/vnd.graphviz; charset=utf-8", []byte(resp.DOT()))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resp,
+	})
+}
+
+// handleError handles application errors and returns appropriate HTTP responses
+func (h *UserHandler) handleError(c *gin.Context, err error) {
+	respondError(c, h.logger, err)
 }
@@ -2,29 +2,38 @@ package middleware
 
 import (
 	"net/http"
+	"slices"
 
 	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/actor"
+	"bm-staff/pkg/errors"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
-// AuthMiddleware provides JWT authentication middleware
+// AuthMiddleware provides JWT authentication and authorization middleware
 type AuthMiddleware struct {
-	jwtService *services.JWTService
-	logger     *zap.Logger
+	jwtService           *services.JWTService
+	tokenRevoker         *services.TokenRevoker
+	authorizationService *services.AuthorizationService
+	logger               *zap.Logger
 }
 
 // NewAuthMiddleware creates a new authentication middleware
-func NewAuthMiddleware(jwtService *services.JWTService, logger *zap.Logger) *AuthMiddleware {
+func NewAuthMiddleware(jwtService *services.JWTService, tokenRevoker *services.TokenRevoker, authorizationService *services.AuthorizationService, logger *zap.Logger) *AuthMiddleware {
 	return &AuthMiddleware{
-		jwtService: jwtService,
-		logger:     logger,
+		jwtService:           jwtService,
+		tokenRevoker:         tokenRevoker,
+		authorizationService: authorizationService,
+		logger:               logger,
 	}
 }
 
 // RequireAuth middleware that requires valid JWT token
-func (am *AuthMiddleware) RequireAuth() gin.HandlerFunc {
+// Tokens restricted to a scope, e.g. for changing an expired password, are only accepted when the scope is listed.
+func (am *AuthMiddleware) RequireAuth(scopes ...string) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// Get Authorization header
 		authHeader := c.GetHeader("Authorization")
@@ -84,12 +93,53 @@ func (am *AuthMiddleware) RequireAuth() gin.HandlerFunc {
 			return
 		}
 
+		// Check a restricted token is allowed here
+		if claims.Scope != "" && !slices.Contains(scopes, claims.Scope) {
+			am.logger.Warn("Restricted token outside its scope",
+				zap.String("path", c.Request.URL.Path),
+				zap.String("method", c.Request.Method),
+				zap.String("scope", claims.Scope),
+			)
+			c.JSON(http.StatusForbidden, gin.H{
+				"error": gin.H{
+					"code":    errors.ErrAuthInsufficient,
+					"message": "Token is restricted to another scope",
+					"details": gin.H{"scope": claims.Scope},
+				},
+			})
+			c.Abort()
+			return
+		}
+
+		// Check the token has not been revoked by logout, blocking or a password change
+		revoked, err := am.tokenRevoker.IsRevoked(c.Request.Context(), claims)
+		if err != nil {
+			am.logger.Error("Failed to check token revocation",
+				zap.String("user_id", claims.UserID.String()),
+				zap.Error(err),
+			)
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Internal server error",
+			})
+			c.Abort()
+			return
+		}
+		if revoked {
+			am.logger.Warn("Revoked token",
+				zap.String("path", c.Request.URL.Path),
+				zap.String("method", c.Request.Method),
+				zap.String("user_id", claims.UserID.String()),
+				zap.String("jti", claims.ID),
+			)
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"error": "Invalid or expired token",
+			})
+			c.Abort()
+			return
+		}
+
 		// Set user information in context
-		c.Set("user_id", claims.UserID)
-		c.Set("username", claims.Username)
-		c.Set("email", claims.Email)
-		c.Set("role_id", claims.RoleID)
-		c.Set("claims", claims)
+		setAuthContext(c, claims)
 
 		am.logger.Debug("User authenticated successfully",
 			zap.String("user_id", claims.UserID.String()),
@@ -128,14 +178,16 @@ func (am *AuthMiddleware) OptionalAuth() gin.HandlerFunc {
 			return
 		}
 
-		// Check if token is for API access
-		if len(claims.Audience) > 0 && claims.Audience[0] == "bm-staff-api" {
+		// Revoked tokens, like invalid ones, leave the request unauthenticated
+		if revoked, err := am.tokenRevoker.IsRevoked(c.Request.Context(), claims); err != nil || revoked {
+			c.Next()
+			return
+		}
+
+		// Check if token is for API access, restricted tokens leave the request unauthenticated
+		if len(claims.Audience) > 0 && claims.Audience[0] == "bm-staff-api" && claims.Scope == "" {
 			// Set user information in context
-			c.Set("user_id", claims.UserID)
-			c.Set("username", claims.Username)
-			c.Set("email", claims.Email)
-			c.Set("role_id", claims.RoleID)
-			c.Set("claims", claims)
+			setAuthContext(c, claims)
 
 			am.logger.Debug("User authenticated successfully (optional)",
 				zap.String("user_id", claims.UserID.String()),
@@ -148,42 +200,133 @@ func (am *AuthMiddleware) OptionalAuth() gin.HandlerFunc {
 	}
 }
 
-// RequireRole middleware that requires specific role
-func (am *AuthMiddleware) RequireRole(requiredRole string) gin.HandlerFunc {
+// setAuthContext stores the authenticated user in the gin context and,
+// as the acting user, in the request context seen by use cases
+func setAuthContext(c *gin.Context, claims *services.JWTClaims) {
+	c.Set("user_id", claims.UserID)
+	c.Set("username", claims.Username)
+	c.Set("email", claims.Email)
+	c.Set("role_id", claims.RoleID)
+	c.Set("claims", claims)
+
+	c.Request = c.Request.WithContext(actor.WithActor(c.Request.Context(), &actor.Actor{
+		UserID:    claims.UserID,
+		Username:  claims.Username,
+		RoleID:    claims.RoleID,
+		IPAddress: c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+		SessionID: claims.ID,
+	}))
+}
+
+// RequireRole middleware that requires one of the given role codes
+// Must be used after RequireAuth, the role is resolved from the role_id claim.
+func (am *AuthMiddleware) RequireRole(roleCodes ...string) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		// First check if user is authenticated
-		userID, exists := c.Get("user_id")
-		if !exists {
-			c.JSON(http.StatusUnauthorized, gin.H{
-				"error": "Authentication required",
-			})
-			c.Abort()
+		roleID, ok := am.requireRoleID(c)
+		if !ok {
 			return
 		}
 
-		// Get role from context (this would need to be populated from database)
-		// For now, we'll just check if user is authenticated
-		// In a real implementation, you'd fetch the user's role from database
-		_ = userID
-		_ = requiredRole
+		allowed, err := am.authorizationService.HasRole(c.Request.Context(), roleID, roleCodes...)
+		if err != nil {
+			am.abortAuthorizationError(c, err)
+			return
+		}
+
+		if !allowed {
+			am.logger.Warn("Role requirement not met",
+				zap.Strings("required_roles", roleCodes),
+				zap.String("path", c.Request.URL.Path),
+				zap.String("method", c.Request.Method),
+			)
+			abortForbidden(c)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// RequirePermission middleware that requires a permission code (e.g. "users:delete")
+// Must be used after RequireAuth, the role is resolved from the role_id claim.
+func (am *AuthMiddleware) RequirePermission(permissionCode string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		roleID, ok := am.requireRoleID(c)
+		if !ok {
+			return
+		}
+
+		allowed, err := am.authorizationService.HasPermission(c.Request.Context(), roleID, permissionCode)
+		if err != nil {
+			am.abortAuthorizationError(c, err)
+			return
+		}
 
-		// TODO: Implement role-based access control
-		// This would require:
-		// 1. Fetching user's role from database
-		// 2. Checking if user has required role
-		// 3. Returning 403 Forbidden if not authorized
+		if !allowed {
+			am.logger.Warn("Permission requirement not met",
+				zap.String("required_permission", permissionCode),
+				zap.String("path", c.Request.URL.Path),
+				zap.String("method", c.Request.Method),
+			)
+			abortForbidden(c)
+			return
+		}
 
 		c.Next()
 	}
 }
 
+// requireRoleID reads the caller's role ID set by RequireAuth, aborting when unauthenticated
+func (am *AuthMiddleware) requireRoleID(c *gin.Context) (*uuid.UUID, bool) {
+	if _, exists := c.Get("user_id"); !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "Authentication required",
+		})
+		c.Abort()
+		return nil, false
+	}
+
+	roleID, _ := c.Get("role_id")
+	id, _ := roleID.(*uuid.UUID)
+	return id, true
+}
+
+// abortAuthorizationError aborts the request when an authorization decision could not be made
+func (am *AuthMiddleware) abortAuthorizationError(c *gin.Context, err error) {
+	am.logger.Error("Failed to evaluate authorization",
+		zap.String("path", c.Request.URL.Path),
+		zap.String("method", c.Request.Method),
+		zap.Error(err),
+	)
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"error": gin.H{
+			"code":    errors.ErrSystemInternal,
+			"message": "Internal server error",
+		},
+	})
+	c.Abort()
+}
+
+// abortForbidden aborts the request with 403 and AUTH_003
+func abortForbidden(c *gin.Context) {
+	c.JSON(http.StatusForbidden, gin.H{
+		"error": gin.H{
+			"code":    errors.ErrAuthInsufficient,
+			"message": "Insufficient permissions",
+		},
+	})
+	c.Abort()
+}
+
 // GetCurrentUserID extracts current user ID from context
-func GetCurrentUserID(c *gin.Context) (string, bool) {
+func GetCurrentUserID(c *gin.Context) (uuid.UUID, bool) {
 	userID, exists := c.Get("user_id")
 	if !exists {
-		return "", false
+		return uuid.Nil, false
 	}
-	return userID.(string), true
+	id, ok := userID.(uuid.UUID)
+	return id, ok
 }
 
 // GetCurrentUsername extracts current username from context
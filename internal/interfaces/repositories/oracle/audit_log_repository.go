@@ -0,0 +1,398 @@
+package oracle
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+
+	"go.uber.org/zap"
+)
+
+// auditLogColumns is the column list scanned by scanAuditLog
+const auditLogColumns = `
+			ID, USER_ID, ACTION, RESOURCE, RESOURCE_ID,
+			OLD_VALUES, NEW_VALUES, IP_ADDRESS, USER_AGENT, SESSION_ID, TIMESTAMP,
+			CREATED_AT, UPDATED_AT, CREATED_BY, UPDATED_BY,
+			DELETED_AT, VERSION, TENANT_ID,
+			CHAIN_SEQUENCE, PREV_HASH, ROW_HASH`
+
+// auditLogRepository implements the AuditLogRepository interface for Oracle
+type auditLogRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// NewAuditLogRepository creates a new Oracle audit log repository
+func NewAuditLogRepository(db *sql.DB, logger *zap.Logger) repositories.AuditLogRepository {
+	return &auditLogRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create appends an audit log record
+func (r *auditLogRepository) Create(ctx context.Context, auditLog *entities.AuditLog) error {
+	query := `
+		INSERT INTO BMSF_AUDIT_LOG (` + auditLogColumns + `
+		) VALUES (
+			:1, :2, :3, :4, :5, :6, :7, :8, :9, :10, :11, :12, :13, :14, :15, :16, :17, :18,
+			:19, :20, :21
+		)`
+
+	_, err := executor(ctx, r.db).ExecContext(ctx, query,
+		auditLog.ID.String(),
+		auditLog.UserID,
+		auditLog.Action,
+		auditLog.Resource,
+		auditLog.ResourceID,
+		auditLog.OldValues,
+		auditLog.NewValues,
+		auditLog.IPAddress,
+		auditLog.UserAgent,
+		auditLog.SessionID,
+		auditLog.Timestamp,
+		auditLog.CreatedAt,
+		auditLog.UpdatedAt,
+		auditLog.CreatedBy,
+		auditLog.UpdatedBy,
+		auditLog.DeletedAt,
+		auditLog.Version,
+		auditLog.TenantID,
+		auditLog.ChainSequence,
+		auditLog.PrevHash,
+		auditLog.RowHash,
+	)
+
+	if err != nil {
+		r.logger.Error("Failed to create audit log",
+			zap.String("action", auditLog.Action),
+			zap.String("resource", auditLog.Resource),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to create audit log: %w", err)
+	}
+
+	r.logger.Debug("Audit log created successfully",
+		zap.String("audit_log_id", auditLog.ID.String()),
+		zap.String("action", auditLog.Action),
+		zap.String("resource", auditLog.Resource),
+	)
+
+	return nil
+}
+
+// List retrieves audit log records matching the filter, ordered by (TIMESTAMP, ID)
+func (r *auditLogRepository) List(ctx context.Context, filter *repositories.AuditLogFilter) ([]*entities.AuditLog, error) {
+	var auditLogs []*entities.AuditLog
+	err := r.Stream(ctx, filter, func(auditLog *entities.AuditLog) error {
+		auditLogs = append(auditLogs, auditLog)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return auditLogs, nil
+}
+
+// Count returns the total number of audit log records matching the filter
+func (r *auditLogRepository) Count(ctx context.Context, filter *repositories.AuditLogFilter) (int64, error) {
+	where, args := buildAuditLogWhere(filter)
+	query := `SELECT COUNT(*) FROM BMSF_AUDIT_LOG WHERE ` + where
+
+	var count int64
+	err := executor(ctx, r.db).QueryRowContext(ctx, query, args...).Scan(&count)
+	if err != nil {
+		r.logger.Error("Failed to count audit logs",
+			zap.Error(err),
+		)
+		return 0, fmt.Errorf("failed to count audit logs: %w", err)
+	}
+
+	return count, nil
+}
+
+// Stream calls fn for every audit log record matching the filter, in order
+func (r *auditLogRepository) Stream(ctx context.Context, filter *repositories.AuditLogFilter, fn func(auditLog *entities.AuditLog) error) error {
+	where, args := buildAuditLogWhere(filter)
+
+	direction := "DESC"
+	if filter.Ascending {
+		direction = "ASC"
+	}
+
+	query := `
+		SELECT ` + auditLogColumns + `
+		FROM BMSF_AUDIT_LOG
+		WHERE ` + where + `
+		ORDER BY TIMESTAMP ` + direction + `, ID ` + direction
+	if filter.Limit > 0 {
+		args = append(args, filter.Limit)
+		query += fmt.Sprintf(`
+		FETCH NEXT :%d ROWS ONLY`, len(args))
+	}
+
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		r.logger.Error("Failed to list audit logs",
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to list audit logs: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		auditLog, err := scanAuditLog(rows)
+		if err != nil {
+			r.logger.Error("Failed to scan audit log row",
+				zap.Error(err),
+			)
+			return fmt.Errorf("failed to scan audit log row: %w", err)
+		}
+
+		if err := fn(auditLog); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating audit log rows: %w", err)
+	}
+
+	return nil
+}
+
+// StreamChain calls fn for every chained record of a hash chain up to lastSequence in sequence order,
+// including soft-deleted records
+func (r *auditLogRepository) StreamChain(ctx context.Context, chainKey string, lastSequence int64, fn func(auditLog *entities.AuditLog) error) error {
+	tenantCondition, args := "TENANT_ID IS NULL", []any{lastSequence}
+	if chainKey != entities.AuditChainDefaultKey {
+		tenantCondition, args = "TENANT_ID = :2", []any{lastSequence, chainKey}
+	}
+
+	query := `
+		SELECT ` + auditLogColumns + `
+		FROM BMSF_AUDIT_LOG
+		WHERE CHAIN_SEQUENCE IS NOT NULL AND CHAIN_SEQUENCE <= :1 AND ` + tenantCondition + `
+		ORDER BY CHAIN_SEQUENCE ASC, ID ASC`
+
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		r.logger.Error("Failed to read audit chain",
+			zap.String("chain_key", chainKey),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to read audit chain: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		auditLog, err := scanAuditLog(rows)
+		if err != nil {
+			r.logger.Error("Failed to scan audit log row",
+				zap.Error(err),
+			)
+			return fmt.Errorf("failed to scan audit log row: %w", err)
+		}
+
+		if err := fn(auditLog); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating audit log rows: %w", err)
+	}
+
+	return nil
+}
+
+// LockChainHead creates the head of a hash chain if needed and locks it until the transaction ends
+func (r *auditLogRepository) LockChainHead(ctx context.Context, chainKey string) (*entities.AuditChainHead, error) {
+	mergeQuery := `
+		MERGE INTO BMSF_AUDIT_CHAIN_HEAD h
+		USING (SELECT :1 AS CHAIN_KEY FROM DUAL) s
+		ON (h.CHAIN_KEY = s.CHAIN_KEY)
+		WHEN NOT MATCHED THEN
+			INSERT (CHAIN_KEY, LAST_SEQUENCE, UPDATED_AT) VALUES (s.CHAIN_KEY, 0, :2)`
+
+	if _, err := executor(ctx, r.db).ExecContext(ctx, mergeQuery, chainKey, time.Now()); err != nil {
+		r.logger.Error("Failed to create audit chain head",
+			zap.String("chain_key", chainKey),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to create audit chain head: %w", err)
+	}
+
+	query := `
+		SELECT CHAIN_KEY, LAST_SEQUENCE, LAST_AUDIT_LOG_ID, LAST_HASH, UPDATED_AT
+		FROM BMSF_AUDIT_CHAIN_HEAD
+		WHERE CHAIN_KEY = :1
+		FOR UPDATE`
+
+	head, err := scanAuditChainHead(executor(ctx, r.db).QueryRowContext(ctx, query, chainKey))
+	if err != nil {
+		r.logger.Error("Failed to lock audit chain head",
+			zap.String("chain_key", chainKey),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to lock audit chain head: %w", err)
+	}
+
+	return head, nil
+}
+
+// GetChainHead retrieves the head of a hash chain
+func (r *auditLogRepository) GetChainHead(ctx context.Context, chainKey string) (*entities.AuditChainHead, error) {
+	query := `
+		SELECT CHAIN_KEY, LAST_SEQUENCE, LAST_AUDIT_LOG_ID, LAST_HASH, UPDATED_AT
+		FROM BMSF_AUDIT_CHAIN_HEAD
+		WHERE CHAIN_KEY = :1`
+
+	head, err := scanAuditChainHead(executor(ctx, r.db).QueryRowContext(ctx, query, chainKey))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		r.logger.Error("Failed to get audit chain head",
+			zap.String("chain_key", chainKey),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to get audit chain head: %w", err)
+	}
+
+	return head, nil
+}
+
+// UpdateChainHead stores the new head of a hash chain
+func (r *auditLogRepository) UpdateChainHead(ctx context.Context, head *entities.AuditChainHead) error {
+	query := `
+		UPDATE BMSF_AUDIT_CHAIN_HEAD
+		SET LAST_SEQUENCE = :1, LAST_AUDIT_LOG_ID = :2, LAST_HASH = :3, UPDATED_AT = :4
+		WHERE CHAIN_KEY = :5`
+
+	_, err := executor(ctx, r.db).ExecContext(ctx, query,
+		head.LastSequence,
+		head.LastAuditLogID,
+		head.LastHash,
+		head.UpdatedAt,
+		head.ChainKey,
+	)
+	if err != nil {
+		r.logger.Error("Failed to update audit chain head",
+			zap.String("chain_key", head.ChainKey),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to update audit chain head: %w", err)
+	}
+
+	return nil
+}
+
+// buildAuditLogWhere builds the WHERE clause and positional bind arguments for an audit log filter
+func buildAuditLogWhere(filter *repositories.AuditLogFilter) (string, []any) {
+	conditions := []string{"DELETED_AT IS NULL"}
+	var args []any
+
+	bind := func(value any) string {
+		args = append(args, value)
+		return fmt.Sprintf(":%d", len(args))
+	}
+
+	if filter.UserID != nil {
+		conditions = append(conditions, "USER_ID = "+bind(filter.UserID.String()))
+	}
+	if filter.Resource != "" {
+		conditions = append(conditions, "RESOURCE = "+bind(filter.Resource))
+	}
+	if filter.ResourceID != nil {
+		conditions = append(conditions, "RESOURCE_ID = "+bind(filter.ResourceID.String()))
+	}
+	if filter.Action != "" {
+		conditions = append(conditions, "ACTION = "+bind(filter.Action))
+	}
+	if filter.From != nil {
+		conditions = append(conditions, "TIMESTAMP >= "+bind(*filter.From))
+	}
+	if filter.To != nil {
+		conditions = append(conditions, "TIMESTAMP <= "+bind(*filter.To))
+	}
+	if filter.After != nil {
+		// Moving forward through a descending listing means seeking smaller keys
+		operator := "<"
+		if filter.Ascending {
+			operator = ">"
+		}
+		conditions = append(conditions, fmt.Sprintf("(TIMESTAMP %[1]s %[2]s OR (TIMESTAMP = %[3]s AND ID %[1]s %[4]s))",
+			operator, bind(filter.After.Timestamp), bind(filter.After.Timestamp), bind(filter.After.ID.String())))
+	}
+
+	return strings.Join(conditions, " AND "), args
+}
+
+// scanAuditLog scans a row selected with auditLogColumns
+func scanAuditLog(row rowScanner) (*entities.AuditLog, error) {
+	var auditLog entities.AuditLog
+	var oldValues, newValues, ipAddress, userAgent, sessionID, prevHash, rowHash sql.NullString
+
+	err := row.Scan(
+		&auditLog.ID,
+		&auditLog.UserID,
+		&auditLog.Action,
+		&auditLog.Resource,
+		&auditLog.ResourceID,
+		&oldValues,
+		&newValues,
+		&ipAddress,
+		&userAgent,
+		&sessionID,
+		&auditLog.Timestamp,
+		&auditLog.CreatedAt,
+		&auditLog.UpdatedAt,
+		&auditLog.CreatedBy,
+		&auditLog.UpdatedBy,
+		&auditLog.DeletedAt,
+		&auditLog.Version,
+		&auditLog.TenantID,
+		&auditLog.ChainSequence,
+		&prevHash,
+		&rowHash,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	auditLog.OldValues = oldValues.String
+	auditLog.NewValues = newValues.String
+	auditLog.IPAddress = ipAddress.String
+	auditLog.UserAgent = userAgent.String
+	auditLog.SessionID = sessionID.String
+	auditLog.PrevHash = prevHash.String
+	auditLog.RowHash = rowHash.String
+	return &auditLog, nil
+}
+
+// scanAuditChainHead scans a BMSF_AUDIT_CHAIN_HEAD row
+func scanAuditChainHead(row rowScanner) (*entities.AuditChainHead, error) {
+	var head entities.AuditChainHead
+	var lastHash sql.NullString
+
+	err := row.Scan(
+		&head.ChainKey,
+		&head.LastSequence,
+		&head.LastAuditLogID,
+		&lastHash,
+		&head.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	head.LastHash = lastHash.String
+	return &head, nil
+}
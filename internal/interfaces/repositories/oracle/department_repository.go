@@ -0,0 +1,364 @@
+package oracle
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// departmentColumns lists the BMSF_DEPARTMENT columns read by every department query, in scanDepartment order
+const departmentColumns = `ID, NAME, CODE, DESCRIPTION, PARENT_ID, MANAGER_ID, IS_ACTIVE,
+			   CREATED_AT, UPDATED_AT, CREATED_BY, UPDATED_BY,
+			   DELETED_AT, VERSION, TENANT_ID`
+
+// departmentRepository implements the DepartmentRepository interface for Oracle
+type departmentRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// NewDepartmentRepository creates a new Oracle department repository
+func NewDepartmentRepository(db *sql.DB, logger *zap.Logger) repositories.DepartmentRepository {
+	return &departmentRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create creates a new department
+func (r *departmentRepository) Create(ctx context.Context, department *entities.Department) error {
+	query := `
+		INSERT INTO BMSF_DEPARTMENT (
+			ID, NAME, CODE, DESCRIPTION, PARENT_ID, MANAGER_ID, IS_ACTIVE,
+			CREATED_AT, UPDATED_AT, CREATED_BY, UPDATED_BY,
+			DELETED_AT, VERSION, TENANT_ID
+		) VALUES (
+			:1, :2, :3, :4, :5, :6, :7, :8, :9, :10, :11, :12, :13, :14
+		)`
+
+	_, err := r.db.ExecContext(ctx, query,
+		department.ID.String(),
+		department.Name,
+		department.Code,
+		department.Description,
+		department.ParentID,
+		department.ManagerID,
+		department.IsActive,
+		department.CreatedAt,
+		department.UpdatedAt,
+		department.CreatedBy,
+		department.UpdatedBy,
+		department.DeletedAt,
+		department.Version,
+		department.TenantID,
+	)
+
+	if err != nil {
+		r.logger.Error("Failed to create department",
+			zap.String("department_id", department.ID.String()),
+			zap.String("code", department.Code),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to create department: %w", err)
+	}
+
+	r.logger.Info("Department created successfully",
+		zap.String("department_id", department.ID.String()),
+		zap.String("code", department.Code),
+	)
+
+	return nil
+}
+
+// GetByID retrieves a department by ID
+func (r *departmentRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Department, error) {
+	query := `
+		SELECT ` + departmentColumns + `
+		FROM BMSF_DEPARTMENT
+		WHERE ID = :1 AND DELETED_AT IS NULL`
+
+	department, err := scanDepartment(r.db.QueryRowContext(ctx, query, id.String()))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		r.logger.Error("Failed to get department by ID",
+			zap.String("department_id", id.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to get department by ID: %w", err)
+	}
+
+	return department, nil
+}
+
+// GetByCode retrieves a department by code
+func (r *departmentRepository) GetByCode(ctx context.Context, code string) (*entities.Department, error) {
+	query := `
+		SELECT ` + departmentColumns + `
+		FROM BMSF_DEPARTMENT
+		WHERE CODE = :1 AND DELETED_AT IS NULL`
+
+	department, err := scanDepartment(r.db.QueryRowContext(ctx, query, code))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		r.logger.Error("Failed to get department by code",
+			zap.String("code", code),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to get department by code: %w", err)
+	}
+
+	return department, nil
+}
+
+// Update updates an existing department
+func (r *departmentRepository) Update(ctx context.Context, department *entities.Department) error {
+	query := `
+		UPDATE BMSF_DEPARTMENT
+		SET NAME = :1, DESCRIPTION = :2, PARENT_ID = :3, MANAGER_ID = :4, IS_ACTIVE = :5,
+			UPDATED_AT = :6, UPDATED_BY = :7, VERSION = :8
+		WHERE ID = :9 AND DELETED_AT IS NULL`
+
+	result, err := r.db.ExecContext(ctx, query,
+		department.Name,
+		department.Description,
+		department.ParentID,
+		department.ManagerID,
+		department.IsActive,
+		department.UpdatedAt,
+		department.UpdatedBy,
+		department.Version,
+		department.ID.String(),
+	)
+
+	if err != nil {
+		r.logger.Error("Failed to update department",
+			zap.String("department_id", department.ID.String()),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to update department: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("department not found")
+	}
+
+	r.logger.Info("Department updated successfully",
+		zap.String("department_id", department.ID.String()),
+	)
+
+	return nil
+}
+
+// Delete performs soft delete of a department by ID
+func (r *departmentRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `
+		UPDATE BMSF_DEPARTMENT
+		SET DELETED_AT = CURRENT_TIMESTAMP, VERSION = VERSION + 1
+		WHERE ID = :1 AND DELETED_AT IS NULL`
+
+	result, err := r.db.ExecContext(ctx, query, id.String())
+	if err != nil {
+		r.logger.Error("Failed to delete department",
+			zap.String("department_id", id.String()),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to delete department: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("department not found")
+	}
+
+	r.logger.Info("Department deleted successfully",
+		zap.String("department_id", id.String()),
+	)
+
+	return nil
+}
+
+// List retrieves departments with pagination
+func (r *departmentRepository) List(ctx context.Context, limit, offset int) ([]*entities.Department, error) {
+	query := `
+		SELECT ` + departmentColumns + `
+		FROM BMSF_DEPARTMENT
+		WHERE DELETED_AT IS NULL
+		ORDER BY NAME ASC, ID ASC
+		OFFSET :1 ROWS FETCH NEXT :2 ROWS ONLY`
+
+	rows, err := r.db.QueryContext(ctx, query, offset, limit)
+	if err != nil {
+		r.logger.Error("Failed to list departments",
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to list departments: %w", err)
+	}
+
+	return scanDepartments(rows)
+}
+
+// Count returns the total number of departments
+func (r *departmentRepository) Count(ctx context.Context) (int64, error) {
+	query := `SELECT COUNT(*) FROM BMSF_DEPARTMENT WHERE DELETED_AT IS NULL`
+
+	var count int64
+	err := r.db.QueryRowContext(ctx, query).Scan(&count)
+	if err != nil {
+		r.logger.Error("Failed to count departments",
+			zap.Error(err),
+		)
+		return 0, fmt.Errorf("failed to count departments: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetChildren retrieves the direct child departments of a department
+func (r *departmentRepository) GetChildren(ctx context.Context, id uuid.UUID) ([]*entities.Department, error) {
+	query := `
+		SELECT ` + departmentColumns + `
+		FROM BMSF_DEPARTMENT
+		WHERE PARENT_ID = :1 AND DELETED_AT IS NULL
+		ORDER BY NAME ASC, ID ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, id.String())
+	if err != nil {
+		r.logger.Error("Failed to get child departments",
+			zap.String("department_id", id.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to get child departments: %w", err)
+	}
+
+	return scanDepartments(rows)
+}
+
+// CountChildren returns the number of direct child departments of a department
+func (r *departmentRepository) CountChildren(ctx context.Context, id uuid.UUID) (int64, error) {
+	query := `SELECT COUNT(*) FROM BMSF_DEPARTMENT WHERE PARENT_ID = :1 AND DELETED_AT IS NULL`
+
+	var count int64
+	err := r.db.QueryRowContext(ctx, query, id.String()).Scan(&count)
+	if err != nil {
+		r.logger.Error("Failed to count child departments",
+			zap.String("department_id", id.String()),
+			zap.Error(err),
+		)
+		return 0, fmt.Errorf("failed to count child departments: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetSubtree retrieves a department followed by all of its descendants, depth first
+// Siblings are ordered by name, so the rows are a pre-order walk of the tree.
+func (r *departmentRepository) GetSubtree(ctx context.Context, id uuid.UUID) ([]*entities.Department, error) {
+	query := `
+		SELECT ` + departmentColumns + `
+		FROM BMSF_DEPARTMENT
+		START WITH ID = :1 AND DELETED_AT IS NULL
+		CONNECT BY NOCYCLE PARENT_ID = PRIOR ID AND DELETED_AT IS NULL
+		ORDER SIBLINGS BY NAME ASC, ID ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, id.String())
+	if err != nil {
+		r.logger.Error("Failed to get department subtree",
+			zap.String("department_id", id.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to get department subtree: %w", err)
+	}
+
+	return scanDepartments(rows)
+}
+
+// GetHierarchy retrieves a department followed by its ancestors, nearest first
+func (r *departmentRepository) GetHierarchy(ctx context.Context, id uuid.UUID) ([]*entities.Department, error) {
+	query := `
+		SELECT ` + departmentColumns + `
+		FROM BMSF_DEPARTMENT
+		START WITH ID = :1 AND DELETED_AT IS NULL
+		CONNECT BY NOCYCLE ID = PRIOR PARENT_ID AND DELETED_AT IS NULL
+		ORDER BY LEVEL`
+
+	rows, err := r.db.QueryContext(ctx, query, id.String())
+	if err != nil {
+		r.logger.Error("Failed to get department hierarchy",
+			zap.String("department_id", id.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to get department hierarchy: %w", err)
+	}
+
+	return scanDepartments(rows)
+}
+
+// scanDepartments scans department rows, closing the rows
+func scanDepartments(rows *sql.Rows) ([]*entities.Department, error) {
+	defer rows.Close()
+
+	var departments []*entities.Department
+	for rows.Next() {
+		department, err := scanDepartment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan department row: %w", err)
+		}
+
+		departments = append(departments, department)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating department rows: %w", err)
+	}
+
+	return departments, nil
+}
+
+// scanDepartment scans a row selected with departmentColumns into a department entity
+func scanDepartment(row rowScanner) (*entities.Department, error) {
+	var department entities.Department
+	// Oracle stores empty strings as NULL
+	var description sql.NullString
+
+	err := row.Scan(
+		&department.ID,
+		&department.Name,
+		&department.Code,
+		&description,
+		&department.ParentID,
+		&department.ManagerID,
+		&department.IsActive,
+		&department.CreatedAt,
+		&department.UpdatedAt,
+		&department.CreatedBy,
+		&department.UpdatedBy,
+		&department.DeletedAt,
+		&department.Version,
+		&department.TenantID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	department.Description = description.String
+	return &department, nil
+}
@@ -0,0 +1,127 @@
+package oracle
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+
+	"go.uber.org/zap"
+)
+
+// MFARecoveryCodeRepository implements the MFA recovery code repository interface for Oracle
+type MFARecoveryCodeRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// NewMFARecoveryCodeRepository creates a new Oracle MFA recovery code repository
+func NewMFARecoveryCodeRepository(db *sql.DB, logger *zap.Logger) repositories.MFARecoveryCodeRepository {
+	return &MFARecoveryCodeRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create creates a new recovery code
+func (r *MFARecoveryCodeRepository) Create(ctx context.Context, code *entities.MFARecoveryCode) error {
+	query := `
+		INSERT INTO BMSF_MFA_RECOVERY_CODE (
+			ID, CREATED_AT, UPDATED_AT, VERSION,
+			USER_ID, CODE_HASH, USED_AT
+		) VALUES (
+			:1, :2, :3, :4, :5, :6, :7
+		)`
+
+	_, err := executor(ctx, r.db).ExecContext(ctx, query,
+		code.ID,
+		code.CreatedAt,
+		code.UpdatedAt,
+		code.Version,
+		code.UserID,
+		code.CodeHash,
+		code.UsedAt,
+	)
+
+	if err != nil {
+		r.logger.Error("Failed to create MFA recovery code",
+			zap.String("user_id", code.UserID.String()),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to create MFA recovery code: %w", err)
+	}
+
+	return nil
+}
+
+// Use marks an unused recovery code of a user as used, returning false when there is none
+// The update is conditional in SQL, so a code cannot be used twice by concurrent requests.
+func (r *MFARecoveryCodeRepository) Use(ctx context.Context, userID, codeHash string) (bool, error) {
+	query := `
+		UPDATE BMSF_MFA_RECOVERY_CODE SET
+			USED_AT = :1,
+			UPDATED_AT = :2,
+			VERSION = VERSION + 1
+		WHERE USER_ID = :3 AND CODE_HASH = :4 AND USED_AT IS NULL AND DELETED_AT IS NULL`
+
+	now := time.Now()
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, now, now, userID, codeHash)
+	if err != nil {
+		r.logger.Error("Failed to use MFA recovery code",
+			zap.String("user_id", userID),
+			zap.Error(err),
+		)
+		return false, fmt.Errorf("failed to use MFA recovery code: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected > 0 {
+		r.logger.Info("MFA recovery code used",
+			zap.String("user_id", userID),
+		)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// CountUnused counts the recovery codes a user has left
+func (r *MFARecoveryCodeRepository) CountUnused(ctx context.Context, userID string) (int, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM BMSF_MFA_RECOVERY_CODE
+		WHERE USER_ID = :1 AND USED_AT IS NULL AND DELETED_AT IS NULL`
+
+	var count int
+	if err := executor(ctx, r.db).QueryRowContext(ctx, query, userID).Scan(&count); err != nil {
+		r.logger.Error("Failed to count MFA recovery codes",
+			zap.String("user_id", userID),
+			zap.Error(err),
+		)
+		return 0, fmt.Errorf("failed to count MFA recovery codes: %w", err)
+	}
+
+	return count, nil
+}
+
+// DeleteAllForUser removes every recovery code of a user
+func (r *MFARecoveryCodeRepository) DeleteAllForUser(ctx context.Context, userID string) error {
+	query := `DELETE FROM BMSF_MFA_RECOVERY_CODE WHERE USER_ID = :1`
+
+	_, err := executor(ctx, r.db).ExecContext(ctx, query, userID)
+	if err != nil {
+		r.logger.Error("Failed to delete MFA recovery codes",
+			zap.String("user_id", userID),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to delete MFA recovery codes: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package oracle
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+
+	"go.uber.org/zap"
+)
+
+// passwordHistoryColumns is the column list scanned by scanPasswordHistory
+const passwordHistoryColumns = `ID, CREATED_AT, UPDATED_AT, CREATED_BY, UPDATED_BY,
+		       DELETED_AT, VERSION, TENANT_ID,
+		       USER_ID, PASSWORD_HASH, SALT`
+
+// PasswordHistoryRepository implements the password history repository interface for Oracle
+type PasswordHistoryRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// NewPasswordHistoryRepository creates a new Oracle password history repository
+func NewPasswordHistoryRepository(db *sql.DB, logger *zap.Logger) repositories.PasswordHistoryRepository {
+	return &PasswordHistoryRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create creates a new password history entry
+func (r *PasswordHistoryRepository) Create(ctx context.Context, entry *entities.PasswordHistory) error {
+	query := `
+		INSERT INTO BMSF_PASSWORD_HISTORY (
+			ID, CREATED_AT, UPDATED_AT, CREATED_BY, VERSION,
+			USER_ID, PASSWORD_HASH, SALT
+		) VALUES (
+			:1, :2, :3, :4, :5, :6, :7, :8
+		)`
+
+	_, err := executor(ctx, r.db).ExecContext(ctx, query,
+		entry.ID,
+		entry.CreatedAt,
+		entry.UpdatedAt,
+		entry.CreatedBy,
+		entry.Version,
+		entry.UserID,
+		entry.PasswordHash,
+		entry.Salt,
+	)
+
+	if err != nil {
+		r.logger.Error("Failed to create password history entry",
+			zap.String("user_id", entry.UserID.String()),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to create password history entry: %w", err)
+	}
+
+	return nil
+}
+
+// ListRecent lists the latest password history entries of a user, newest first
+func (r *PasswordHistoryRepository) ListRecent(ctx context.Context, userID string, limit int) ([]*entities.PasswordHistory, error) {
+	query := `
+		SELECT ` + passwordHistoryColumns + `
+		FROM BMSF_PASSWORD_HISTORY
+		WHERE USER_ID = :1 AND DELETED_AT IS NULL
+		ORDER BY CREATED_AT DESC, ID DESC
+		FETCH FIRST :2 ROWS ONLY`
+
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, userID, limit)
+	if err != nil {
+		r.logger.Error("Failed to list password history",
+			zap.String("user_id", userID),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to list password history: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*entities.PasswordHistory
+	for rows.Next() {
+		entry, err := scanPasswordHistory(rows)
+		if err != nil {
+			r.logger.Error("Failed to scan password history row",
+				zap.Error(err),
+			)
+			return nil, fmt.Errorf("failed to scan password history row: %w", err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating password history rows: %w", err)
+	}
+
+	return entries, nil
+}
+
+// DeleteAllButRecent removes the password history entries of a user except the latest keep ones
+func (r *PasswordHistoryRepository) DeleteAllButRecent(ctx context.Context, userID string, keep int) error {
+	query := `
+		DELETE FROM BMSF_PASSWORD_HISTORY
+		WHERE USER_ID = :1 AND ID NOT IN (
+			SELECT ID FROM BMSF_PASSWORD_HISTORY
+			WHERE USER_ID = :2 AND DELETED_AT IS NULL
+			ORDER BY CREATED_AT DESC, ID DESC
+			FETCH FIRST :3 ROWS ONLY
+		)`
+
+	_, err := executor(ctx, r.db).ExecContext(ctx, query, userID, userID, keep)
+	if err != nil {
+		r.logger.Error("Failed to prune password history",
+			zap.String("user_id", userID),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to prune password history: %w", err)
+	}
+
+	return nil
+}
+
+// scanPasswordHistory scans a row selected with passwordHistoryColumns into a password history entry
+func scanPasswordHistory(row rowScanner) (*entities.PasswordHistory, error) {
+	var entry entities.PasswordHistory
+	// Oracle stores empty strings as NULL
+	var salt sql.NullString
+
+	err := row.Scan(
+		&entry.ID,
+		&entry.CreatedAt,
+		&entry.UpdatedAt,
+		&entry.CreatedBy,
+		&entry.UpdatedBy,
+		&entry.DeletedAt,
+		&entry.Version,
+		&entry.TenantID,
+		&entry.UserID,
+		&entry.PasswordHash,
+		&salt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	entry.Salt = salt.String
+	return &entry, nil
+}
@@ -0,0 +1,211 @@
+package oracle
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+
+	"go.uber.org/zap"
+)
+
+// passwordResetTokenColumns is the column list scanned by scanPasswordResetToken
+const passwordResetTokenColumns = `ID, CREATED_AT, UPDATED_AT, CREATED_BY, UPDATED_BY,
+		       DELETED_AT, VERSION, TENANT_ID,
+		       USER_ID, TOKEN, EXPIRES_AT, USED_AT, IP_ADDRESS, USER_AGENT`
+
+// PasswordResetTokenRepository implements the password reset token repository interface for Oracle
+type PasswordResetTokenRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// NewPasswordResetTokenRepository creates a new Oracle password reset token repository
+func NewPasswordResetTokenRepository(db *sql.DB, logger *zap.Logger) repositories.PasswordResetTokenRepository {
+	return &PasswordResetTokenRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create creates a new password reset token
+func (r *PasswordResetTokenRepository) Create(ctx context.Context, resetToken *entities.PasswordResetToken) error {
+	query := `
+		INSERT INTO BMSF_PASSWORD_RESET_TOKEN (
+			ID, CREATED_AT, UPDATED_AT, VERSION,
+			USER_ID, TOKEN, EXPIRES_AT, USED_AT, IP_ADDRESS, USER_AGENT
+		) VALUES (
+			:1, :2, :3, :4, :5, :6, :7, :8, :9, :10
+		)`
+
+	_, err := executor(ctx, r.db).ExecContext(ctx, query,
+		resetToken.ID,
+		resetToken.CreatedAt,
+		resetToken.UpdatedAt,
+		resetToken.Version,
+		resetToken.UserID,
+		resetToken.TokenHash,
+		resetToken.ExpiresAt,
+		resetToken.UsedAt,
+		resetToken.IPAddress,
+		resetToken.UserAgent,
+	)
+
+	if err != nil {
+		r.logger.Error("Failed to create password reset token",
+			zap.String("user_id", resetToken.UserID.String()),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to create password reset token: %w", err)
+	}
+
+	r.logger.Info("Password reset token created successfully",
+		zap.String("user_id", resetToken.UserID.String()),
+		zap.String("token_id", resetToken.ID.String()),
+	)
+
+	return nil
+}
+
+// GetByToken gets a password reset token by token string, looked up by its stored digest
+func (r *PasswordResetTokenRepository) GetByToken(ctx context.Context, token string) (*entities.PasswordResetToken, error) {
+	query := `
+		SELECT ` + passwordResetTokenColumns + `
+		FROM BMSF_PASSWORD_RESET_TOKEN
+		WHERE TOKEN = :1 AND DELETED_AT IS NULL`
+
+	resetToken, err := scanPasswordResetToken(executor(ctx, r.db).QueryRowContext(ctx, query, entities.HashPasswordResetToken(token)))
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("password reset token not found")
+		}
+		r.logger.Error("Failed to get password reset token by token",
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to get password reset token: %w", err)
+	}
+
+	return resetToken, nil
+}
+
+// MarkUsed consumes a password reset token, failing when it has been consumed already
+// The USED_AT IS NULL condition makes concurrent resets with the same token race to a single winner.
+func (r *PasswordResetTokenRepository) MarkUsed(ctx context.Context, resetToken *entities.PasswordResetToken) error {
+	query := `
+		UPDATE BMSF_PASSWORD_RESET_TOKEN SET
+			UPDATED_AT = :1,
+			UPDATED_BY = :2,
+			VERSION = :3,
+			USED_AT = :4
+		WHERE ID = :5 AND USED_AT IS NULL`
+
+	result, err := executor(ctx, r.db).ExecContext(ctx, query,
+		resetToken.UpdatedAt,
+		resetToken.UpdatedBy,
+		resetToken.Version,
+		resetToken.UsedAt,
+		resetToken.ID,
+	)
+
+	if err != nil {
+		r.logger.Error("Failed to mark password reset token used",
+			zap.String("id", resetToken.ID.String()),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to mark password reset token used: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("password reset token not found or already used")
+	}
+
+	r.logger.Info("Password reset token used",
+		zap.String("id", resetToken.ID.String()),
+	)
+
+	return nil
+}
+
+// InvalidateAllForUser consumes every outstanding password reset token of a user
+func (r *PasswordResetTokenRepository) InvalidateAllForUser(ctx context.Context, userID string) error {
+	query := `
+		UPDATE BMSF_PASSWORD_RESET_TOKEN SET
+			USED_AT = :1,
+			UPDATED_AT = :2
+		WHERE USER_ID = :3 AND USED_AT IS NULL AND DELETED_AT IS NULL`
+
+	now := time.Now()
+	_, err := executor(ctx, r.db).ExecContext(ctx, query, now, now, userID)
+	if err != nil {
+		r.logger.Error("Failed to invalidate password reset tokens for user",
+			zap.String("user_id", userID),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to invalidate password reset tokens: %w", err)
+	}
+
+	r.logger.Info("Password reset tokens invalidated for user",
+		zap.String("user_id", userID),
+	)
+
+	return nil
+}
+
+// CleanupExpired removes expired password reset tokens
+func (r *PasswordResetTokenRepository) CleanupExpired(ctx context.Context) error {
+	query := `DELETE FROM BMSF_PASSWORD_RESET_TOKEN WHERE EXPIRES_AT < :1`
+
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, time.Now())
+	if err != nil {
+		r.logger.Error("Failed to cleanup expired password reset tokens",
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to cleanup expired password reset tokens: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	r.logger.Info("Expired password reset tokens cleaned up",
+		zap.Int64("count", rowsAffected),
+	)
+
+	return nil
+}
+
+// scanPasswordResetToken scans a row selected with passwordResetTokenColumns
+func scanPasswordResetToken(row rowScanner) (*entities.PasswordResetToken, error) {
+	var resetToken entities.PasswordResetToken
+	err := row.Scan(
+		&resetToken.ID,
+		&resetToken.CreatedAt,
+		&resetToken.UpdatedAt,
+		&resetToken.CreatedBy,
+		&resetToken.UpdatedBy,
+		&resetToken.DeletedAt,
+		&resetToken.Version,
+		&resetToken.TenantID,
+		&resetToken.UserID,
+		&resetToken.TokenHash,
+		&resetToken.ExpiresAt,
+		&resetToken.UsedAt,
+		&resetToken.IPAddress,
+		&resetToken.UserAgent,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resetToken, nil
+}
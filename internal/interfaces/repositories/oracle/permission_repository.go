@@ -0,0 +1,294 @@
+package oracle
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// permissionColumns lists the BMSF_PERMISSION columns read by every permission query, in scanPermission order
+const permissionColumns = `ID, NAME, CODE, RESOURCE, ACTION, DESCRIPTION, IS_ACTIVE,
+			   CREATED_AT, UPDATED_AT, CREATED_BY, UPDATED_BY,
+			   DELETED_AT, VERSION, TENANT_ID`
+
+// permissionRepository implements the PermissionRepository interface for Oracle
+type permissionRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// NewPermissionRepository creates a new Oracle permission repository
+func NewPermissionRepository(db *sql.DB, logger *zap.Logger) repositories.PermissionRepository {
+	return &permissionRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create creates a new permission
+func (r *permissionRepository) Create(ctx context.Context, permission *entities.Permission) error {
+	query := `
+		INSERT INTO BMSF_PERMISSION (
+			ID, NAME, CODE, RESOURCE, ACTION, DESCRIPTION, IS_ACTIVE,
+			CREATED_AT, UPDATED_AT, CREATED_BY, UPDATED_BY,
+			DELETED_AT, VERSION, TENANT_ID
+		) VALUES (
+			:1, :2, :3, :4, :5, :6, :7, :8, :9, :10, :11, :12, :13, :14
+		)`
+
+	_, err := executor(ctx, r.db).ExecContext(ctx, query,
+		permission.ID.String(),
+		permission.Name,
+		permission.Code,
+		permission.Resource,
+		permission.Action,
+		permission.Description,
+		permission.IsActive,
+		permission.CreatedAt,
+		permission.UpdatedAt,
+		permission.CreatedBy,
+		permission.UpdatedBy,
+		permission.DeletedAt,
+		permission.Version,
+		permission.TenantID,
+	)
+
+	if err != nil {
+		r.logger.Error("Failed to create permission",
+			zap.String("permission_id", permission.ID.String()),
+			zap.String("code", permission.Code),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to create permission: %w", err)
+	}
+
+	r.logger.Info("Permission created successfully",
+		zap.String("permission_id", permission.ID.String()),
+		zap.String("code", permission.Code),
+	)
+
+	return nil
+}
+
+// GetByID retrieves a permission by ID
+func (r *permissionRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Permission, error) {
+	query := `
+		SELECT ` + permissionColumns + `
+		FROM BMSF_PERMISSION
+		WHERE ID = :1 AND DELETED_AT IS NULL`
+
+	permission, err := scanPermission(executor(ctx, r.db).QueryRowContext(ctx, query, id.String()))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		r.logger.Error("Failed to get permission by ID",
+			zap.String("permission_id", id.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to get permission by ID: %w", err)
+	}
+
+	return permission, nil
+}
+
+// GetByCode retrieves a permission by code
+func (r *permissionRepository) GetByCode(ctx context.Context, code string) (*entities.Permission, error) {
+	query := `
+		SELECT ` + permissionColumns + `
+		FROM BMSF_PERMISSION
+		WHERE CODE = :1 AND DELETED_AT IS NULL`
+
+	permission, err := scanPermission(executor(ctx, r.db).QueryRowContext(ctx, query, code))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		r.logger.Error("Failed to get permission by code",
+			zap.String("code", code),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to get permission by code: %w", err)
+	}
+
+	return permission, nil
+}
+
+// Update updates an existing permission
+func (r *permissionRepository) Update(ctx context.Context, permission *entities.Permission) error {
+	query := `
+		UPDATE BMSF_PERMISSION
+		SET NAME = :1, DESCRIPTION = :2, IS_ACTIVE = :3,
+			UPDATED_AT = :4, UPDATED_BY = :5, VERSION = :6
+		WHERE ID = :7 AND DELETED_AT IS NULL`
+
+	result, err := executor(ctx, r.db).ExecContext(ctx, query,
+		permission.Name,
+		permission.Description,
+		permission.IsActive,
+		permission.UpdatedAt,
+		permission.UpdatedBy,
+		permission.Version,
+		permission.ID.String(),
+	)
+
+	if err != nil {
+		r.logger.Error("Failed to update permission",
+			zap.String("permission_id", permission.ID.String()),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to update permission: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("permission not found")
+	}
+
+	r.logger.Info("Permission updated successfully",
+		zap.String("permission_id", permission.ID.String()),
+	)
+
+	return nil
+}
+
+// Delete performs soft delete of a permission by ID and removes its role grants
+func (r *permissionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `
+		UPDATE BMSF_PERMISSION
+		SET DELETED_AT = CURRENT_TIMESTAMP, VERSION = VERSION + 1
+		WHERE ID = :1 AND DELETED_AT IS NULL`
+
+	err := withinTransaction(ctx, r.db, func(ctx context.Context) error {
+		result, err := executor(ctx, r.db).ExecContext(ctx, query, id.String())
+		if err != nil {
+			r.logger.Error("Failed to delete permission",
+				zap.String("permission_id", id.String()),
+				zap.Error(err),
+			)
+			return fmt.Errorf("failed to delete permission: %w", err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+
+		if rowsAffected == 0 {
+			return fmt.Errorf("permission not found")
+		}
+
+		if _, err := executor(ctx, r.db).ExecContext(ctx, `DELETE FROM BMSF_ROLE_PERMISSION WHERE PERMISSION_ID = :1`, id.String()); err != nil {
+			r.logger.Error("Failed to revoke deleted permission from roles",
+				zap.String("permission_id", id.String()),
+				zap.Error(err),
+			)
+			return fmt.Errorf("failed to revoke permission grants: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	r.logger.Info("Permission deleted successfully",
+		zap.String("permission_id", id.String()),
+	)
+
+	return nil
+}
+
+// List retrieves permissions with pagination
+func (r *permissionRepository) List(ctx context.Context, limit, offset int) ([]*entities.Permission, error) {
+	query := `
+		SELECT ` + permissionColumns + `
+		FROM BMSF_PERMISSION
+		WHERE DELETED_AT IS NULL
+		ORDER BY CODE ASC, ID ASC
+		OFFSET :1 ROWS FETCH NEXT :2 ROWS ONLY`
+
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, offset, limit)
+	if err != nil {
+		r.logger.Error("Failed to list permissions",
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to list permissions: %w", err)
+	}
+	defer rows.Close()
+
+	var permissions []*entities.Permission
+	for rows.Next() {
+		permission, err := scanPermission(rows)
+		if err != nil {
+			r.logger.Error("Failed to scan permission row",
+				zap.Error(err),
+			)
+			return nil, fmt.Errorf("failed to scan permission row: %w", err)
+		}
+
+		permissions = append(permissions, permission)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating permission rows: %w", err)
+	}
+
+	return permissions, nil
+}
+
+// Count returns the total number of permissions
+func (r *permissionRepository) Count(ctx context.Context) (int64, error) {
+	query := `SELECT COUNT(*) FROM BMSF_PERMISSION WHERE DELETED_AT IS NULL`
+
+	var count int64
+	err := executor(ctx, r.db).QueryRowContext(ctx, query).Scan(&count)
+	if err != nil {
+		r.logger.Error("Failed to count permissions",
+			zap.Error(err),
+		)
+		return 0, fmt.Errorf("failed to count permissions: %w", err)
+	}
+
+	return count, nil
+}
+
+// scanPermission scans a row selected with permissionColumns into a permission entity
+func scanPermission(row rowScanner) (*entities.Permission, error) {
+	var permission entities.Permission
+	// Oracle stores empty strings as NULL
+	var description sql.NullString
+
+	err := row.Scan(
+		&permission.ID,
+		&permission.Name,
+		&permission.Code,
+		&permission.Resource,
+		&permission.Action,
+		&description,
+		&permission.IsActive,
+		&permission.CreatedAt,
+		&permission.UpdatedAt,
+		&permission.CreatedBy,
+		&permission.UpdatedBy,
+		&permission.DeletedAt,
+		&permission.Version,
+		&permission.TenantID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	permission.Description = description.String
+	return &permission, nil
+}
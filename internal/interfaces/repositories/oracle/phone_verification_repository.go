@@ -0,0 +1,235 @@
+package oracle
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+
+	"go.uber.org/zap"
+)
+
+// phoneVerificationColumns is the column list scanned by scanPhoneVerification
+const phoneVerificationColumns = `ID, CREATED_AT, UPDATED_AT, CREATED_BY, UPDATED_BY,
+		       DELETED_AT, VERSION, TENANT_ID,
+		       USER_ID, PHONE, CODE_HASH, EXPIRES_AT, ATTEMPTS, CONSUMED_AT`
+
+// PhoneVerificationRepository implements the phone verification repository interface for Oracle
+type PhoneVerificationRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// NewPhoneVerificationRepository creates a new Oracle phone verification repository
+func NewPhoneVerificationRepository(db *sql.DB, logger *zap.Logger) repositories.PhoneVerificationRepository {
+	return &PhoneVerificationRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create creates a new phone verification
+func (r *PhoneVerificationRepository) Create(ctx context.Context, verification *entities.PhoneVerification) error {
+	query := `
+		INSERT INTO BMSF_PHONE_VERIFICATION (
+			ID, CREATED_AT, UPDATED_AT, VERSION,
+			USER_ID, PHONE, CODE_HASH, EXPIRES_AT, ATTEMPTS, CONSUMED_AT
+		) VALUES (
+			:1, :2, :3, :4, :5, :6, :7, :8, :9, :10
+		)`
+
+	_, err := executor(ctx, r.db).ExecContext(ctx, query,
+		verification.ID,
+		verification.CreatedAt,
+		verification.UpdatedAt,
+		verification.Version,
+		verification.UserID,
+		verification.Phone,
+		verification.CodeHash,
+		verification.ExpiresAt,
+		verification.Attempts,
+		verification.ConsumedAt,
+	)
+
+	if err != nil {
+		r.logger.Error("Failed to create phone verification",
+			zap.String("user_id", verification.UserID.String()),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to create phone verification: %w", err)
+	}
+
+	r.logger.Info("Phone verification created successfully",
+		zap.String("user_id", verification.UserID.String()),
+		zap.String("verification_id", verification.ID.String()),
+	)
+
+	return nil
+}
+
+// GetLatestForUser gets the most recent unconsumed phone verification of a user
+func (r *PhoneVerificationRepository) GetLatestForUser(ctx context.Context, userID string) (*entities.PhoneVerification, error) {
+	query := `
+		SELECT ` + phoneVerificationColumns + `
+		FROM BMSF_PHONE_VERIFICATION
+		WHERE USER_ID = :1 AND CONSUMED_AT IS NULL AND DELETED_AT IS NULL
+		ORDER BY CREATED_AT DESC
+		FETCH FIRST 1 ROWS ONLY`
+
+	verification, err := scanPhoneVerification(executor(ctx, r.db).QueryRowContext(ctx, query, userID))
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("phone verification not found")
+		}
+		r.logger.Error("Failed to get latest phone verification",
+			zap.String("user_id", userID),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to get phone verification: %w", err)
+	}
+
+	return verification, nil
+}
+
+// ReserveAttempt counts one code entry, returning false once maxAttempts entries have been counted
+// The increment is conditional in SQL, so concurrent guesses cannot exceed the limit.
+func (r *PhoneVerificationRepository) ReserveAttempt(ctx context.Context, id string, maxAttempts int) (bool, error) {
+	query := `
+		UPDATE BMSF_PHONE_VERIFICATION SET
+			ATTEMPTS = ATTEMPTS + 1,
+			UPDATED_AT = :1
+		WHERE ID = :2 AND ATTEMPTS < :3 AND CONSUMED_AT IS NULL`
+
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, time.Now(), id, maxAttempts)
+	if err != nil {
+		r.logger.Error("Failed to reserve phone verification attempt",
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		return false, fmt.Errorf("failed to reserve phone verification attempt: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// MarkConsumed uses up a phone verification, failing when it has been consumed already
+func (r *PhoneVerificationRepository) MarkConsumed(ctx context.Context, verification *entities.PhoneVerification) error {
+	query := `
+		UPDATE BMSF_PHONE_VERIFICATION SET
+			UPDATED_AT = :1,
+			UPDATED_BY = :2,
+			VERSION = :3,
+			CONSUMED_AT = :4
+		WHERE ID = :5 AND CONSUMED_AT IS NULL`
+
+	result, err := executor(ctx, r.db).ExecContext(ctx, query,
+		verification.UpdatedAt,
+		verification.UpdatedBy,
+		verification.Version,
+		verification.ConsumedAt,
+		verification.ID,
+	)
+
+	if err != nil {
+		r.logger.Error("Failed to consume phone verification",
+			zap.String("id", verification.ID.String()),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to consume phone verification: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("phone verification not found or already consumed")
+	}
+
+	r.logger.Info("Phone verification consumed",
+		zap.String("id", verification.ID.String()),
+	)
+
+	return nil
+}
+
+// InvalidateAllForUser consumes every outstanding phone verification of a user
+func (r *PhoneVerificationRepository) InvalidateAllForUser(ctx context.Context, userID string) error {
+	query := `
+		UPDATE BMSF_PHONE_VERIFICATION SET
+			CONSUMED_AT = :1,
+			UPDATED_AT = :2
+		WHERE USER_ID = :3 AND CONSUMED_AT IS NULL AND DELETED_AT IS NULL`
+
+	now := time.Now()
+	_, err := executor(ctx, r.db).ExecContext(ctx, query, now, now, userID)
+	if err != nil {
+		r.logger.Error("Failed to invalidate phone verifications for user",
+			zap.String("user_id", userID),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to invalidate phone verifications: %w", err)
+	}
+
+	return nil
+}
+
+// CleanupExpired removes expired phone verifications
+func (r *PhoneVerificationRepository) CleanupExpired(ctx context.Context) error {
+	query := `DELETE FROM BMSF_PHONE_VERIFICATION WHERE EXPIRES_AT < :1`
+
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, time.Now())
+	if err != nil {
+		r.logger.Error("Failed to cleanup expired phone verifications",
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to cleanup expired phone verifications: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	r.logger.Info("Expired phone verifications cleaned up",
+		zap.Int64("count", rowsAffected),
+	)
+
+	return nil
+}
+
+// scanPhoneVerification scans a row selected with phoneVerificationColumns
+func scanPhoneVerification(row rowScanner) (*entities.PhoneVerification, error) {
+	var verification entities.PhoneVerification
+	err := row.Scan(
+		&verification.ID,
+		&verification.CreatedAt,
+		&verification.UpdatedAt,
+		&verification.CreatedBy,
+		&verification.UpdatedBy,
+		&verification.DeletedAt,
+		&verification.Version,
+		&verification.TenantID,
+		&verification.UserID,
+		&verification.Phone,
+		&verification.CodeHash,
+		&verification.ExpiresAt,
+		&verification.Attempts,
+		&verification.ConsumedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &verification, nil
+}
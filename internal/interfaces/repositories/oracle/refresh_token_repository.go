@@ -12,6 +12,12 @@ import (
 	"go.uber.org/zap"
 )
 
+// refreshTokenColumns is the column list scanned by scanRefreshToken
+const refreshTokenColumns = `ID, CREATED_AT, UPDATED_AT, CREATED_BY, UPDATED_BY, 
+		       DELETED_AT, VERSION, TENANT_ID,
+		       USER_ID, TOKEN, EXPIRES_AT, IS_REVOKED, 
+		       REVOKED_AT, IP_ADDRESS, USER_AGENT, FAMILY_ID`
+
 // RefreshTokenRepository implements the refresh token repository interface for Oracle
 type RefreshTokenRepository struct {
 	db     *sql.DB
@@ -32,23 +38,24 @@ func (r *RefreshTokenRepository) Create(ctx context.Context, refreshToken *entit
 		INSERT INTO BMSF_REFRESH_TOKEN (
 			ID, CREATED_AT, UPDATED_AT, VERSION,
 			USER_ID, TOKEN, EXPIRES_AT, IS_REVOKED, 
-			REVOKED_AT, IP_ADDRESS, USER_AGENT
+			REVOKED_AT, IP_ADDRESS, USER_AGENT, FAMILY_ID
 		) VALUES (
-			:1, :2, :3, :4, :5, :6, :7, :8, :9, :10, :11
+			:1, :2, :3, :4, :5, :6, :7, :8, :9, :10, :11, :12
 		)`
 
-	_, err := r.db.ExecContext(ctx, query,
+	_, err := executor(ctx, r.db).ExecContext(ctx, query,
 		refreshToken.ID,
 		refreshToken.CreatedAt,
 		refreshToken.UpdatedAt,
 		refreshToken.Version,
 		refreshToken.UserID,
-		refreshToken.Token,
+		refreshToken.TokenHash,
 		refreshToken.ExpiresAt,
 		refreshToken.IsRevoked,
 		refreshToken.RevokedAt,
 		refreshToken.IPAddress,
 		refreshToken.UserAgent,
+		refreshToken.FamilyID,
 	)
 
 	if err != nil {
@@ -70,31 +77,11 @@ func (r *RefreshTokenRepository) Create(ctx context.Context, refreshToken *entit
 // GetByID gets a refresh token by ID
 func (r *RefreshTokenRepository) GetByID(ctx context.Context, id string) (*entities.RefreshToken, error) {
 	query := `
-		SELECT ID, CREATED_AT, UPDATED_AT, CREATED_BY, UPDATED_BY, 
-		       DELETED_AT, VERSION, TENANT_ID,
-		       USER_ID, TOKEN, EXPIRES_AT, IS_REVOKED, 
-		       REVOKED_AT, IP_ADDRESS, USER_AGENT
+		SELECT ` + refreshTokenColumns + `
 		FROM BMSF_REFRESH_TOKEN 
 		WHERE ID = :1 AND DELETED_AT IS NULL`
 
-	var refreshToken entities.RefreshToken
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&refreshToken.ID,
-		&refreshToken.CreatedAt,
-		&refreshToken.UpdatedAt,
-		&refreshToken.CreatedBy,
-		&refreshToken.UpdatedBy,
-		&refreshToken.DeletedAt,
-		&refreshToken.Version,
-		&refreshToken.TenantID,
-		&refreshToken.UserID,
-		&refreshToken.Token,
-		&refreshToken.ExpiresAt,
-		&refreshToken.IsRevoked,
-		&refreshToken.RevokedAt,
-		&refreshToken.IPAddress,
-		&refreshToken.UserAgent,
-	)
+	refreshToken, err := scanRefreshToken(executor(ctx, r.db).QueryRowContext(ctx, query, id))
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -107,37 +94,17 @@ func (r *RefreshTokenRepository) GetByID(ctx context.Context, id string) (*entit
 		return nil, fmt.Errorf("failed to get refresh token: %w", err)
 	}
 
-	return &refreshToken, nil
+	return refreshToken, nil
 }
 
-// GetByToken gets a refresh token by token string
+// GetByToken gets a refresh token by token string, looked up by its stored digest
 func (r *RefreshTokenRepository) GetByToken(ctx context.Context, token string) (*entities.RefreshToken, error) {
 	query := `
-		SELECT ID, CREATED_AT, UPDATED_AT, CREATED_BY, UPDATED_BY, 
-		       DELETED_AT, VERSION, TENANT_ID,
-		       USER_ID, TOKEN, EXPIRES_AT, IS_REVOKED, 
-		       REVOKED_AT, IP_ADDRESS, USER_AGENT
+		SELECT ` + refreshTokenColumns + `
 		FROM BMSF_REFRESH_TOKEN 
 		WHERE TOKEN = :1 AND DELETED_AT IS NULL`
 
-	var refreshToken entities.RefreshToken
-	err := r.db.QueryRowContext(ctx, query, token).Scan(
-		&refreshToken.ID,
-		&refreshToken.CreatedAt,
-		&refreshToken.UpdatedAt,
-		&refreshToken.CreatedBy,
-		&refreshToken.UpdatedBy,
-		&refreshToken.DeletedAt,
-		&refreshToken.Version,
-		&refreshToken.TenantID,
-		&refreshToken.UserID,
-		&refreshToken.Token,
-		&refreshToken.ExpiresAt,
-		&refreshToken.IsRevoked,
-		&refreshToken.RevokedAt,
-		&refreshToken.IPAddress,
-		&refreshToken.UserAgent,
-	)
+	refreshToken, err := scanRefreshToken(executor(ctx, r.db).QueryRowContext(ctx, query, entities.HashRefreshToken(token)))
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -149,21 +116,18 @@ func (r *RefreshTokenRepository) GetByToken(ctx context.Context, token string) (
 		return nil, fmt.Errorf("failed to get refresh token: %w", err)
 	}
 
-	return &refreshToken, nil
+	return refreshToken, nil
 }
 
 // GetByUserID gets all refresh tokens for a user
 func (r *RefreshTokenRepository) GetByUserID(ctx context.Context, userID string) ([]*entities.RefreshToken, error) {
 	query := `
-		SELECT ID, CREATED_AT, UPDATED_AT, CREATED_BY, UPDATED_BY, 
-		       DELETED_AT, VERSION, TENANT_ID,
-		       USER_ID, TOKEN, EXPIRES_AT, IS_REVOKED, 
-		       REVOKED_AT, IP_ADDRESS, USER_AGENT
+		SELECT ` + refreshTokenColumns + `
 		FROM BMSF_REFRESH_TOKEN 
 		WHERE USER_ID = :1 AND DELETED_AT IS NULL
 		ORDER BY CREATED_AT DESC`
 
-	rows, err := r.db.QueryContext(ctx, query, userID)
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, userID)
 	if err != nil {
 		r.logger.Error("Failed to get refresh tokens by user ID",
 			zap.String("user_id", userID),
@@ -175,31 +139,14 @@ func (r *RefreshTokenRepository) GetByUserID(ctx context.Context, userID string)
 
 	var refreshTokens []*entities.RefreshToken
 	for rows.Next() {
-		var refreshToken entities.RefreshToken
-		err := rows.Scan(
-			&refreshToken.ID,
-			&refreshToken.CreatedAt,
-			&refreshToken.UpdatedAt,
-			&refreshToken.CreatedBy,
-			&refreshToken.UpdatedBy,
-			&refreshToken.DeletedAt,
-			&refreshToken.Version,
-			&refreshToken.TenantID,
-			&refreshToken.UserID,
-			&refreshToken.Token,
-			&refreshToken.ExpiresAt,
-			&refreshToken.IsRevoked,
-			&refreshToken.RevokedAt,
-			&refreshToken.IPAddress,
-			&refreshToken.UserAgent,
-		)
+		refreshToken, err := scanRefreshToken(rows)
 		if err != nil {
 			r.logger.Error("Failed to scan refresh token",
 				zap.Error(err),
 			)
 			return nil, fmt.Errorf("failed to scan refresh token: %w", err)
 		}
-		refreshTokens = append(refreshTokens, &refreshToken)
+		refreshTokens = append(refreshTokens, refreshToken)
 	}
 
 	return refreshTokens, nil
@@ -216,7 +163,7 @@ func (r *RefreshTokenRepository) Update(ctx context.Context, refreshToken *entit
 			REVOKED_AT = :5
 		WHERE ID = :6 AND VERSION = :7`
 
-	result, err := r.db.ExecContext(ctx, query,
+	result, err := executor(ctx, r.db).ExecContext(ctx, query,
 		refreshToken.UpdatedAt,
 		refreshToken.UpdatedBy,
 		refreshToken.Version,
@@ -254,7 +201,7 @@ func (r *RefreshTokenRepository) Update(ctx context.Context, refreshToken *entit
 func (r *RefreshTokenRepository) Delete(ctx context.Context, id string) error {
 	query := `UPDATE BMSF_REFRESH_TOKEN SET DELETED_AT = :1 WHERE ID = :2`
 
-	_, err := r.db.ExecContext(ctx, query, time.Now(), id)
+	_, err := executor(ctx, r.db).ExecContext(ctx, query, time.Now(), id)
 	if err != nil {
 		r.logger.Error("Failed to delete refresh token",
 			zap.String("id", id),
@@ -280,7 +227,7 @@ func (r *RefreshTokenRepository) RevokeAllForUser(ctx context.Context, userID st
 		WHERE USER_ID = :3 AND IS_REVOKED = 0 AND DELETED_AT IS NULL`
 
 	now := time.Now()
-	_, err := r.db.ExecContext(ctx, query, now, now, userID)
+	_, err := executor(ctx, r.db).ExecContext(ctx, query, now, now, userID)
 	if err != nil {
 		r.logger.Error("Failed to revoke all refresh tokens for user",
 			zap.String("user_id", userID),
@@ -296,11 +243,43 @@ func (r *RefreshTokenRepository) RevokeAllForUser(ctx context.Context, userID st
 	return nil
 }
 
+// RevokeFamily revokes every refresh token of a token family
+func (r *RefreshTokenRepository) RevokeFamily(ctx context.Context, familyID string) error {
+	query := `
+		UPDATE BMSF_REFRESH_TOKEN SET
+			IS_REVOKED = 1,
+			REVOKED_AT = :1,
+			UPDATED_AT = :2
+		WHERE (FAMILY_ID = :3 OR ID = :4) AND IS_REVOKED = 0 AND DELETED_AT IS NULL`
+
+	now := time.Now()
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, now, now, familyID, familyID)
+	if err != nil {
+		r.logger.Error("Failed to revoke refresh token family",
+			zap.String("family_id", familyID),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to revoke refresh token family: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	r.logger.Info("Refresh token family revoked",
+		zap.String("family_id", familyID),
+		zap.Int64("count", rowsAffected),
+	)
+
+	return nil
+}
+
 // CleanupExpired removes expired refresh tokens
 func (r *RefreshTokenRepository) CleanupExpired(ctx context.Context) error {
 	query := `DELETE FROM BMSF_REFRESH_TOKEN WHERE EXPIRES_AT < :1`
 
-	result, err := r.db.ExecContext(ctx, query, time.Now())
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, time.Now())
 	if err != nil {
 		r.logger.Error("Failed to cleanup expired refresh tokens",
 			zap.Error(err),
@@ -319,3 +298,31 @@ func (r *RefreshTokenRepository) CleanupExpired(ctx context.Context) error {
 
 	return nil
 }
+
+// scanRefreshToken scans a row selected with refreshTokenColumns
+func scanRefreshToken(row rowScanner) (*entities.RefreshToken, error) {
+	var refreshToken entities.RefreshToken
+	err := row.Scan(
+		&refreshToken.ID,
+		&refreshToken.CreatedAt,
+		&refreshToken.UpdatedAt,
+		&refreshToken.CreatedBy,
+		&refreshToken.UpdatedBy,
+		&refreshToken.DeletedAt,
+		&refreshToken.Version,
+		&refreshToken.TenantID,
+		&refreshToken.UserID,
+		&refreshToken.TokenHash,
+		&refreshToken.ExpiresAt,
+		&refreshToken.IsRevoked,
+		&refreshToken.RevokedAt,
+		&refreshToken.IPAddress,
+		&refreshToken.UserAgent,
+		&refreshToken.FamilyID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &refreshToken, nil
+}
@@ -0,0 +1,476 @@
+package oracle
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// roleColumns lists the BMSF_ROLE columns read by every role query, in scanRole order
+const roleColumns = `ID, NAME, CODE, DESCRIPTION, IS_ACTIVE, IS_SYSTEM, PARENT_ID,
+			   CREATED_AT, UPDATED_AT, CREATED_BY, UPDATED_BY,
+			   DELETED_AT, VERSION, TENANT_ID`
+
+// roleRepository implements the RoleRepository interface for Oracle
+type roleRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// NewRoleRepository creates a new Oracle role repository
+func NewRoleRepository(db *sql.DB, logger *zap.Logger) repositories.RoleRepository {
+	return &roleRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create creates a new role together with its permission grants
+func (r *roleRepository) Create(ctx context.Context, role *entities.Role) error {
+	query := `
+		INSERT INTO BMSF_ROLE (
+			ID, NAME, CODE, DESCRIPTION, IS_ACTIVE, IS_SYSTEM, PARENT_ID,
+			CREATED_AT, UPDATED_AT, CREATED_BY, UPDATED_BY,
+			DELETED_AT, VERSION, TENANT_ID
+		) VALUES (
+			:1, :2, :3, :4, :5, :6, :7, :8, :9, :10, :11, :12, :13, :14
+		)`
+
+	err := withinTransaction(ctx, r.db, func(ctx context.Context) error {
+		_, err := executor(ctx, r.db).ExecContext(ctx, query,
+			role.ID.String(),
+			role.Name,
+			role.Code,
+			role.Description,
+			role.IsActive,
+			role.IsSystem,
+			role.ParentID,
+			role.CreatedAt,
+			role.UpdatedAt,
+			role.CreatedBy,
+			role.UpdatedBy,
+			role.DeletedAt,
+			role.Version,
+			role.TenantID,
+		)
+		if err != nil {
+			return err
+		}
+
+		return r.replacePermissions(ctx, role)
+	})
+
+	if err != nil {
+		r.logger.Error("Failed to create role",
+			zap.String("role_id", role.ID.String()),
+			zap.String("code", role.Code),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to create role: %w", err)
+	}
+
+	r.logger.Info("Role created successfully",
+		zap.String("role_id", role.ID.String()),
+		zap.String("code", role.Code),
+	)
+
+	return nil
+}
+
+// GetByID retrieves a role by ID
+func (r *roleRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Role, error) {
+	query := `
+		SELECT ` + roleColumns + `
+		FROM BMSF_ROLE
+		WHERE ID = :1 AND DELETED_AT IS NULL`
+
+	role, err := scanRole(executor(ctx, r.db).QueryRowContext(ctx, query, id.String()))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		r.logger.Error("Failed to get role by ID",
+			zap.String("role_id", id.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to get role by ID: %w", err)
+	}
+
+	if err := r.loadPermissionCodes(ctx, role); err != nil {
+		return nil, err
+	}
+
+	return role, nil
+}
+
+// GetByCode retrieves a role by code
+func (r *roleRepository) GetByCode(ctx context.Context, code string) (*entities.Role, error) {
+	query := `
+		SELECT ` + roleColumns + `
+		FROM BMSF_ROLE
+		WHERE CODE = :1 AND DELETED_AT IS NULL`
+
+	role, err := scanRole(executor(ctx, r.db).QueryRowContext(ctx, query, code))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		r.logger.Error("Failed to get role by code",
+			zap.String("code", code),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to get role by code: %w", err)
+	}
+
+	if err := r.loadPermissionCodes(ctx, role); err != nil {
+		return nil, err
+	}
+
+	return role, nil
+}
+
+// Update updates an existing role and replaces its permission grants
+func (r *roleRepository) Update(ctx context.Context, role *entities.Role) error {
+	query := `
+		UPDATE BMSF_ROLE
+		SET NAME = :1, DESCRIPTION = :2, IS_ACTIVE = :3, PARENT_ID = :4,
+			UPDATED_AT = :5, UPDATED_BY = :6, VERSION = :7
+		WHERE ID = :8 AND DELETED_AT IS NULL`
+
+	err := withinTransaction(ctx, r.db, func(ctx context.Context) error {
+		result, err := executor(ctx, r.db).ExecContext(ctx, query,
+			role.Name,
+			role.Description,
+			role.IsActive,
+			role.ParentID,
+			role.UpdatedAt,
+			role.UpdatedBy,
+			role.Version,
+			role.ID.String(),
+		)
+
+		if err != nil {
+			r.logger.Error("Failed to update role",
+				zap.String("role_id", role.ID.String()),
+				zap.Error(err),
+			)
+			return fmt.Errorf("failed to update role: %w", err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+
+		if rowsAffected == 0 {
+			return fmt.Errorf("role not found")
+		}
+
+		if err := r.replacePermissions(ctx, role); err != nil {
+			r.logger.Error("Failed to update role permissions",
+				zap.String("role_id", role.ID.String()),
+				zap.Error(err),
+			)
+			return fmt.Errorf("failed to update role permissions: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	r.logger.Info("Role updated successfully",
+		zap.String("role_id", role.ID.String()),
+	)
+
+	return nil
+}
+
+// Delete performs soft delete of a role by ID
+func (r *roleRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `
+		UPDATE BMSF_ROLE
+		SET DELETED_AT = CURRENT_TIMESTAMP, VERSION = VERSION + 1
+		WHERE ID = :1 AND DELETED_AT IS NULL`
+
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, id.String())
+	if err != nil {
+		r.logger.Error("Failed to delete role",
+			zap.String("role_id", id.String()),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to delete role: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("role not found")
+	}
+
+	r.logger.Info("Role deleted successfully",
+		zap.String("role_id", id.String()),
+	)
+
+	return nil
+}
+
+// List retrieves roles with pagination
+func (r *roleRepository) List(ctx context.Context, limit, offset int) ([]*entities.Role, error) {
+	query := `
+		SELECT ` + roleColumns + `
+		FROM BMSF_ROLE
+		WHERE DELETED_AT IS NULL
+		ORDER BY NAME ASC, ID ASC
+		OFFSET :1 ROWS FETCH NEXT :2 ROWS ONLY`
+
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, offset, limit)
+	if err != nil {
+		r.logger.Error("Failed to list roles",
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to list roles: %w", err)
+	}
+
+	return r.scanRoles(ctx, rows)
+}
+
+// Count returns the total number of roles
+func (r *roleRepository) Count(ctx context.Context) (int64, error) {
+	query := `SELECT COUNT(*) FROM BMSF_ROLE WHERE DELETED_AT IS NULL`
+
+	var count int64
+	err := executor(ctx, r.db).QueryRowContext(ctx, query).Scan(&count)
+	if err != nil {
+		r.logger.Error("Failed to count roles",
+			zap.Error(err),
+		)
+		return 0, fmt.Errorf("failed to count roles: %w", err)
+	}
+
+	return count, nil
+}
+
+// ListByPermission retrieves the roles granting a permission code, directly or through a wildcard
+func (r *roleRepository) ListByPermission(ctx context.Context, permissionCode string) ([]*entities.Role, error) {
+	granting := entities.PermissionGrantingCodes(permissionCode)
+
+	query := `
+		SELECT ` + roleColumns + `
+		FROM BMSF_ROLE ro
+		WHERE DELETED_AT IS NULL
+		AND EXISTS (
+			SELECT 1
+			FROM BMSF_ROLE_PERMISSION rp
+			JOIN BMSF_PERMISSION p ON p.ID = rp.PERMISSION_ID
+			WHERE rp.ROLE_ID = ro.ID AND p.DELETED_AT IS NULL
+			AND p.CODE IN (:1, :2, :3)
+		)
+		ORDER BY NAME ASC, ID ASC`
+
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, granting[0], granting[1], granting[2])
+	if err != nil {
+		r.logger.Error("Failed to list roles by permission",
+			zap.String("permission_code", permissionCode),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to list roles by permission: %w", err)
+	}
+
+	return r.scanRoles(ctx, rows)
+}
+
+// GetHierarchy retrieves a role followed by its ancestors, nearest first
+func (r *roleRepository) GetHierarchy(ctx context.Context, id uuid.UUID) ([]*entities.Role, error) {
+	query := `
+		SELECT ` + roleColumns + `
+		FROM BMSF_ROLE
+		START WITH ID = :1 AND DELETED_AT IS NULL
+		CONNECT BY NOCYCLE ID = PRIOR PARENT_ID AND DELETED_AT IS NULL
+		ORDER BY LEVEL`
+
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, id.String())
+	if err != nil {
+		r.logger.Error("Failed to get role hierarchy",
+			zap.String("role_id", id.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to get role hierarchy: %w", err)
+	}
+
+	return r.scanRoles(ctx, rows)
+}
+
+// CountChildren returns the number of roles inheriting directly from a role
+func (r *roleRepository) CountChildren(ctx context.Context, id uuid.UUID) (int64, error) {
+	query := `SELECT COUNT(*) FROM BMSF_ROLE WHERE PARENT_ID = :1 AND DELETED_AT IS NULL`
+
+	var count int64
+	err := executor(ctx, r.db).QueryRowContext(ctx, query, id.String()).Scan(&count)
+	if err != nil {
+		r.logger.Error("Failed to count child roles",
+			zap.String("role_id", id.String()),
+			zap.Error(err),
+		)
+		return 0, fmt.Errorf("failed to count child roles: %w", err)
+	}
+
+	return count, nil
+}
+
+// replacePermissions replaces the BMSF_ROLE_PERMISSION grants of a role with its permission codes
+// The caller runs it in the transaction that writes the role.
+func (r *roleRepository) replacePermissions(ctx context.Context, role *entities.Role) error {
+	tx := executor(ctx, r.db)
+	if _, err := tx.ExecContext(ctx, `DELETE FROM BMSF_ROLE_PERMISSION WHERE ROLE_ID = :1`, role.ID.String()); err != nil {
+		return fmt.Errorf("failed to clear role permissions: %w", err)
+	}
+
+	query := `
+		INSERT INTO BMSF_ROLE_PERMISSION (ROLE_ID, PERMISSION_ID, CREATED_AT, CREATED_BY)
+		SELECT :1, ID, :2, :3
+		FROM BMSF_PERMISSION
+		WHERE CODE = :4 AND DELETED_AT IS NULL`
+
+	for _, code := range role.PermissionCodes {
+		result, err := tx.ExecContext(ctx, query, role.ID.String(), role.UpdatedAt, role.UpdatedBy, code)
+		if err != nil {
+			return fmt.Errorf("failed to grant permission %s: %w", code, err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+
+		if rowsAffected == 0 {
+			return fmt.Errorf("permission not found: %s", code)
+		}
+	}
+
+	return nil
+}
+
+// loadPermissionCodes fills the permission codes granted to each role with a single query
+func (r *roleRepository) loadPermissionCodes(ctx context.Context, roles ...*entities.Role) error {
+	if len(roles) == 0 {
+		return nil
+	}
+
+	byID := make(map[uuid.UUID]*entities.Role, len(roles))
+	placeholders := make([]string, len(roles))
+	args := make([]any, len(roles))
+	for i, role := range roles {
+		role.PermissionCodes = []string{}
+		byID[role.ID] = role
+		placeholders[i] = fmt.Sprintf(":%d", i+1)
+		args[i] = role.ID.String()
+	}
+
+	query := `
+		SELECT rp.ROLE_ID, p.CODE
+		FROM BMSF_ROLE_PERMISSION rp
+		JOIN BMSF_PERMISSION p ON p.ID = rp.PERMISSION_ID
+		WHERE rp.ROLE_ID IN (` + strings.Join(placeholders, ", ") + `)
+		AND p.DELETED_AT IS NULL
+		ORDER BY p.CODE ASC`
+
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		r.logger.Error("Failed to load role permissions",
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to load role permissions: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var roleID uuid.UUID
+		var code string
+		if err := rows.Scan(&roleID, &code); err != nil {
+			return fmt.Errorf("failed to scan role permission row: %w", err)
+		}
+
+		if role, ok := byID[roleID]; ok {
+			role.PermissionCodes = append(role.PermissionCodes, code)
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("error iterating role permission rows: %w", err)
+	}
+
+	return nil
+}
+
+// scanRoles scans role rows and loads their permission codes, closing the rows
+func (r *roleRepository) scanRoles(ctx context.Context, rows *sql.Rows) ([]*entities.Role, error) {
+	defer rows.Close()
+
+	var roles []*entities.Role
+	for rows.Next() {
+		role, err := scanRole(rows)
+		if err != nil {
+			r.logger.Error("Failed to scan role row",
+				zap.Error(err),
+			)
+			return nil, fmt.Errorf("failed to scan role row: %w", err)
+		}
+
+		roles = append(roles, role)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating role rows: %w", err)
+	}
+
+	// Release the connection before loading permissions
+	rows.Close()
+
+	if err := r.loadPermissionCodes(ctx, roles...); err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+// scanRole scans a row selected with roleColumns into a role entity
+func scanRole(row rowScanner) (*entities.Role, error) {
+	var role entities.Role
+	// Oracle stores empty strings as NULL
+	var description sql.NullString
+
+	err := row.Scan(
+		&role.ID,
+		&role.Name,
+		&role.Code,
+		&description,
+		&role.IsActive,
+		&role.IsSystem,
+		&role.ParentID,
+		&role.CreatedAt,
+		&role.UpdatedAt,
+		&role.CreatedBy,
+		&role.UpdatedBy,
+		&role.DeletedAt,
+		&role.Version,
+		&role.TenantID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	role.Description = description.String
+	return &role, nil
+}
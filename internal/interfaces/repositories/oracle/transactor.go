@@ -0,0 +1,89 @@
+package oracle
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"bm-staff/internal/domain/repositories"
+
+	"go.uber.org/zap"
+)
+
+// txContextKey is the private type for the transaction context key
+type txContextKey struct{}
+
+// sqlExecutor is implemented by both *sql.DB and *sql.Tx
+type sqlExecutor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// executor returns the transaction carried by ctx, or db outside of a transaction
+func executor(ctx context.Context, db *sql.DB) sqlExecutor {
+	if tx, ok := ctx.Value(txContextKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	return db
+}
+
+// transactor implements the Transactor interface for Oracle
+type transactor struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// NewTransactor creates a new Oracle transactor
+func NewTransactor(db *sql.DB, logger *zap.Logger) repositories.Transactor {
+	return &transactor{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// WithinTransaction runs fn in a transaction, joining the transaction already carried by ctx if any
+func (t *transactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txContextKey{}).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := t.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := fn(context.WithValue(ctx, txContextKey{}, tx)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		t.logger.Error("Failed to commit transaction",
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// withinTransaction runs the statements of a single repository call atomically,
+// joining the transaction carried by ctx if any so that they commit with the caller's change
+func withinTransaction(ctx context.Context, db *sql.DB, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txContextKey{}).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := fn(context.WithValue(ctx, txContextKey{}, tx)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,205 @@
+package oracle
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+
+	"go.uber.org/zap"
+)
+
+// userMFAColumns is the column list scanned by scanUserMFA
+const userMFAColumns = `ID, CREATED_AT, UPDATED_AT, CREATED_BY, UPDATED_BY,
+		       DELETED_AT, VERSION, TENANT_ID,
+		       USER_ID, SECRET, CONFIRMED_AT, LAST_USED_STEP`
+
+// UserMFARepository implements the TOTP enrollment repository interface for Oracle
+type UserMFARepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// NewUserMFARepository creates a new Oracle TOTP enrollment repository
+func NewUserMFARepository(db *sql.DB, logger *zap.Logger) repositories.UserMFARepository {
+	return &UserMFARepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create creates a new TOTP enrollment
+func (r *UserMFARepository) Create(ctx context.Context, mfa *entities.UserMFA) error {
+	query := `
+		INSERT INTO BMSF_USER_MFA (
+			ID, CREATED_AT, UPDATED_AT, CREATED_BY, VERSION,
+			USER_ID, SECRET, CONFIRMED_AT, LAST_USED_STEP
+		) VALUES (
+			:1, :2, :3, :4, :5, :6, :7, :8, :9
+		)`
+
+	_, err := executor(ctx, r.db).ExecContext(ctx, query,
+		mfa.ID,
+		mfa.CreatedAt,
+		mfa.UpdatedAt,
+		mfa.CreatedBy,
+		mfa.Version,
+		mfa.UserID,
+		mfa.Secret,
+		mfa.ConfirmedAt,
+		mfa.LastUsedStep,
+	)
+
+	if err != nil {
+		r.logger.Error("Failed to create MFA enrollment",
+			zap.String("user_id", mfa.UserID.String()),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to create MFA enrollment: %w", err)
+	}
+
+	r.logger.Info("MFA enrollment created successfully",
+		zap.String("user_id", mfa.UserID.String()),
+	)
+
+	return nil
+}
+
+// GetByUserID gets the TOTP enrollment of a user, or ErrMFANotEnrolled
+func (r *UserMFARepository) GetByUserID(ctx context.Context, userID string) (*entities.UserMFA, error) {
+	query := `
+		SELECT ` + userMFAColumns + `
+		FROM BMSF_USER_MFA
+		WHERE USER_ID = :1 AND DELETED_AT IS NULL`
+
+	mfa, err := scanUserMFA(executor(ctx, r.db).QueryRowContext(ctx, query, userID))
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, repositories.ErrMFANotEnrolled
+		}
+		r.logger.Error("Failed to get MFA enrollment",
+			zap.String("user_id", userID),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to get MFA enrollment: %w", err)
+	}
+
+	return mfa, nil
+}
+
+// Update updates a TOTP enrollment
+func (r *UserMFARepository) Update(ctx context.Context, mfa *entities.UserMFA) error {
+	query := `
+		UPDATE BMSF_USER_MFA SET
+			UPDATED_AT = :1,
+			UPDATED_BY = :2,
+			VERSION = :3,
+			SECRET = :4,
+			CONFIRMED_AT = :5,
+			LAST_USED_STEP = :6
+		WHERE ID = :7 AND DELETED_AT IS NULL`
+
+	result, err := executor(ctx, r.db).ExecContext(ctx, query,
+		mfa.UpdatedAt,
+		mfa.UpdatedBy,
+		mfa.Version,
+		mfa.Secret,
+		mfa.ConfirmedAt,
+		mfa.LastUsedStep,
+		mfa.ID,
+	)
+
+	if err != nil {
+		r.logger.Error("Failed to update MFA enrollment",
+			zap.String("id", mfa.ID.String()),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to update MFA enrollment: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("MFA enrollment not found")
+	}
+
+	return nil
+}
+
+// AdvanceStep records the time step of an accepted code, returning false when that step
+// or a later one was accepted already
+// The update is conditional in SQL, so a code cannot be replayed by concurrent requests.
+func (r *UserMFARepository) AdvanceStep(ctx context.Context, id string, step int64) (bool, error) {
+	query := `
+		UPDATE BMSF_USER_MFA SET
+			LAST_USED_STEP = :1,
+			UPDATED_AT = :2
+		WHERE ID = :3 AND LAST_USED_STEP < :4 AND DELETED_AT IS NULL`
+
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, step, time.Now(), id, step)
+	if err != nil {
+		r.logger.Error("Failed to record MFA code use",
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		return false, fmt.Errorf("failed to record MFA code use: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// DeleteByUserID removes the TOTP enrollment of a user
+func (r *UserMFARepository) DeleteByUserID(ctx context.Context, userID string) error {
+	query := `DELETE FROM BMSF_USER_MFA WHERE USER_ID = :1`
+
+	_, err := executor(ctx, r.db).ExecContext(ctx, query, userID)
+	if err != nil {
+		r.logger.Error("Failed to delete MFA enrollment",
+			zap.String("user_id", userID),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to delete MFA enrollment: %w", err)
+	}
+
+	r.logger.Info("MFA enrollment deleted",
+		zap.String("user_id", userID),
+	)
+
+	return nil
+}
+
+// scanUserMFA scans a row selected with userMFAColumns
+func scanUserMFA(row rowScanner) (*entities.UserMFA, error) {
+	var mfa entities.UserMFA
+	err := row.Scan(
+		&mfa.ID,
+		&mfa.CreatedAt,
+		&mfa.UpdatedAt,
+		&mfa.CreatedBy,
+		&mfa.UpdatedBy,
+		&mfa.DeletedAt,
+		&mfa.Version,
+		&mfa.TenantID,
+		&mfa.UserID,
+		&mfa.Secret,
+		&mfa.ConfirmedAt,
+		&mfa.LastUsedStep,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mfa, nil
+}
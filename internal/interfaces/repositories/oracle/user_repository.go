@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 
 	"bm-staff/internal/domain/entities"
 	"bm-staff/internal/domain/repositories"
@@ -12,6 +13,21 @@ import (
 	"go.uber.org/zap"
 )
 
+// userColumns lists the BMSF_USER columns read by every user query, in scanUser order
+const userColumns = `ID, USERNAME, EMAIL, FIRST_NAME, LAST_NAME, PHONE, 
+			   STATUS, PASSWORD_HASH, SALT, LAST_LOGIN_AT, LOGIN_ATTEMPTS, LOCKED_UNTIL,
+			   PASSWORD_CHANGED_AT,
+			   DEPARTMENT_ID, ROLE_ID, MANAGER_ID, EMPLOYEE_CODE,
+			   AVATAR, DATE_OF_BIRTH, GENDER, ADDRESS, CITY, COUNTRY,
+			   EMAIL_VERIFIED, PHONE_VERIFIED, LANGUAGE, TIMEZONE, NOTIFICATION_PREF,
+			   CREATED_AT, UPDATED_AT, CREATED_BY, UPDATED_BY, 
+			   DELETED_AT, VERSION, TENANT_ID`
+
+// rowScanner is implemented by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
 // userRepository implements the UserRepository interface for Oracle
 type userRepository struct {
 	db     *sql.DB
@@ -31,13 +47,18 @@ func (r *userRepository) Create(ctx context.Context, user *entities.User) error
 	query := `
 		INSERT INTO BMSF_USER (
 			ID, USERNAME, EMAIL, FIRST_NAME, LAST_NAME, PHONE, 
-			STATUS, CREATED_AT, UPDATED_AT, CREATED_BY, UPDATED_BY, 
+			STATUS, PASSWORD_HASH, SALT, LOGIN_ATTEMPTS, PASSWORD_CHANGED_AT,
+			DEPARTMENT_ID, ROLE_ID, MANAGER_ID, EMPLOYEE_CODE,
+			AVATAR, DATE_OF_BIRTH, GENDER, ADDRESS, CITY, COUNTRY,
+			EMAIL_VERIFIED, PHONE_VERIFIED, LANGUAGE, TIMEZONE, NOTIFICATION_PREF,
+			CREATED_AT, UPDATED_AT, CREATED_BY, UPDATED_BY, 
 			DELETED_AT, VERSION, TENANT_ID
 		) VALUES (
-			:1, :2, :3, :4, :5, :6, :7, :8, :9, :10, :11, :12, :13, :14
+			:1, :2, :3, :4, :5, :6, :7, :8, :9, :10, :11, :12, :13, :14, :15, :16, :17,
+			:18, :19, :20, :21, :22, :23, :24, :25, :26, :27, :28, :29, :30, :31, :32, :33
 		)`
 
-	_, err := r.db.ExecContext(ctx, query,
+	_, err := executor(ctx, r.db).ExecContext(ctx, query,
 		user.ID.String(),
 		user.Username,
 		user.Email,
@@ -45,6 +66,25 @@ func (r *userRepository) Create(ctx context.Context, user *entities.User) error
 		user.LastName,
 		user.Phone,
 		string(user.Status),
+		user.PasswordHash,
+		user.Salt,
+		user.LoginAttempts,
+		user.PasswordChangedAt,
+		user.DepartmentID,
+		user.RoleID,
+		user.ManagerID,
+		user.EmployeeCode,
+		user.Avatar,
+		user.DateOfBirth,
+		user.Gender,
+		user.Address,
+		user.City,
+		user.Country,
+		user.EmailVerified,
+		user.PhoneVerified,
+		user.Language,
+		user.Timezone,
+		user.NotificationPref,
 		user.CreatedAt,
 		user.UpdatedAt,
 		user.CreatedBy,
@@ -74,32 +114,11 @@ func (r *userRepository) Create(ctx context.Context, user *entities.User) error
 // GetByID retrieves a user by ID
 func (r *userRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.User, error) {
 	query := `
-		SELECT ID, USERNAME, EMAIL, FIRST_NAME, LAST_NAME, PHONE, 
-			   STATUS, CREATED_AT, UPDATED_AT, CREATED_BY, UPDATED_BY, 
-			   DELETED_AT, VERSION, TENANT_ID
+		SELECT ` + userColumns + `
 		FROM BMSF_USER 
 		WHERE ID = :1 AND DELETED_AT IS NULL`
 
-	var user entities.User
-	var status string
-
-	err := r.db.QueryRowContext(ctx, query, id.String()).Scan(
-		&user.ID,
-		&user.Username,
-		&user.Email,
-		&user.FirstName,
-		&user.LastName,
-		&user.Phone,
-		&status,
-		&user.CreatedAt,
-		&user.UpdatedAt,
-		&user.CreatedBy,
-		&user.UpdatedBy,
-		&user.DeletedAt,
-		&user.Version,
-		&user.TenantID,
-	)
-
+	user, err := scanUser(executor(ctx, r.db).QueryRowContext(ctx, query, id.String()))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -111,39 +130,17 @@ func (r *userRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.U
 		return nil, fmt.Errorf("failed to get user by ID: %w", err)
 	}
 
-	user.Status = entities.UserStatus(status)
-	return &user, nil
+	return user, nil
 }
 
 // GetByUsername retrieves a user by username
 func (r *userRepository) GetByUsername(ctx context.Context, username string) (*entities.User, error) {
 	query := `
-		SELECT ID, USERNAME, EMAIL, FIRST_NAME, LAST_NAME, PHONE, 
-			   STATUS, CREATED_AT, UPDATED_AT, CREATED_BY, UPDATED_BY, 
-			   DELETED_AT, VERSION, TENANT_ID
+		SELECT ` + userColumns + `
 		FROM BMSF_USER 
 		WHERE USERNAME = :1 AND DELETED_AT IS NULL`
 
-	var user entities.User
-	var status string
-
-	err := r.db.QueryRowContext(ctx, query, username).Scan(
-		&user.ID,
-		&user.Username,
-		&user.Email,
-		&user.FirstName,
-		&user.LastName,
-		&user.Phone,
-		&status,
-		&user.CreatedAt,
-		&user.UpdatedAt,
-		&user.CreatedBy,
-		&user.UpdatedBy,
-		&user.DeletedAt,
-		&user.Version,
-		&user.TenantID,
-	)
-
+	user, err := scanUser(executor(ctx, r.db).QueryRowContext(ctx, query, username))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -155,39 +152,17 @@ func (r *userRepository) GetByUsername(ctx context.Context, username string) (*e
 		return nil, fmt.Errorf("failed to get user by username: %w", err)
 	}
 
-	user.Status = entities.UserStatus(status)
-	return &user, nil
+	return user, nil
 }
 
 // GetByEmail retrieves a user by email
 func (r *userRepository) GetByEmail(ctx context.Context, email string) (*entities.User, error) {
 	query := `
-		SELECT ID, USERNAME, EMAIL, FIRST_NAME, LAST_NAME, PHONE, 
-			   STATUS, CREATED_AT, UPDATED_AT, CREATED_BY, UPDATED_BY, 
-			   DELETED_AT, VERSION, TENANT_ID
+		SELECT ` + userColumns + `
 		FROM BMSF_USER 
 		WHERE EMAIL = :1 AND DELETED_AT IS NULL`
 
-	var user entities.User
-	var status string
-
-	err := r.db.QueryRowContext(ctx, query, email).Scan(
-		&user.ID,
-		&user.Username,
-		&user.Email,
-		&user.FirstName,
-		&user.LastName,
-		&user.Phone,
-		&status,
-		&user.CreatedAt,
-		&user.UpdatedAt,
-		&user.CreatedBy,
-		&user.UpdatedBy,
-		&user.DeletedAt,
-		&user.Version,
-		&user.TenantID,
-	)
-
+	user, err := scanUser(executor(ctx, r.db).QueryRowContext(ctx, query, email))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -199,8 +174,7 @@ func (r *userRepository) GetByEmail(ctx context.Context, email string) (*entitie
 		return nil, fmt.Errorf("failed to get user by email: %w", err)
 	}
 
-	user.Status = entities.UserStatus(status)
-	return &user, nil
+	return user, nil
 }
 
 // Update updates an existing user
@@ -208,17 +182,42 @@ func (r *userRepository) Update(ctx context.Context, user *entities.User) error
 	query := `
 		UPDATE BMSF_USER 
 		SET USERNAME = :1, EMAIL = :2, FIRST_NAME = :3, LAST_NAME = :4, 
-			PHONE = :5, STATUS = :6, UPDATED_AT = :7, UPDATED_BY = :8, 
-			VERSION = :9
-		WHERE ID = :10 AND DELETED_AT IS NULL`
-
-	result, err := r.db.ExecContext(ctx, query,
+			PHONE = :5, STATUS = :6, PASSWORD_HASH = :7, SALT = :8, 
+			LAST_LOGIN_AT = :9, LOGIN_ATTEMPTS = :10, LOCKED_UNTIL = :11, PASSWORD_CHANGED_AT = :12,
+			DEPARTMENT_ID = :13, ROLE_ID = :14, MANAGER_ID = :15, EMPLOYEE_CODE = :16,
+			AVATAR = :17, DATE_OF_BIRTH = :18, GENDER = :19, ADDRESS = :20, CITY = :21, COUNTRY = :22,
+			EMAIL_VERIFIED = :23, PHONE_VERIFIED = :24, LANGUAGE = :25, TIMEZONE = :26, NOTIFICATION_PREF = :27,
+			UPDATED_AT = :28, UPDATED_BY = :29, VERSION = :30
+		WHERE ID = :31 AND DELETED_AT IS NULL`
+
+	result, err := executor(ctx, r.db).ExecContext(ctx, query,
 		user.Username,
 		user.Email,
 		user.FirstName,
 		user.LastName,
 		user.Phone,
 		string(user.Status),
+		user.PasswordHash,
+		user.Salt,
+		user.LastLoginAt,
+		user.LoginAttempts,
+		user.LockedUntil,
+		user.PasswordChangedAt,
+		user.DepartmentID,
+		user.RoleID,
+		user.ManagerID,
+		user.EmployeeCode,
+		user.Avatar,
+		user.DateOfBirth,
+		user.Gender,
+		user.Address,
+		user.City,
+		user.Country,
+		user.EmailVerified,
+		user.PhoneVerified,
+		user.Language,
+		user.Timezone,
+		user.NotificationPref,
 		user.UpdatedAt,
 		user.UpdatedBy,
 		user.Version,
@@ -256,7 +255,7 @@ func (r *userRepository) Delete(ctx context.Context, id uuid.UUID) error {
 		SET DELETED_AT = CURRENT_TIMESTAMP, VERSION = VERSION + 1
 		WHERE ID = :1 AND DELETED_AT IS NULL`
 
-	result, err := r.db.ExecContext(ctx, query, id.String())
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, id.String())
 	if err != nil {
 		r.logger.Error("Failed to delete user",
 			zap.String("user_id", id.String()),
@@ -281,18 +280,33 @@ func (r *userRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
-// List retrieves users with pagination
-func (r *userRepository) List(ctx context.Context, limit, offset int) ([]*entities.User, error) {
-	query := `
-		SELECT ID, USERNAME, EMAIL, FIRST_NAME, LAST_NAME, PHONE, 
-			   STATUS, CREATED_AT, UPDATED_AT, CREATED_BY, UPDATED_BY, 
-			   DELETED_AT, VERSION, TENANT_ID
+// List retrieves users matching the filter with sorting and pagination
+func (r *userRepository) List(ctx context.Context, filter *repositories.UserListFilter) ([]*entities.User, error) {
+	where, args := buildUserListWhere(filter)
+
+	var query string
+	if filter.After != nil || filter.Before != nil {
+		// Keyset pagination: seek past the cursor instead of skipping rows
+		seek, seekArgs := buildUserKeysetSeek(filter, len(args))
+		args = append(args, seekArgs...)
+		query = `
+		SELECT ` + userColumns + `
 		FROM BMSF_USER 
-		WHERE DELETED_AT IS NULL
-		ORDER BY CREATED_AT DESC
-		OFFSET :1 ROWS FETCH NEXT :2 ROWS ONLY`
+		WHERE ` + where + ` AND ` + seek + `
+		ORDER BY ` + buildUserKeysetOrderBy(filter) + fmt.Sprintf(`
+		FETCH NEXT :%d ROWS ONLY`, len(args)+1)
+		args = append(args, filter.Limit)
+	} else {
+		query = `
+		SELECT ` + userColumns + `
+		FROM BMSF_USER 
+		WHERE ` + where + `
+		ORDER BY ` + buildUserListOrderBy(filter) + fmt.Sprintf(`
+		OFFSET :%d ROWS FETCH NEXT :%d ROWS ONLY`, len(args)+1, len(args)+2)
+		args = append(args, filter.Offset, filter.Limit)
+	}
 
-	rows, err := r.db.QueryContext(ctx, query, offset, limit)
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, args...)
 	if err != nil {
 		r.logger.Error("Failed to list users",
 			zap.Error(err),
@@ -303,25 +317,7 @@ func (r *userRepository) List(ctx context.Context, limit, offset int) ([]*entiti
 
 	var users []*entities.User
 	for rows.Next() {
-		var user entities.User
-		var status string
-
-		err := rows.Scan(
-			&user.ID,
-			&user.Username,
-			&user.Email,
-			&user.FirstName,
-			&user.LastName,
-			&user.Phone,
-			&status,
-			&user.CreatedAt,
-			&user.UpdatedAt,
-			&user.CreatedBy,
-			&user.UpdatedBy,
-			&user.DeletedAt,
-			&user.Version,
-			&user.TenantID,
-		)
+		user, err := scanUser(rows)
 		if err != nil {
 			r.logger.Error("Failed to scan user row",
 				zap.Error(err),
@@ -329,8 +325,7 @@ func (r *userRepository) List(ctx context.Context, limit, offset int) ([]*entiti
 			return nil, fmt.Errorf("failed to scan user row: %w", err)
 		}
 
-		user.Status = entities.UserStatus(status)
-		users = append(users, &user)
+		users = append(users, user)
 	}
 
 	if err = rows.Err(); err != nil {
@@ -340,12 +335,13 @@ func (r *userRepository) List(ctx context.Context, limit, offset int) ([]*entiti
 	return users, nil
 }
 
-// Count returns the total number of users
-func (r *userRepository) Count(ctx context.Context) (int64, error) {
-	query := `SELECT COUNT(*) FROM BMSF_USER WHERE DELETED_AT IS NULL`
+// Count returns the total number of users matching the filter
+func (r *userRepository) Count(ctx context.Context, filter *repositories.UserListFilter) (int64, error) {
+	where, args := buildUserListWhere(filter)
+	query := `SELECT COUNT(*) FROM BMSF_USER WHERE ` + where
 
 	var count int64
-	err := r.db.QueryRowContext(ctx, query).Scan(&count)
+	err := executor(ctx, r.db).QueryRowContext(ctx, query, args...).Scan(&count)
 	if err != nil {
 		r.logger.Error("Failed to count users",
 			zap.Error(err),
@@ -364,9 +360,7 @@ func (r *userRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*enti
 
 	// For simplicity, we'll use Oracle's TABLE function for multiple IDs
 	query := `
-		SELECT ID, USERNAME, EMAIL, FIRST_NAME, LAST_NAME, PHONE, 
-			   STATUS, CREATED_AT, UPDATED_AT, CREATED_BY, UPDATED_BY, 
-			   DELETED_AT, VERSION, TENANT_ID
+		SELECT ` + userColumns + `
 		FROM BMSF_USER 
 		WHERE ID IN (SELECT COLUMN_VALUE FROM TABLE(SYS.ODCIVARCHAR2LIST(:1, :2, :3, :4, :5)))
 		AND DELETED_AT IS NULL
@@ -384,7 +378,7 @@ func (r *userRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*enti
 		idStrings[i] = ""
 	}
 
-	rows, err := r.db.QueryContext(ctx, query, idStrings[0], idStrings[1], idStrings[2], idStrings[3], idStrings[4])
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, idStrings[0], idStrings[1], idStrings[2], idStrings[3], idStrings[4])
 	if err != nil {
 		r.logger.Error("Failed to get users by IDs",
 			zap.Error(err),
@@ -395,25 +389,76 @@ func (r *userRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*enti
 
 	var users []*entities.User
 	for rows.Next() {
-		var user entities.User
-		var status string
-
-		err := rows.Scan(
-			&user.ID,
-			&user.Username,
-			&user.Email,
-			&user.FirstName,
-			&user.LastName,
-			&user.Phone,
-			&status,
-			&user.CreatedAt,
-			&user.UpdatedAt,
-			&user.CreatedBy,
-			&user.UpdatedBy,
-			&user.DeletedAt,
-			&user.Version,
-			&user.TenantID,
+		user, err := scanUser(rows)
+		if err != nil {
+			r.logger.Error("Failed to scan user row",
+				zap.Error(err),
+			)
+			return nil, fmt.Errorf("failed to scan user row: %w", err)
+		}
+
+		users = append(users, user)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating user rows: %w", err)
+	}
+
+	return users, nil
+}
+
+// ListByPermission retrieves active users whose active role effectively grants an active permission code
+func (r *userRepository) ListByPermission(ctx context.Context, permissionCode string, limit, offset int) ([]*entities.User, error) {
+	granting := entities.PermissionGrantingCodes(permissionCode)
+
+	query := `
+		SELECT ` + userColumns + `
+		FROM BMSF_USER
+		WHERE DELETED_AT IS NULL AND STATUS = :1
+		AND ROLE_ID IN (
+			SELECT ID
+			FROM BMSF_ROLE
+			START WITH DELETED_AT IS NULL AND IS_ACTIVE = :2
+			AND ID IN (
+				SELECT rp.ROLE_ID
+				FROM BMSF_ROLE_PERMISSION rp
+				JOIN BMSF_PERMISSION p ON p.ID = rp.PERMISSION_ID
+				WHERE p.DELETED_AT IS NULL AND p.IS_ACTIVE = :3
+				AND p.CODE IN (:4, :5, :6)
+			)
+			CONNECT BY NOCYCLE PRIOR ID = PARENT_ID AND DELETED_AT IS NULL AND IS_ACTIVE = :7
+		)
+		AND EXISTS (
+			SELECT 1 FROM BMSF_PERMISSION
+			WHERE CODE = :8 AND DELETED_AT IS NULL AND IS_ACTIVE = :9
+		)
+		ORDER BY USERNAME ASC, ID ASC
+		OFFSET :10 ROWS FETCH NEXT :11 ROWS ONLY`
+
+	// Granting roles and every active role inheriting from them
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query,
+		string(entities.UserStatusActive),
+		true,
+		true,
+		granting[0], granting[1], granting[2],
+		true,
+		permissionCode,
+		true,
+		offset,
+		limit,
+	)
+	if err != nil {
+		r.logger.Error("Failed to list users by permission",
+			zap.String("permission_code", permissionCode),
+			zap.Error(err),
 		)
+		return nil, fmt.Errorf("failed to list users by permission: %w", err)
+	}
+	defer rows.Close()
+
+	var users []*entities.User
+	for rows.Next() {
+		user, err := scanUser(rows)
 		if err != nil {
 			r.logger.Error("Failed to scan user row",
 				zap.Error(err),
@@ -421,8 +466,7 @@ func (r *userRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*enti
 			return nil, fmt.Errorf("failed to scan user row: %w", err)
 		}
 
-		user.Status = entities.UserStatus(status)
-		users = append(users, &user)
+		users = append(users, user)
 	}
 
 	if err = rows.Err(); err != nil {
@@ -431,3 +475,305 @@ func (r *userRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*enti
 
 	return users, nil
 }
+
+// ListIDsByRole retrieves the IDs of users whose role is the given role or inherits from it
+func (r *userRepository) ListIDsByRole(ctx context.Context, roleID uuid.UUID) ([]uuid.UUID, error) {
+	query := `
+		SELECT ID
+		FROM BMSF_USER
+		WHERE DELETED_AT IS NULL
+		AND ROLE_ID IN (
+			SELECT ID
+			FROM BMSF_ROLE
+			START WITH ID = :1
+			CONNECT BY NOCYCLE PRIOR ID = PARENT_ID AND DELETED_AT IS NULL
+		)`
+
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, roleID.String())
+	if err != nil {
+		r.logger.Error("Failed to list users by role",
+			zap.String("role_id", roleID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to list users by role: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan user ID: %w", err)
+		}
+
+		userID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid user ID %q: %w", id, err)
+		}
+		ids = append(ids, userID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate user IDs: %w", err)
+	}
+
+	return ids, nil
+}
+
+// GetManagerChain retrieves the managers of a user following MANAGER_ID, nearest first
+func (r *userRepository) GetManagerChain(ctx context.Context, id uuid.UUID) ([]*entities.User, error) {
+	query := `
+		SELECT ` + userColumns + `
+		FROM BMSF_USER
+		WHERE LEVEL > 1
+		START WITH ID = :1 AND DELETED_AT IS NULL
+		CONNECT BY NOCYCLE ID = PRIOR MANAGER_ID AND DELETED_AT IS NULL
+		ORDER BY LEVEL`
+
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, id.String())
+	if err != nil {
+		r.logger.Error("Failed to get manager chain",
+			zap.String("user_id", id.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to get manager chain: %w", err)
+	}
+
+	return r.scanUsers(rows)
+}
+
+// GetReports retrieves the users reporting to a manager following MANAGER_ID
+// Direct reports are ordered by name; transitive reports are a pre-order walk of the reporting tree.
+func (r *userRepository) GetReports(ctx context.Context, managerID uuid.UUID, transitive bool) ([]*entities.User, error) {
+	query := `
+		SELECT ` + userColumns + `
+		FROM BMSF_USER
+		WHERE MANAGER_ID = :1 AND DELETED_AT IS NULL
+		ORDER BY LAST_NAME ASC, FIRST_NAME ASC, ID ASC`
+	if transitive {
+		query = `
+		SELECT ` + userColumns + `
+		FROM BMSF_USER
+		WHERE LEVEL > 1
+		START WITH ID = :1 AND DELETED_AT IS NULL
+		CONNECT BY NOCYCLE MANAGER_ID = PRIOR ID AND DELETED_AT IS NULL
+		ORDER SIBLINGS BY LAST_NAME ASC, FIRST_NAME ASC, ID ASC`
+	}
+
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, managerID.String())
+	if err != nil {
+		r.logger.Error("Failed to get reports",
+			zap.String("manager_id", managerID.String()),
+			zap.Bool("transitive", transitive),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to get reports: %w", err)
+	}
+
+	return r.scanUsers(rows)
+}
+
+// ListOrganization retrieves every user that is not deleted, ordered by name
+func (r *userRepository) ListOrganization(ctx context.Context) ([]*entities.User, error) {
+	query := `
+		SELECT ` + userColumns + `
+		FROM BMSF_USER
+		WHERE DELETED_AT IS NULL
+		ORDER BY LAST_NAME ASC, FIRST_NAME ASC, ID ASC`
+
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query)
+	if err != nil {
+		r.logger.Error("Failed to list organization",
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to list organization: %w", err)
+	}
+
+	return r.scanUsers(rows)
+}
+
+// scanUsers scans user rows, closing the rows
+func (r *userRepository) scanUsers(rows *sql.Rows) ([]*entities.User, error) {
+	defer rows.Close()
+
+	var users []*entities.User
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			r.logger.Error("Failed to scan user row",
+				zap.Error(err),
+			)
+			return nil, fmt.Errorf("failed to scan user row: %w", err)
+		}
+
+		users = append(users, user)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating user rows: %w", err)
+	}
+
+	return users, nil
+}
+
+// scanUser scans a row selected with userColumns into a user entity
+func scanUser(row rowScanner) (*entities.User, error) {
+	var user entities.User
+	var status string
+	// Oracle stores empty strings as NULL
+	var phone, employeeCode, avatar, gender, address, city, country sql.NullString
+
+	err := row.Scan(
+		&user.ID,
+		&user.Username,
+		&user.Email,
+		&user.FirstName,
+		&user.LastName,
+		&phone,
+		&status,
+		&user.PasswordHash,
+		&user.Salt,
+		&user.LastLoginAt,
+		&user.LoginAttempts,
+		&user.LockedUntil,
+		&user.PasswordChangedAt,
+		&user.DepartmentID,
+		&user.RoleID,
+		&user.ManagerID,
+		&employeeCode,
+		&avatar,
+		&user.DateOfBirth,
+		&gender,
+		&address,
+		&city,
+		&country,
+		&user.EmailVerified,
+		&user.PhoneVerified,
+		&user.Language,
+		&user.Timezone,
+		&user.NotificationPref,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+		&user.CreatedBy,
+		&user.UpdatedBy,
+		&user.DeletedAt,
+		&user.Version,
+		&user.TenantID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	user.Status = entities.UserStatus(status)
+	user.Phone = phone.String
+	user.EmployeeCode = employeeCode.String
+	user.Avatar = avatar.String
+	user.Gender = gender.String
+	user.Address = address.String
+	user.City = city.String
+	user.Country = country.String
+	return &user, nil
+}
+
+// userSortColumns maps whitelisted sort fields to BMSF_USER columns
+var userSortColumns = map[repositories.UserSortField]string{
+	repositories.UserSortByCreatedAt:   "CREATED_AT",
+	repositories.UserSortByUpdatedAt:   "UPDATED_AT",
+	repositories.UserSortByUsername:    "USERNAME",
+	repositories.UserSortByEmail:       "EMAIL",
+	repositories.UserSortByFirstName:   "FIRST_NAME",
+	repositories.UserSortByLastName:    "LAST_NAME",
+	repositories.UserSortByStatus:      "STATUS",
+	repositories.UserSortByLastLoginAt: "LAST_LOGIN_AT",
+}
+
+// buildUserListWhere builds the WHERE clause and positional bind arguments for a user filter
+func buildUserListWhere(filter *repositories.UserListFilter) (string, []any) {
+	conditions := []string{"DELETED_AT IS NULL"}
+	var args []any
+
+	bind := func(value any) string {
+		args = append(args, value)
+		return fmt.Sprintf(":%d", len(args))
+	}
+
+	if filter.Status != nil {
+		conditions = append(conditions, "STATUS = "+bind(string(*filter.Status)))
+	}
+	if filter.DepartmentID != nil {
+		conditions = append(conditions, "DEPARTMENT_ID = "+bind(filter.DepartmentID.String()))
+	}
+	if filter.RoleID != nil {
+		conditions = append(conditions, "ROLE_ID = "+bind(filter.RoleID.String()))
+	}
+	if filter.ManagerID != nil {
+		conditions = append(conditions, "MANAGER_ID = "+bind(filter.ManagerID.String()))
+	}
+	if filter.CreatedFrom != nil {
+		conditions = append(conditions, "CREATED_AT >= "+bind(*filter.CreatedFrom))
+	}
+	if filter.CreatedTo != nil {
+		conditions = append(conditions, "CREATED_AT <= "+bind(*filter.CreatedTo))
+	}
+	if search := strings.TrimSpace(filter.Search); search != "" {
+		pattern := "%" + escapeLike(strings.ToLower(search)) + "%"
+		conditions = append(conditions, fmt.Sprintf(
+			`(LOWER(USERNAME) LIKE %s ESCAPE '\' OR LOWER(EMAIL) LIKE %s ESCAPE '\' OR LOWER(FIRST_NAME || ' ' || LAST_NAME) LIKE %s ESCAPE '\')`,
+			bind(pattern), bind(pattern), bind(pattern),
+		))
+	}
+
+	return strings.Join(conditions, " AND "), args
+}
+
+// buildUserListOrderBy builds the ORDER BY clause, using ID as a tie-breaker for stable paging
+func buildUserListOrderBy(filter *repositories.UserListFilter) string {
+	column, direction := "CREATED_AT", "ASC"
+	if mapped, ok := userSortColumns[filter.SortBy]; ok {
+		column = mapped
+	}
+	if filter.SortDesc {
+		direction = "DESC"
+	}
+	return column + " " + direction + ", ID " + direction
+}
+
+// buildUserKeysetSeek builds the (CREATED_AT, ID) seek predicate for a keyset cursor
+// Bind placeholders are numbered after the given number of existing arguments.
+func buildUserKeysetSeek(filter *repositories.UserListFilter, argCount int) (string, []any) {
+	cursor, forward := filter.After, true
+	if cursor == nil {
+		cursor, forward = filter.Before, false
+	}
+
+	// Moving forward through a descending listing means seeking smaller keys
+	operator := ">"
+	if forward == filter.SortDesc {
+		operator = "<"
+	}
+
+	seek := fmt.Sprintf("(CREATED_AT %[1]s :%[2]d OR (CREATED_AT = :%[3]d AND ID %[1]s :%[4]d))",
+		operator, argCount+1, argCount+2, argCount+3)
+	return seek, []any{cursor.CreatedAt, cursor.CreatedAt, cursor.ID.String()}
+}
+
+// buildUserKeysetOrderBy builds the ORDER BY clause for keyset pagination
+// Backward pages are read nearest-first, so the order is inverted.
+func buildUserKeysetOrderBy(filter *repositories.UserListFilter) string {
+	desc := filter.SortDesc
+	if filter.After == nil {
+		desc = !desc
+	}
+
+	direction := "ASC"
+	if desc {
+		direction = "DESC"
+	}
+	return "CREATED_AT " + direction + ", ID " + direction
+}
+
+// escapeLike escapes LIKE wildcards so user input is matched literally
+func escapeLike(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+	return replacer.Replace(value)
+}
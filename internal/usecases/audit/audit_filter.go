@@ -0,0 +1,132 @@
+package audit
+
+import (
+	stderrors "errors"
+	"time"
+
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/pkg/errors"
+	"bm-staff/pkg/pagination"
+
+	"github.com/google/uuid"
+)
+
+// AuditLogFilterRequest holds the audit log filters shared by listing and export
+type AuditLogFilterRequest struct {
+	UserID     string `form:"user_id" validate:"omitempty,uuid"`
+	Resource   string `form:"resource" validate:"omitempty,max=100"`
+	ResourceID string `form:"resource_id" validate:"omitempty,uuid"`
+	Action     string `form:"action" validate:"omitempty,max=100"`
+	From       string `form:"from" validate:"omitempty"` // RFC3339
+	To         string `form:"to" validate:"omitempty"`   // RFC3339
+	SortOrder  string `form:"sort_order" validate:"omitempty,oneof=asc desc"`
+}
+
+// auditLogCursorToken is the signed payload of an audit log listing cursor
+type auditLogCursorToken struct {
+	Timestamp time.Time `json:"t"`
+	ID        uuid.UUID `json:"i"`
+}
+
+// auditLogCursorScope holds the filters and sort order an audit log listing cursor is bound to
+type auditLogCursorScope struct {
+	UserID     *uuid.UUID `json:"u,omitempty"`
+	Resource   string     `json:"r,omitempty"`
+	ResourceID *uuid.UUID `json:"ri,omitempty"`
+	Action     string     `json:"ac,omitempty"`
+	From       *time.Time `json:"f,omitempty"`
+	To         *time.Time `json:"to,omitempty"`
+	Ascending  bool       `json:"a,omitempty"`
+}
+
+// newAuditLogCursorScope captures the parts of a filter that determine the listing order
+func newAuditLogCursorScope(filter *repositories.AuditLogFilter) auditLogCursorScope {
+	return auditLogCursorScope{
+		UserID:     filter.UserID,
+		Resource:   filter.Resource,
+		ResourceID: filter.ResourceID,
+		Action:     filter.Action,
+		From:       filter.From,
+		To:         filter.To,
+		Ascending:  filter.Ascending,
+	}
+}
+
+// buildAuditLogFilter converts request filters into a repository filter, newest first by default
+func buildAuditLogFilter(req *AuditLogFilterRequest) (*repositories.AuditLogFilter, error) {
+	filter := &repositories.AuditLogFilter{
+		Resource:  req.Resource,
+		Action:    req.Action,
+		Ascending: req.SortOrder == "asc",
+	}
+
+	var err error
+	if filter.UserID, err = parseOptionalUUID("user_id", req.UserID); err != nil {
+		return nil, err
+	}
+	if filter.ResourceID, err = parseOptionalUUID("resource_id", req.ResourceID); err != nil {
+		return nil, err
+	}
+	if filter.From, err = parseOptionalTime("from", req.From); err != nil {
+		return nil, err
+	}
+	if filter.To, err = parseOptionalTime("to", req.To); err != nil {
+		return nil, err
+	}
+	if filter.From != nil && filter.To != nil && filter.From.After(*filter.To) {
+		return nil, errors.NewValidationError("VAL_003", "from must be before to", nil)
+	}
+
+	return filter, nil
+}
+
+// applyCursor continues a keyset-paginated listing from a signed cursor token
+func applyCursor(cursorCodec *pagination.CursorCodec, filter *repositories.AuditLogFilter, cursor string) error {
+	var token auditLogCursorToken
+	if err := cursorCodec.Decode(cursor, newAuditLogCursorScope(filter), &token); err != nil {
+		if stderrors.Is(err, pagination.ErrCursorMismatch) {
+			return errors.NewValidationError("VAL_002", "Cursor does not match the listing filters or sort order", nil)
+		}
+		return errors.NewValidationError("VAL_002", "Invalid cursor", nil)
+	}
+
+	filter.After = &repositories.AuditLogCursor{Timestamp: token.Timestamp, ID: token.ID}
+	return nil
+}
+
+// nextCursor encodes the position after the last record of a page
+func nextCursor(cursorCodec *pagination.CursorCodec, filter *repositories.AuditLogFilter, timestamp time.Time, id uuid.UUID) (string, error) {
+	next, err := cursorCodec.Encode(newAuditLogCursorScope(filter), auditLogCursorToken{Timestamp: timestamp, ID: id})
+	if err != nil {
+		return "", errors.WrapError(err, "SYS_001", "Failed to encode cursor")
+	}
+	return next, nil
+}
+
+// parseOptionalUUID parses a UUID query value, returning nil when empty
+func parseOptionalUUID(field, value string) (*uuid.UUID, error) {
+	if value == "" {
+		return nil, nil
+	}
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil, errors.NewValidationError("VAL_002", "Invalid "+field+" format", map[string]any{
+			field: value,
+		})
+	}
+	return &id, nil
+}
+
+// parseOptionalTime parses an RFC3339 query value, returning nil when empty
+func parseOptionalTime(field, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, errors.NewValidationError("VAL_002", "Invalid "+field+" format, expected RFC3339", map[string]any{
+			field: value,
+		})
+	}
+	return &t, nil
+}
@@ -0,0 +1,62 @@
+package audit
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+// FieldChange describes how one field of an audited resource changed
+// Old is omitted for created fields and New for removed ones.
+type FieldChange struct {
+	Field string `json:"field"`
+	Old   any    `json:"old,omitempty"`
+	New   any    `json:"new,omitempty"`
+}
+
+// diffSnapshots compares the JSON before/after snapshots of an audit record field by field,
+// in field name order. Empty snapshots are treated as objects without fields.
+func diffSnapshots(oldValues, newValues string) ([]FieldChange, error) {
+	oldFields, err := decodeSnapshot(oldValues)
+	if err != nil {
+		return nil, err
+	}
+	newFields, err := decodeSnapshot(newValues)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]string, 0, len(oldFields)+len(newFields))
+	for field := range oldFields {
+		fields = append(fields, field)
+	}
+	for field := range newFields {
+		if _, ok := oldFields[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+
+	changes := []FieldChange{}
+	for _, field := range fields {
+		oldValue, newValue := oldFields[field], newFields[field]
+		if reflect.DeepEqual(oldValue, newValue) {
+			continue
+		}
+		changes = append(changes, FieldChange{Field: field, Old: oldValue, New: newValue})
+	}
+
+	return changes, nil
+}
+
+// decodeSnapshot decodes a JSON object snapshot
+func decodeSnapshot(snapshot string) (map[string]any, error) {
+	fields := map[string]any{}
+	if snapshot == "" {
+		return fields, nil
+	}
+	if err := json.Unmarshal([]byte(snapshot), &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
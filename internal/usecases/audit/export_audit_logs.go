@@ -0,0 +1,142 @@
+package audit
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// Audit log export formats
+const (
+	ExportFormatCSV    = "csv"
+	ExportFormatNDJSON = "ndjson"
+)
+
+// csvHeader is the header row of CSV exports
+var csvHeader = []string{
+	"id", "timestamp", "user_id", "action", "resource", "resource_id",
+	"ip_address", "user_agent", "session_id", "old_values", "new_values",
+}
+
+// ExportAuditLogsRequest represents the request to export audit log records
+type ExportAuditLogsRequest struct {
+	AuditLogFilterRequest
+	Format string `form:"format" validate:"omitempty,oneof=csv ndjson"`
+}
+
+// ExportAuditLogsResponse is a prepared export, written by Write
+type ExportAuditLogsResponse struct {
+	Format      string
+	ContentType string
+	FileName    string
+
+	auditLogRepo repositories.AuditLogRepository
+	filter       *repositories.AuditLogFilter
+}
+
+// ExportAuditLogsUseCase handles audit log export business logic
+type ExportAuditLogsUseCase struct {
+	auditLogRepo repositories.AuditLogRepository
+}
+
+// NewExportAuditLogsUseCase creates a new export audit logs use case
+func NewExportAuditLogsUseCase(auditLogRepo repositories.AuditLogRepository) *ExportAuditLogsUseCase {
+	return &ExportAuditLogsUseCase{
+		auditLogRepo: auditLogRepo,
+	}
+}
+
+// Execute validates the export filters; records are only read once the response is written
+func (uc *ExportAuditLogsUseCase) Execute(ctx context.Context, req *ExportAuditLogsRequest) (*ExportAuditLogsResponse, error) {
+	filter, err := buildAuditLogFilter(&req.AuditLogFilterRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ExportAuditLogsResponse{
+		Format:       req.Format,
+		auditLogRepo: uc.auditLogRepo,
+		filter:       filter,
+	}
+	if resp.Format == "" {
+		resp.Format = ExportFormatCSV
+	}
+
+	fileName := "audit-logs-" + time.Now().UTC().Format("20060102T150405Z")
+	switch resp.Format {
+	case ExportFormatCSV:
+		resp.ContentType = "text/csv; charset=utf-8"
+		resp.FileName = fileName + ".csv"
+	case ExportFormatNDJSON:
+		resp.ContentType = "application/x-ndjson"
+		resp.FileName = fileName + ".ndjson"
+	default:
+		return nil, errors.NewValidationError("VAL_002", "Invalid export format", map[string]any{
+			"format": req.Format,
+		})
+	}
+
+	return resp, nil
+}
+
+// Write streams the matching audit log records to w, one row at a time
+func (r *ExportAuditLogsResponse) Write(ctx context.Context, w io.Writer) error {
+	if r.Format == ExportFormatNDJSON {
+		encoder := json.NewEncoder(w)
+		return r.auditLogRepo.Stream(ctx, r.filter, func(auditLog *entities.AuditLog) error {
+			return encoder.Encode(auditLog)
+		})
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+
+	err := r.auditLogRepo.Stream(ctx, r.filter, func(auditLog *entities.AuditLog) error {
+		return writer.Write([]string{
+			auditLog.ID.String(),
+			auditLog.Timestamp.UTC().Format(time.RFC3339Nano),
+			optionalUUID(auditLog.UserID),
+			csvCell(auditLog.Action),
+			csvCell(auditLog.Resource),
+			optionalUUID(auditLog.ResourceID),
+			csvCell(auditLog.IPAddress),
+			csvCell(auditLog.UserAgent),
+			csvCell(auditLog.SessionID),
+			csvCell(auditLog.OldValues),
+			csvCell(auditLog.NewValues),
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// optionalUUID formats an optional UUID, empty when nil
+func optionalUUID(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+// csvCell neutralizes values spreadsheets would evaluate as formulas
+func csvCell(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
@@ -0,0 +1,124 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/pkg/errors"
+	"bm-staff/pkg/pagination"
+
+	"github.com/google/uuid"
+)
+
+// GetResourceHistoryRequest represents the request to get the change history of a resource
+type GetResourceHistoryRequest struct {
+	Resource string `json:"-"`
+	ID       string `json:"id" validate:"required,uuid"`
+	Limit    int    `form:"limit" validate:"omitempty,min=1,max=100"`
+	Cursor   string `form:"cursor" validate:"omitempty,max=512"`
+}
+
+// HistoryEntry is one audited change of a resource with its field-level diff
+type HistoryEntry struct {
+	ID        uuid.UUID     `json:"id"`
+	Action    string        `json:"action"`
+	UserID    *uuid.UUID    `json:"user_id,omitempty"`
+	IPAddress string        `json:"ip_address"`
+	UserAgent string        `json:"user_agent"`
+	SessionID string        `json:"session_id"`
+	Timestamp time.Time     `json:"timestamp"`
+	Changes   []FieldChange `json:"changes"`
+}
+
+// GetResourceHistoryResponse represents the response after getting the change history of a resource
+type GetResourceHistoryResponse struct {
+	History    []*HistoryEntry `json:"history"`
+	Pagination pagination.Page `json:"pagination"`
+}
+
+// GetResourceHistoryUseCase handles resource history business logic
+type GetResourceHistoryUseCase struct {
+	auditLogRepo repositories.AuditLogRepository
+	cursorCodec  *pagination.CursorCodec
+}
+
+// NewGetResourceHistoryUseCase creates a new get resource history use case
+func NewGetResourceHistoryUseCase(auditLogRepo repositories.AuditLogRepository, cursorCodec *pagination.CursorCodec) *GetResourceHistoryUseCase {
+	return &GetResourceHistoryUseCase{
+		auditLogRepo: auditLogRepo,
+		cursorCodec:  cursorCodec,
+	}
+}
+
+// Execute returns the audited changes of a resource, newest first
+// The history remains available after the resource itself is deleted.
+func (uc *GetResourceHistoryUseCase) Execute(ctx context.Context, req *GetResourceHistoryRequest) (*GetResourceHistoryResponse, error) {
+	resourceID, err := uuid.Parse(req.ID)
+	if err != nil {
+		return nil, errors.NewValidationError("VAL_002", "Invalid "+req.Resource+" ID format", map[string]any{
+			"id": req.ID,
+		})
+	}
+
+	filter := &repositories.AuditLogFilter{
+		Resource:   req.Resource,
+		ResourceID: &resourceID,
+	}
+
+	total, err := uc.auditLogRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to count audit logs")
+	}
+
+	if req.Cursor != "" {
+		if err := applyCursor(uc.cursorCodec, filter, req.Cursor); err != nil {
+			return nil, err
+		}
+	}
+
+	limit, _ := pagination.Normalize(req.Limit, 0)
+
+	// Fetch one extra row to know whether another page exists
+	filter.Limit = limit + 1
+	auditLogs, err := uc.auditLogRepo.List(ctx, filter)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to list audit logs")
+	}
+
+	page := pagination.Page{
+		Limit: limit,
+		Total: total,
+	}
+	if len(auditLogs) > limit {
+		auditLogs = auditLogs[:limit]
+		last := auditLogs[len(auditLogs)-1]
+		if page.NextCursor, err = nextCursor(uc.cursorCodec, filter, last.Timestamp, last.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	history := make([]*HistoryEntry, 0, len(auditLogs))
+	for _, auditLog := range auditLogs {
+		changes, err := diffSnapshots(auditLog.OldValues, auditLog.NewValues)
+		if err != nil {
+			return nil, errors.WrapError(err, "SYS_001", "Failed to decode audit log snapshot")
+		}
+
+		history = append(history, &HistoryEntry{
+			ID:        auditLog.ID,
+			Action:    auditLog.Action,
+			UserID:    auditLog.UserID,
+			IPAddress: auditLog.IPAddress,
+			UserAgent: auditLog.UserAgent,
+			SessionID: auditLog.SessionID,
+			Timestamp: auditLog.Timestamp,
+			Changes:   changes,
+		})
+	}
+
+	return &GetResourceHistoryResponse{
+		History:    history,
+		Pagination: page,
+	}, nil
+}
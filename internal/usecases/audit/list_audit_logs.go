@@ -0,0 +1,87 @@
+package audit
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/pkg/errors"
+	"bm-staff/pkg/pagination"
+)
+
+// ListAuditLogsRequest represents the request to list audit log records
+type ListAuditLogsRequest struct {
+	AuditLogFilterRequest
+	Limit  int    `form:"limit" validate:"omitempty,min=1,max=100"`
+	Cursor string `form:"cursor" validate:"omitempty,max=512"`
+}
+
+// ListAuditLogsResponse represents the response after listing audit log records
+type ListAuditLogsResponse struct {
+	AuditLogs  []*entities.AuditLog `json:"audit_logs"`
+	Pagination pagination.Page      `json:"pagination"`
+}
+
+// ListAuditLogsUseCase handles audit log listing business logic
+type ListAuditLogsUseCase struct {
+	auditLogRepo repositories.AuditLogRepository
+	cursorCodec  *pagination.CursorCodec
+}
+
+// NewListAuditLogsUseCase creates a new list audit logs use case
+func NewListAuditLogsUseCase(auditLogRepo repositories.AuditLogRepository, cursorCodec *pagination.CursorCodec) *ListAuditLogsUseCase {
+	return &ListAuditLogsUseCase{
+		auditLogRepo: auditLogRepo,
+		cursorCodec:  cursorCodec,
+	}
+}
+
+// Execute lists audit log records matching the request filters, one keyset page at a time
+func (uc *ListAuditLogsUseCase) Execute(ctx context.Context, req *ListAuditLogsRequest) (*ListAuditLogsResponse, error) {
+	filter, err := buildAuditLogFilter(&req.AuditLogFilterRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the total honouring the same filters, before the cursor narrows them
+	total, err := uc.auditLogRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to count audit logs")
+	}
+
+	if req.Cursor != "" {
+		if err := applyCursor(uc.cursorCodec, filter, req.Cursor); err != nil {
+			return nil, err
+		}
+	}
+
+	limit, _ := pagination.Normalize(req.Limit, 0)
+
+	// Fetch one extra row to know whether another page exists
+	filter.Limit = limit + 1
+	auditLogs, err := uc.auditLogRepo.List(ctx, filter)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to list audit logs")
+	}
+
+	page := pagination.Page{
+		Limit: limit,
+		Total: total,
+	}
+	if len(auditLogs) > limit {
+		auditLogs = auditLogs[:limit]
+		last := auditLogs[len(auditLogs)-1]
+		if page.NextCursor, err = nextCursor(uc.cursorCodec, filter, last.Timestamp, last.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	if auditLogs == nil {
+		auditLogs = []*entities.AuditLog{}
+	}
+
+	return &ListAuditLogsResponse{
+		AuditLogs:  auditLogs,
+		Pagination: page,
+	}, nil
+}
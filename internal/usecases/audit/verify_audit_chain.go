@@ -0,0 +1,40 @@
+package audit
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/errors"
+)
+
+// VerifyAuditChainRequest represents the request to verify an audit hash chain
+type VerifyAuditChainRequest struct {
+	TenantID string `form:"tenant_id" validate:"omitempty,uuid"` // records without a tenant when empty
+}
+
+// VerifyAuditChainUseCase handles audit chain verification business logic
+type VerifyAuditChainUseCase struct {
+	auditChainService *services.AuditChainService
+}
+
+// NewVerifyAuditChainUseCase creates a new verify audit chain use case
+func NewVerifyAuditChainUseCase(auditChainService *services.AuditChainService) *VerifyAuditChainUseCase {
+	return &VerifyAuditChainUseCase{
+		auditChainService: auditChainService,
+	}
+}
+
+// Execute walks the hash chain of a tenant and reports the first broken link
+func (uc *VerifyAuditChainUseCase) Execute(ctx context.Context, req *VerifyAuditChainRequest) (*services.AuditChainVerification, error) {
+	tenantID, err := parseOptionalUUID("tenant_id", req.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	verification, err := uc.auditChainService.Verify(ctx, tenantID)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to verify audit chain")
+	}
+
+	return verification, nil
+}
@@ -0,0 +1,17 @@
+package auth
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/errors"
+)
+
+// recordAudit writes the audit record of an authentication event, inside the caller's transaction
+func recordAudit(ctx context.Context, auditRecorder *services.AuditRecorder, entry services.AuditEntry) error {
+	if err := auditRecorder.Record(ctx, entry); err != nil {
+		return errors.WrapError(err, "SYS_001", "Failed to record audit log")
+	}
+
+	return nil
+}
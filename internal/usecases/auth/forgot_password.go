@@ -0,0 +1,139 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/errors"
+)
+
+// forgotPasswordMessage is returned whether or not the email belongs to an account, so responses do not reveal users
+const forgotPasswordMessage = "If the email belongs to an active account, a password reset link has been sent to it"
+
+// ForgotPasswordRequest represents the request to start a password reset
+type ForgotPasswordRequest struct {
+	Email string `json:"email" validate:"required,email,max=100"`
+}
+
+// ForgotPasswordResponse represents the response after requesting a password reset
+type ForgotPasswordResponse struct {
+	Message string `json:"message"`
+}
+
+// ForgotPasswordUseCase issues password reset tokens and emails the reset link
+type ForgotPasswordUseCase struct {
+	userRepo        repositories.UserRepository
+	resetTokenRepo  repositories.PasswordResetTokenRepository
+	passwordService *services.PasswordService
+	mailSender      services.MailSender
+	transactor      repositories.Transactor
+	auditRecorder   *services.AuditRecorder
+	tokenExpiry     time.Duration
+	resetURL        string
+}
+
+// NewForgotPasswordUseCase creates a new forgot password use case
+// Reset links point to resetURL with the token appended as the token query parameter.
+func NewForgotPasswordUseCase(
+	userRepo repositories.UserRepository,
+	resetTokenRepo repositories.PasswordResetTokenRepository,
+	passwordService *services.PasswordService,
+	mailSender services.MailSender,
+	transactor repositories.Transactor,
+	auditRecorder *services.AuditRecorder,
+	tokenExpiry time.Duration,
+	resetURL string,
+) *ForgotPasswordUseCase {
+	return &ForgotPasswordUseCase{
+		userRepo:        userRepo,
+		resetTokenRepo:  resetTokenRepo,
+		passwordService: passwordService,
+		mailSender:      mailSender,
+		transactor:      transactor,
+		auditRecorder:   auditRecorder,
+		tokenExpiry:     tokenExpiry,
+		resetURL:        resetURL,
+	}
+}
+
+// Execute emails a reset link to an active account with the email
+// Earlier outstanding reset tokens of the account stop working. Unknown, inactive and known
+// accounts all get the same response.
+func (uc *ForgotPasswordUseCase) Execute(ctx context.Context, req *ForgotPasswordRequest, ipAddress, userAgent string) (*ForgotPasswordResponse, error) {
+	response := &ForgotPasswordResponse{Message: forgotPasswordMessage}
+
+	user, err := uc.userRepo.GetByEmail(ctx, req.Email)
+	if err != nil || user == nil || !user.IsActive() {
+		return response, nil
+	}
+
+	token, err := uc.passwordService.GenerateResetToken()
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to generate reset token")
+	}
+
+	link, err := uc.resetLink(token)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to build reset link")
+	}
+
+	resetToken := entities.NewPasswordResetToken(user.ID, token, time.Now().Add(uc.tokenExpiry), ipAddress, userAgent)
+
+	// Replace outstanding reset tokens and record the request in one transaction
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.resetTokenRepo.InvalidateAllForUser(ctx, user.ID.String()); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to invalidate reset tokens")
+		}
+
+		if err := uc.resetTokenRepo.Create(ctx, resetToken); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to save reset token")
+		}
+
+		return recordAudit(ctx, uc.auditRecorder, services.AuditEntry{
+			Action:     services.AuditActionPasswordResetRequest,
+			Resource:   services.AuditResourcePasswordResetTokens,
+			ResourceID: &resetToken.ID,
+			UserID:     &user.ID,
+			NewValues:  services.Snapshot(resetToken),
+			IPAddress:  ipAddress,
+			UserAgent:  userAgent,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.mailSender.Send(ctx, services.MailMessage{
+		To:      []string{user.Email},
+		Subject: "Reset your BM Staff password",
+		Body: fmt.Sprintf("Hello %s,\n\n"+
+			"A password reset was requested for your BM Staff account %s.\n"+
+			"Open the link below within %s to choose a new password:\n\n"+
+			"%s\n\n"+
+			"The link works once. If you did not request a reset, ignore this email and your password stays unchanged.\n",
+			user.GetFullName(), user.Username, uc.tokenExpiry, link),
+	})
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to send password reset email")
+	}
+
+	return response, nil
+}
+
+// resetLink appends the token to the configured reset page URL
+func (uc *ForgotPasswordUseCase) resetLink(token string) (string, error) {
+	link, err := url.Parse(uc.resetURL)
+	if err != nil {
+		return "", err
+	}
+
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+	return link.String(), nil
+}
@@ -0,0 +1,24 @@
+package auth
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/services"
+)
+
+// GetJWKSUseCase publishes the public keys access and refresh tokens can be verified with
+type GetJWKSUseCase struct {
+	jwtService *services.JWTService
+}
+
+// NewGetJWKSUseCase creates a new get JWKS use case
+func NewGetJWKSUseCase(jwtService *services.JWTService) *GetJWKSUseCase {
+	return &GetJWKSUseCase{
+		jwtService: jwtService,
+	}
+}
+
+// Execute returns the JSON Web Key Set; it is empty while tokens are signed with the HMAC secret
+func (uc *GetJWKSUseCase) Execute(ctx context.Context) *services.JWKSet {
+	return uc.jwtService.JWKS()
+}
@@ -2,12 +2,16 @@ package auth
 
 import (
 	"context"
+	stderrors "errors"
+	"math"
 	"time"
 
 	"bm-staff/internal/domain/entities"
 	"bm-staff/internal/domain/repositories"
 	"bm-staff/internal/domain/services"
 	"bm-staff/pkg/errors"
+
+	"go.uber.org/zap"
 )
 
 // LoginRequest represents the request to login
@@ -17,10 +21,25 @@ type LoginRequest struct {
 }
 
 // LoginResponse represents the response after login
+// Users with two-factor authentication get an MFAToken, users whose password expired a PasswordChangeToken
+// instead of Tokens; ExpiresIn is then the lifetime of that token and Scope the scope of a PasswordChangeToken.
+// User is only set with Tokens, so neither token describes the account before the login completes.
 type LoginResponse struct {
-	User      *entities.User      `json:"user"`
-	Tokens    *services.TokenPair `json:"tokens"`
-	ExpiresIn int64               `json:"expires_in"`
+	User                *entities.User      `json:"user,omitempty"`
+	Tokens              *services.TokenPair `json:"tokens,omitempty"`
+	ExpiresIn           int64               `json:"expires_in"`
+	MFAToken            string              `json:"mfa_token,omitempty"`
+	PasswordChangeToken string              `json:"password_change_token,omitempty"`
+	Scope               string              `json:"scope,omitempty"`
+
+	// RecoveryCodesLeft is set when the second step used a recovery code
+	RecoveryCodesLeft *int `json:"recovery_codes_left,omitempty"`
+}
+
+// LoginMFARequest represents the second login step, exchanging the MFA token and a code for tokens
+type LoginMFARequest struct {
+	MFAToken string `json:"mfa_token" validate:"required"`
+	Code     string `json:"code" validate:"required,min=6,max=20"` // Authenticator or recovery code
 }
 
 // LoginUseCase handles user login business logic
@@ -29,51 +48,299 @@ type LoginUseCase struct {
 	refreshTokenRepo repositories.RefreshTokenRepository
 	passwordService  *services.PasswordService
 	jwtService       *services.JWTService
+	loginThrottle    *services.LoginThrottle
+	passwordPolicy   *services.PasswordPolicy
+	mfaService       *services.MFAService
+	tokenRevoker     *services.TokenRevoker
+	mfaThrottle      *services.Throttle
+	transactor       repositories.Transactor
+	auditRecorder    *services.AuditRecorder
+	logger           *zap.Logger
+	mfaTokenExpiry   time.Duration
+
+	passwordChangeTokenExpiry time.Duration
 }
 
 // NewLoginUseCase creates a new login use case
+// loginThrottle limits failed passwords and codes per client IP and username and decides account lockouts.
+// MFA tokens expire after mfaTokenExpiry, mfaThrottle limits code entries per user.
+// Users whose password expired under passwordPolicy get a token for changing it, valid for passwordChangeTokenExpiry.
 func NewLoginUseCase(
 	userRepo repositories.UserRepository,
 	refreshTokenRepo repositories.RefreshTokenRepository,
 	passwordService *services.PasswordService,
 	jwtService *services.JWTService,
+	loginThrottle *services.LoginThrottle,
+	passwordPolicy *services.PasswordPolicy,
+	mfaService *services.MFAService,
+	tokenRevoker *services.TokenRevoker,
+	mfaThrottle *services.Throttle,
+	transactor repositories.Transactor,
+	auditRecorder *services.AuditRecorder,
+	logger *zap.Logger,
+	mfaTokenExpiry time.Duration,
+	passwordChangeTokenExpiry time.Duration,
 ) *LoginUseCase {
 	return &LoginUseCase{
 		userRepo:         userRepo,
 		refreshTokenRepo: refreshTokenRepo,
 		passwordService:  passwordService,
 		jwtService:       jwtService,
+		loginThrottle:    loginThrottle,
+		passwordPolicy:   passwordPolicy,
+		mfaService:       mfaService,
+		tokenRevoker:     tokenRevoker,
+		mfaThrottle:      mfaThrottle,
+		transactor:       transactor,
+		auditRecorder:    auditRecorder,
+		logger:           logger,
+		mfaTokenExpiry:   mfaTokenExpiry,
+
+		passwordChangeTokenExpiry: passwordChangeTokenExpiry,
 	}
 }
 
 // Execute performs user login
+// Unknown usernames and wrong passwords get the same answer in about the same time, and locked
+// accounts the same answer as throttled usernames, so responses do not reveal which accounts exist.
 func (uc *LoginUseCase) Execute(ctx context.Context, req *LoginRequest, ipAddress, userAgent string) (*LoginResponse, error) {
+	invalidCredentials := errors.NewValidationError("AUTH_001", "Invalid credentials", nil)
+
+	// Refuse throttled clients and usernames before checking any password
+	wait, err := uc.loginThrottle.Check(ctx, ipAddress, req.Username)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to check login attempts")
+	}
+	if wait > 0 {
+		return nil, tooManyFailedLogins(wait)
+	}
+
 	// Get user by username
 	user, err := uc.userRepo.GetByUsername(ctx, req.Username)
-	if err != nil {
-		return nil, errors.NewValidationError("AUTH_001", "Invalid credentials", nil)
+	if err != nil || user == nil {
+		uc.passwordService.SimulateVerify(req.Password)
+		uc.recordFailure(ctx, ipAddress, req.Username)
+		return nil, invalidCredentials
 	}
 
-	// Check if user is locked
+	// Check if user is locked, after a password check as long as for unknown usernames
 	if user.IsLocked() {
-		return nil, errors.NewValidationError("AUTH_002", "Account is locked due to too many failed login attempts", map[string]any{
-			"locked_until": user.LockedUntil,
+		uc.passwordService.SimulateVerify(req.Password)
+		return nil, accountLocked(user)
+	}
+
+	// Verify password
+	before := services.Snapshot(user)
+	if !uc.passwordService.VerifyPassword(req.Password, user.PasswordHash, user.Salt) {
+		counted := uc.recordFailure(ctx, ipAddress, req.Username)
+
+		// Record failed login attempt, locking the account as the throttle's policy decides
+		user.RecordFailedLogin(uc.loginThrottle.AccountLockout(), nil) // No updatedBy for failed login
+		err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			if err := uc.userRepo.Update(ctx, user); err != nil {
+				return err
+			}
+
+			return uc.auditRecorder.Record(ctx, services.AuditEntry{
+				Action:     services.AuditActionLoginFailed,
+				Resource:   services.AuditResourceUsers,
+				ResourceID: &user.ID,
+				UserID:     &user.ID,
+				OldValues:  before,
+				NewValues:  services.Snapshot(user),
+				IPAddress:  ipAddress,
+				UserAgent:  userAgent,
+			})
 		})
+		if err != nil {
+			uc.logger.Error("Failed to record failed login",
+				zap.String("user_id", user.ID.String()),
+				zap.Error(err),
+			)
+			// Without the lockout counter only the throttle limits further guesses, refuse if it did not count either
+			if !counted {
+				return nil, errors.WrapError(err, "SYS_001", "Failed to record failed login")
+			}
+		}
+		return nil, invalidCredentials
 	}
 
-	// Check if user is active
+	// Check if user is active, only once the password is known to be right
 	if !user.IsActive() {
 		return nil, errors.NewValidationError("AUTH_003", "Account is not active", nil)
 	}
 
-	// Verify password
-	if !uc.passwordService.VerifyPassword(req.Password, user.PasswordHash, user.Salt) {
-		// Record failed login attempt
-		user.RecordFailedLogin(nil) // No updatedBy for failed login
-		if err := uc.userRepo.Update(ctx, user); err != nil {
-			// Log error but don't expose it
+	// Upgrade legacy SHA-256 or outdated hashes while the plaintext password is at hand,
+	// the new hash is persisted together with the login or challenge record below
+	rehashed := false
+	if uc.passwordService.NeedsRehash(user.PasswordHash) {
+		if passwordHash, salt, err := uc.passwordService.HashPassword(req.Password); err == nil {
+			user.SetPassword(passwordHash, salt, nil)
+			rehashed = true
 		}
-		return nil, errors.NewValidationError("AUTH_001", "Invalid credentials", nil)
+	}
+
+	// Users with two-factor authentication get a challenge instead of tokens
+	mfaRequired, err := uc.mfaService.IsRequired(ctx, user.ID)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to check two-factor authentication")
+	}
+	if mfaRequired {
+		return uc.challenge(ctx, user, before, rehashed, ipAddress, userAgent)
+	}
+
+	return uc.complete(ctx, user, before, ipAddress, userAgent)
+}
+
+// CompleteMFA performs the second login step, exchanging an MFA token and a code for tokens
+func (uc *LoginUseCase) CompleteMFA(ctx context.Context, req *LoginMFARequest, ipAddress, userAgent string) (*LoginResponse, error) {
+	invalidToken := errors.NewValidationError("AUTH_001", "Invalid or expired MFA token", nil)
+
+	claims, err := uc.jwtService.ValidateMFAChallengeToken(req.MFAToken)
+	if err != nil {
+		return nil, invalidToken
+	}
+
+	// Used tokens are denied like revoked access tokens
+	revoked, err := uc.tokenRevoker.IsRevoked(ctx, claims)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to check MFA token")
+	}
+	if revoked {
+		return nil, invalidToken
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, claims.UserID)
+	if err != nil || user == nil {
+		return nil, invalidToken
+	}
+
+	// Code entries count against the client IP and username like passwords
+	wait, err := uc.loginThrottle.Check(ctx, ipAddress, user.Username)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to check login attempts")
+	}
+	if wait > 0 {
+		return nil, tooManyFailedLogins(wait)
+	}
+
+	if allowed, wait := uc.mfaThrottle.Allow(claims.UserID.String()); !allowed {
+		return nil, errors.NewBusinessError("BIZ_003", "Too many authentication codes entered, try again later", map[string]any{
+			"retry_after": int(math.Ceil(wait.Seconds())),
+		})
+	}
+
+	// The account may have changed since the password was checked
+	if user.IsLocked() {
+		return nil, accountLocked(user)
+	}
+	if !user.IsActive() {
+		return nil, errors.NewValidationError("AUTH_003", "Account is not active", nil)
+	}
+
+	mfa, enrolled, err := uc.mfaService.Enrollment(ctx, user.ID)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to check two-factor authentication")
+	}
+	if !enrolled || !mfa.IsConfirmed() {
+		return nil, invalidToken
+	}
+
+	before := services.Snapshot(user)
+	method, err := uc.mfaService.VerifyCode(ctx, mfa, req.Code)
+	if stderrors.Is(err, services.ErrInvalidMFACode) {
+		// Count and record the failed code, failures must not hide the login error
+		uc.recordFailure(ctx, ipAddress, user.Username)
+		_ = recordAudit(ctx, uc.auditRecorder, services.AuditEntry{
+			Action:     services.AuditActionMFAFailed,
+			Resource:   services.AuditResourceUsers,
+			ResourceID: &user.ID,
+			UserID:     &user.ID,
+			IPAddress:  ipAddress,
+			UserAgent:  userAgent,
+		})
+		return nil, errors.NewValidationError("AUTH_001", "Invalid authentication code", nil)
+	}
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to verify authentication code")
+	}
+
+	// An MFA token completes a single login
+	if err := uc.tokenRevoker.RevokeAccessToken(ctx, claims); err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to revoke MFA token")
+	}
+
+	resp, err := uc.complete(ctx, user, before, ipAddress, userAgent)
+	if err != nil {
+		return nil, err
+	}
+	if method == services.MFAMethodRecoveryCode {
+		if left, err := uc.mfaService.RemainingRecoveryCodes(ctx, user.ID); err == nil {
+			resp.RecoveryCodesLeft = &left
+		}
+	}
+
+	return resp, nil
+}
+
+// recordFailure counts a failed login for the client IP and username, reporting whether it was counted
+// Counting errors are logged, the response must not differ from other invalid credentials.
+func (uc *LoginUseCase) recordFailure(ctx context.Context, ipAddress, username string) bool {
+	if err := uc.loginThrottle.RecordFailure(ctx, ipAddress, username); err != nil {
+		uc.logger.Error("Failed to count failed login",
+			zap.String("username", username),
+			zap.Error(err),
+		)
+		return false
+	}
+	return true
+}
+
+// challenge issues the MFA token of a user whose password was accepted and records it
+func (uc *LoginUseCase) challenge(ctx context.Context, user *entities.User, before string, rehashed bool, ipAddress, userAgent string) (*LoginResponse, error) {
+	mfaToken, err := uc.jwtService.GenerateMFAChallengeToken(user.ID, uc.mfaTokenExpiry)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to generate MFA token")
+	}
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if rehashed {
+			if err := uc.userRepo.Update(ctx, user); err != nil {
+				return errors.WrapError(err, "SYS_001", "Failed to upgrade password hash")
+			}
+		}
+
+		return recordAudit(ctx, uc.auditRecorder, services.AuditEntry{
+			Action:     services.AuditActionMFAChallenge,
+			Resource:   services.AuditResourceUsers,
+			ResourceID: &user.ID,
+			UserID:     &user.ID,
+			OldValues:  before,
+			NewValues:  services.Snapshot(user),
+			IPAddress:  ipAddress,
+			UserAgent:  userAgent,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoginResponse{
+		ExpiresIn: int64(uc.mfaTokenExpiry.Seconds()),
+		MFAToken:  mfaToken,
+	}, nil
+}
+
+// complete issues the token pair of an authenticated user and records the login
+func (uc *LoginUseCase) complete(ctx context.Context, user *entities.User, before, ipAddress, userAgent string) (*LoginResponse, error) {
+	// Every factor is right, forget the failures counted for the username
+	if err := uc.loginThrottle.Reset(ctx, user.Username); err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to reset login attempts")
+	}
+
+	// Expired passwords get a token for changing the password instead
+	if uc.passwordPolicy.IsExpired(user) {
+		return uc.requirePasswordChange(ctx, user, before, ipAddress, userAgent)
 	}
 
 	// Generate tokens
@@ -82,7 +349,6 @@ func (uc *LoginUseCase) Execute(ctx context.Context, req *LoginRequest, ipAddres
 		return nil, errors.WrapError(err, "SYS_001", "Failed to generate tokens")
 	}
 
-	// Save refresh token to database
 	refreshToken := entities.NewRefreshToken(
 		user.ID,
 		tokens.RefreshToken,
@@ -91,14 +357,31 @@ func (uc *LoginUseCase) Execute(ctx context.Context, req *LoginRequest, ipAddres
 		userAgent,
 	)
 
-	if err := uc.refreshTokenRepo.Create(ctx, refreshToken); err != nil {
-		return nil, errors.WrapError(err, "SYS_001", "Failed to save refresh token")
-	}
-
-	// Record successful login
+	// Save refresh token, login record and audit record in one transaction
 	user.RecordLogin(nil) // No updatedBy for login
-	if err := uc.userRepo.Update(ctx, user); err != nil {
-		// Log error but don't fail login
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.refreshTokenRepo.Create(ctx, refreshToken); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to save refresh token")
+		}
+
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to record login")
+		}
+
+		return recordAudit(ctx, uc.auditRecorder, services.AuditEntry{
+			Action:     services.AuditActionLogin,
+			Resource:   services.AuditResourceUsers,
+			ResourceID: &user.ID,
+			UserID:     &user.ID,
+			OldValues:  before,
+			NewValues:  services.Snapshot(user),
+			IPAddress:  ipAddress,
+			UserAgent:  userAgent,
+			SessionID:  refreshToken.ID.String(),
+		})
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &LoginResponse{
@@ -107,3 +390,52 @@ func (uc *LoginUseCase) Execute(ctx context.Context, req *LoginRequest, ipAddres
 		ExpiresIn: tokens.ExpiresIn,
 	}, nil
 }
+
+// requirePasswordChange issues the restricted token of an authenticated user whose password expired and records it
+// The token is only accepted for changing the password, which then returns a regular token pair.
+func (uc *LoginUseCase) requirePasswordChange(ctx context.Context, user *entities.User, before, ipAddress, userAgent string) (*LoginResponse, error) {
+	token, err := uc.jwtService.GenerateRestrictedToken(user.ID, user.Username, user.Email, user.RoleID, services.TokenScopePasswordChange, uc.passwordChangeTokenExpiry)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to generate password change token")
+	}
+
+	// Record the login and its audit record in one transaction
+	user.RecordLogin(nil) // No updatedBy for login
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to record login")
+		}
+
+		return recordAudit(ctx, uc.auditRecorder, services.AuditEntry{
+			Action:     services.AuditActionPasswordExpired,
+			Resource:   services.AuditResourceUsers,
+			ResourceID: &user.ID,
+			UserID:     &user.ID,
+			OldValues:  before,
+			NewValues:  services.Snapshot(user),
+			IPAddress:  ipAddress,
+			UserAgent:  userAgent,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoginResponse{
+		ExpiresIn:           int64(uc.passwordChangeTokenExpiry.Seconds()),
+		PasswordChangeToken: token,
+		Scope:               services.TokenScopePasswordChange,
+	}, nil
+}
+
+// tooManyFailedLogins returns the error of a login refused for wait after too many failures
+func tooManyFailedLogins(wait time.Duration) error {
+	return errors.NewBusinessError("BIZ_003", "Too many failed login attempts, try again later", map[string]any{
+		"retry_after": int(math.Ceil(wait.Seconds())),
+	})
+}
+
+// accountLocked returns the error of a login to a locked account, answered like a throttled username
+func accountLocked(user *entities.User) error {
+	return tooManyFailedLogins(time.Until(*user.LockedUntil))
+}
@@ -11,6 +11,7 @@ import (
 // LogoutRequest represents the request to logout
 type LogoutRequest struct {
 	RefreshToken string `json:"refresh_token" validate:"required"`
+	AccessToken  string `json:"-"` // Bearer token of the request, if any; revoked along with the refresh token
 }
 
 // LogoutResponse represents the response after logout
@@ -22,21 +23,30 @@ type LogoutResponse struct {
 type LogoutUseCase struct {
 	refreshTokenRepo repositories.RefreshTokenRepository
 	jwtService       *services.JWTService
+	tokenRevoker     *services.TokenRevoker
+	transactor       repositories.Transactor
+	auditRecorder    *services.AuditRecorder
 }
 
 // NewLogoutUseCase creates a new logout use case
 func NewLogoutUseCase(
 	refreshTokenRepo repositories.RefreshTokenRepository,
 	jwtService *services.JWTService,
+	tokenRevoker *services.TokenRevoker,
+	transactor repositories.Transactor,
+	auditRecorder *services.AuditRecorder,
 ) *LogoutUseCase {
 	return &LogoutUseCase{
 		refreshTokenRepo: refreshTokenRepo,
 		jwtService:       jwtService,
+		tokenRevoker:     tokenRevoker,
+		transactor:       transactor,
+		auditRecorder:    auditRecorder,
 	}
 }
 
 // Execute performs user logout
-func (uc *LogoutUseCase) Execute(ctx context.Context, req *LogoutRequest) (*LogoutResponse, error) {
+func (uc *LogoutUseCase) Execute(ctx context.Context, req *LogoutRequest, ipAddress, userAgent string) (*LogoutResponse, error) {
 	// Validate refresh token
 	_, err := uc.jwtService.ValidateToken(req.RefreshToken)
 	if err != nil {
@@ -54,10 +64,38 @@ func (uc *LogoutUseCase) Execute(ctx context.Context, req *LogoutRequest) (*Logo
 		return nil, errors.NewValidationError("AUTH_001", "Invalid refresh token", nil)
 	}
 
-	// Revoke refresh token
+	// Revoke refresh token and record the logout in one transaction
+	before := services.Snapshot(refreshToken)
 	refreshToken.Revoke(nil) // No updatedBy for logout
-	if err := uc.refreshTokenRepo.Update(ctx, refreshToken); err != nil {
-		return nil, errors.WrapError(err, "SYS_001", "Failed to revoke refresh token")
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.refreshTokenRepo.Update(ctx, refreshToken); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to revoke refresh token")
+		}
+
+		return recordAudit(ctx, uc.auditRecorder, services.AuditEntry{
+			Action:     services.AuditActionLogout,
+			Resource:   services.AuditResourceRefreshTokens,
+			ResourceID: &refreshToken.ID,
+			UserID:     &refreshToken.UserID,
+			OldValues:  before,
+			NewValues:  services.Snapshot(refreshToken),
+			IPAddress:  ipAddress,
+			UserAgent:  userAgent,
+			SessionID:  refreshToken.ID.String(),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Revoke the access token of the same user right away instead of letting it run until expiry
+	if req.AccessToken != "" {
+		claims, err := uc.jwtService.ValidateToken(req.AccessToken)
+		if err == nil && claims.UserID == refreshToken.UserID {
+			if err := uc.tokenRevoker.RevokeAccessToken(ctx, claims); err != nil {
+				return nil, errors.WrapError(err, "SYS_001", "Failed to revoke access token")
+			}
+		}
 	}
 
 	return &LogoutResponse{
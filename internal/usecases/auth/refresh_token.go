@@ -25,6 +25,9 @@ type RefreshTokenUseCase struct {
 	userRepo         repositories.UserRepository
 	refreshTokenRepo repositories.RefreshTokenRepository
 	jwtService       *services.JWTService
+	transactor       repositories.Transactor
+	auditRecorder    *services.AuditRecorder
+	revokeAllOnReuse bool
 }
 
 // NewRefreshTokenUseCase creates a new refresh token use case
@@ -32,11 +35,17 @@ func NewRefreshTokenUseCase(
 	userRepo repositories.UserRepository,
 	refreshTokenRepo repositories.RefreshTokenRepository,
 	jwtService *services.JWTService,
+	transactor repositories.Transactor,
+	auditRecorder *services.AuditRecorder,
+	revokeAllOnReuse bool,
 ) *RefreshTokenUseCase {
 	return &RefreshTokenUseCase{
 		userRepo:         userRepo,
 		refreshTokenRepo: refreshTokenRepo,
 		jwtService:       jwtService,
+		transactor:       transactor,
+		auditRecorder:    auditRecorder,
+		revokeAllOnReuse: revokeAllOnReuse,
 	}
 }
 
@@ -54,6 +63,14 @@ func (uc *RefreshTokenUseCase) Execute(ctx context.Context, req *RefreshTokenReq
 		return nil, errors.NewValidationError("AUTH_001", "Invalid refresh token", nil)
 	}
 
+	// A revoked token that is presented again was stolen or replayed, the whole family is compromised
+	if refreshToken.IsRevoked {
+		if err := uc.handleReuse(ctx, refreshToken, ipAddress, userAgent); err != nil {
+			return nil, err
+		}
+		return nil, errors.NewValidationError("AUTH_001", "Invalid refresh token", nil)
+	}
+
 	// Check if token is valid
 	if !refreshToken.IsValid() {
 		return nil, errors.NewValidationError("AUTH_001", "Invalid refresh token", nil)
@@ -65,6 +82,14 @@ func (uc *RefreshTokenUseCase) Execute(ctx context.Context, req *RefreshTokenReq
 		return nil, errors.NewValidationError("AUTH_001", "User not found", nil)
 	}
 
+	// The user was deleted, their sessions must not outlive them
+	if user == nil {
+		if err := uc.refreshTokenRepo.RevokeFamily(ctx, refreshToken.Family().String()); err != nil {
+			return nil, errors.WrapError(err, "SYS_001", "Failed to revoke refresh token family")
+		}
+		return nil, errors.NewValidationError("AUTH_001", "Invalid refresh token", nil)
+	}
+
 	// Check if user is still active
 	if !user.IsActive() {
 		return nil, errors.NewValidationError("AUTH_003", "Account is not active", nil)
@@ -76,23 +101,34 @@ func (uc *RefreshTokenUseCase) Execute(ctx context.Context, req *RefreshTokenReq
 		return nil, errors.WrapError(err, "SYS_001", "Failed to refresh token")
 	}
 
-	// Revoke old refresh token
+	// Rotate the refresh token and record the refresh in one transaction
+	before := services.Snapshot(refreshToken)
 	refreshToken.Revoke(nil) // No updatedBy for token refresh
-	if err := uc.refreshTokenRepo.Update(ctx, refreshToken); err != nil {
-		// Log error but don't fail the refresh
-	}
-
-	// Save new refresh token to database
-	newRefreshToken := entities.NewRefreshToken(
-		user.ID,
-		tokens.RefreshToken,
-		refreshToken.ExpiresAt, // Keep same expiry
-		ipAddress,
-		userAgent,
-	)
-
-	if err := uc.refreshTokenRepo.Create(ctx, newRefreshToken); err != nil {
-		// Log error but don't fail the refresh
+	newRefreshToken := refreshToken.Rotate(tokens.RefreshToken, ipAddress, userAgent)
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.refreshTokenRepo.Update(ctx, refreshToken); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to revoke refresh token")
+		}
+
+		if err := uc.refreshTokenRepo.Create(ctx, newRefreshToken); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to save refresh token")
+		}
+
+		return recordAudit(ctx, uc.auditRecorder, services.AuditEntry{
+			Action:     services.AuditActionTokenRefresh,
+			Resource:   services.AuditResourceRefreshTokens,
+			ResourceID: &newRefreshToken.ID,
+			UserID:     &user.ID,
+			OldValues:  before,
+			NewValues:  services.Snapshot(newRefreshToken),
+			IPAddress:  ipAddress,
+			UserAgent:  userAgent,
+			SessionID:  newRefreshToken.ID.String(),
+		})
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &RefreshTokenResponse{
@@ -100,3 +136,35 @@ func (uc *RefreshTokenUseCase) Execute(ctx context.Context, req *RefreshTokenReq
 		ExpiresIn: tokens.ExpiresIn,
 	}, nil
 }
+
+// handleReuse revokes the token family of a replayed refresh token, and every session of
+// its user when configured, and records a security audit event
+func (uc *RefreshTokenUseCase) handleReuse(ctx context.Context, refreshToken *entities.RefreshToken, ipAddress, userAgent string) error {
+	familyID := refreshToken.Family()
+
+	return uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.refreshTokenRepo.RevokeFamily(ctx, familyID.String()); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to revoke refresh token family")
+		}
+
+		if uc.revokeAllOnReuse {
+			if err := uc.refreshTokenRepo.RevokeAllForUser(ctx, refreshToken.UserID.String()); err != nil {
+				return errors.WrapError(err, "SYS_001", "Failed to revoke refresh tokens")
+			}
+		}
+
+		return recordAudit(ctx, uc.auditRecorder, services.AuditEntry{
+			Action:     services.AuditActionTokenReuse,
+			Resource:   services.AuditResourceRefreshTokens,
+			ResourceID: &refreshToken.ID,
+			UserID:     &refreshToken.UserID,
+			NewValues: services.Snapshot(map[string]any{
+				"family_id":          familyID,
+				"revoked_all_tokens": uc.revokeAllOnReuse,
+			}),
+			IPAddress: ipAddress,
+			UserAgent: userAgent,
+			SessionID: refreshToken.ID.String(),
+		})
+	})
+}
@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"context"
+	"math"
+	"strings"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/errors"
+)
+
+// resendVerificationMessage is returned whether or not the email belongs to an account, so responses do not reveal users
+const resendVerificationMessage = "If the email belongs to an account awaiting verification, a new verification link has been sent to it"
+
+// ResendVerificationEmailRequest represents the request to send another verification link
+type ResendVerificationEmailRequest struct {
+	Email string `json:"email" validate:"required,email,max=100"`
+}
+
+// ResendVerificationEmailResponse represents the response after requesting another verification link
+type ResendVerificationEmailResponse struct {
+	Message string `json:"message"`
+}
+
+// ResendVerificationEmailUseCase sends fresh verification links, throttled per address
+type ResendVerificationEmailUseCase struct {
+	userRepo          repositories.UserRepository
+	emailVerification *services.EmailVerificationService
+	throttle          *services.Throttle
+}
+
+// NewResendVerificationEmailUseCase creates a new resend verification email use case
+func NewResendVerificationEmailUseCase(
+	userRepo repositories.UserRepository,
+	emailVerification *services.EmailVerificationService,
+	throttle *services.Throttle,
+) *ResendVerificationEmailUseCase {
+	return &ResendVerificationEmailUseCase{
+		userRepo:          userRepo,
+		emailVerification: emailVerification,
+		throttle:          throttle,
+	}
+}
+
+// Execute emails a new verification link to an unverified account with the email
+// The throttle counts every request for the address, known or not, so throttling does not reveal users either.
+func (uc *ResendVerificationEmailUseCase) Execute(ctx context.Context, req *ResendVerificationEmailRequest) (*ResendVerificationEmailResponse, error) {
+	if allowed, wait := uc.throttle.Allow(strings.ToLower(req.Email)); !allowed {
+		return nil, errors.NewBusinessError("BIZ_003", "Too many verification emails requested, try again later", map[string]any{
+			"retry_after": int(math.Ceil(wait.Seconds())),
+		})
+	}
+
+	response := &ResendVerificationEmailResponse{Message: resendVerificationMessage}
+
+	user, err := uc.userRepo.GetByEmail(ctx, req.Email)
+	if err != nil || user == nil || user.EmailVerified || user.Status == entities.UserStatusBlocked {
+		return response, nil
+	}
+
+	if err := uc.emailVerification.SendVerification(ctx, user); err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to send verification email")
+	}
+
+	return response, nil
+}
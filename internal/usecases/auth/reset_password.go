@@ -0,0 +1,138 @@
+package auth
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/errors"
+)
+
+// ResetPasswordRequest represents the request to set a new password with a reset token
+type ResetPasswordRequest struct {
+	Token       string `json:"token" validate:"required,max=100"`
+	NewPassword string `json:"new_password" validate:"required,min=8,max=128"`
+}
+
+// ResetPasswordResponse represents the response after resetting the password
+type ResetPasswordResponse struct {
+	Message string `json:"message"`
+}
+
+// ResetPasswordUseCase sets a new password through a single-use reset token
+type ResetPasswordUseCase struct {
+	userRepo         repositories.UserRepository
+	resetTokenRepo   repositories.PasswordResetTokenRepository
+	refreshTokenRepo repositories.RefreshTokenRepository
+	passwordService  *services.PasswordService
+	passwordPolicy   *services.PasswordPolicy
+	tokenRevoker     *services.TokenRevoker
+	transactor       repositories.Transactor
+	auditRecorder    *services.AuditRecorder
+}
+
+// NewResetPasswordUseCase creates a new reset password use case
+func NewResetPasswordUseCase(
+	userRepo repositories.UserRepository,
+	resetTokenRepo repositories.PasswordResetTokenRepository,
+	refreshTokenRepo repositories.RefreshTokenRepository,
+	passwordService *services.PasswordService,
+	passwordPolicy *services.PasswordPolicy,
+	tokenRevoker *services.TokenRevoker,
+	transactor repositories.Transactor,
+	auditRecorder *services.AuditRecorder,
+) *ResetPasswordUseCase {
+	return &ResetPasswordUseCase{
+		userRepo:         userRepo,
+		resetTokenRepo:   resetTokenRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		passwordService:  passwordService,
+		passwordPolicy:   passwordPolicy,
+		tokenRevoker:     tokenRevoker,
+		transactor:       transactor,
+		auditRecorder:    auditRecorder,
+	}
+}
+
+// Execute consumes the reset token, stores the new password and signs the user out everywhere
+func (uc *ResetPasswordUseCase) Execute(ctx context.Context, req *ResetPasswordRequest, ipAddress, userAgent string) (*ResetPasswordResponse, error) {
+	invalidToken := errors.NewValidationError("VAL_003", "Invalid or expired password reset token", nil)
+
+	resetToken, err := uc.resetTokenRepo.GetByToken(ctx, req.Token)
+	if err != nil || !resetToken.IsValid() {
+		return nil, invalidToken
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, resetToken.UserID)
+	if err != nil || user == nil || !user.IsActive() {
+		return nil, invalidToken
+	}
+
+	// Check the password policy, including reuse of the current and earlier passwords
+	violations, err := uc.passwordPolicy.Validate(ctx, user, req.NewPassword)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to check password history")
+	}
+	if len(violations) > 0 {
+		return nil, errors.NewValidationError("VAL_003", "Password does not meet the password policy", map[string]any{
+			"violations": violations,
+		})
+	}
+
+	passwordHash, salt, err := uc.passwordService.HashPassword(req.NewPassword)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to hash password")
+	}
+
+	before := services.Snapshot(user)
+	history := entities.NewPasswordHistory(user.ID, user.PasswordHash, user.Salt, &user.ID)
+	user.ChangePassword(passwordHash, salt, &user.ID)
+	resetToken.MarkUsed(&user.ID)
+
+	// Consume the token, store the password and revoke every session in one transaction
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		// Fails when a concurrent reset consumed the token first
+		if err := uc.resetTokenRepo.MarkUsed(ctx, resetToken); err != nil {
+			return invalidToken
+		}
+
+		if err := uc.resetTokenRepo.InvalidateAllForUser(ctx, user.ID.String()); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to invalidate reset tokens")
+		}
+
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to update password")
+		}
+
+		if err := uc.passwordPolicy.Remember(ctx, history); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to save password history")
+		}
+
+		if err := uc.refreshTokenRepo.RevokeAllForUser(ctx, user.ID.String()); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to revoke sessions")
+		}
+
+		return recordAudit(ctx, uc.auditRecorder, services.AuditEntry{
+			Action:     services.AuditActionPasswordReset,
+			Resource:   services.AuditResourceUsers,
+			ResourceID: &user.ID,
+			UserID:     &user.ID,
+			OldValues:  before,
+			NewValues:  services.Snapshot(user),
+			IPAddress:  ipAddress,
+			UserAgent:  userAgent,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.tokenRevoker.RevokeUser(ctx, user.ID); err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to revoke access tokens")
+	}
+
+	return &ResetPasswordResponse{
+		Message: "Password has been reset, sign in with the new password",
+	}, nil
+}
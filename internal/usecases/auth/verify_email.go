@@ -0,0 +1,112 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/errors"
+)
+
+// VerifyEmailRequest represents the request to confirm an email address
+type VerifyEmailRequest struct {
+	Token string `json:"token" validate:"required,max=1000"`
+}
+
+// VerifyEmailResponse represents the response after confirming an email address
+type VerifyEmailResponse struct {
+	Message   string `json:"message"`
+	Activated bool   `json:"activated"` // The pending account was activated by the verification
+}
+
+// VerifyEmailUseCase marks email addresses verified through signed links
+type VerifyEmailUseCase struct {
+	userRepo          repositories.UserRepository
+	userService       *services.UserService
+	emailVerification *services.EmailVerificationService
+	transactor        repositories.Transactor
+	auditRecorder     *services.AuditRecorder
+	autoActivate      bool
+}
+
+// NewVerifyEmailUseCase creates a new verify email use case
+// With autoActivate, pending accounts become active once their email is verified.
+func NewVerifyEmailUseCase(
+	userRepo repositories.UserRepository,
+	userService *services.UserService,
+	emailVerification *services.EmailVerificationService,
+	transactor repositories.Transactor,
+	auditRecorder *services.AuditRecorder,
+	autoActivate bool,
+) *VerifyEmailUseCase {
+	return &VerifyEmailUseCase{
+		userRepo:          userRepo,
+		userService:       userService,
+		emailVerification: emailVerification,
+		transactor:        transactor,
+		auditRecorder:     auditRecorder,
+		autoActivate:      autoActivate,
+	}
+}
+
+// Execute verifies the token and marks the user's email verified
+// Verifying an already verified address succeeds again without changes.
+func (uc *VerifyEmailUseCase) Execute(ctx context.Context, req *VerifyEmailRequest, ipAddress, userAgent string) (*VerifyEmailResponse, error) {
+	invalidToken := errors.NewValidationError("VAL_003", "Invalid or expired verification token", nil)
+
+	userID, email, err := uc.emailVerification.Verify(req.Token)
+	if err != nil {
+		return nil, invalidToken
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil || user == nil {
+		return nil, invalidToken
+	}
+
+	// The address changed since the link was sent
+	if !strings.EqualFold(user.Email, email) {
+		return nil, invalidToken
+	}
+
+	if user.EmailVerified {
+		return &VerifyEmailResponse{Message: "Email address is already verified"}, nil
+	}
+
+	before := services.Snapshot(user)
+	user.VerifyEmail(&user.ID)
+
+	activated := false
+	if uc.autoActivate && user.Status == entities.UserStatusPending && uc.userService.CanActivate(ctx, user) == nil {
+		user.Activate(&user.ID)
+		activated = true
+	}
+
+	// Store the verification and record it in one transaction
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to verify email")
+		}
+
+		return recordAudit(ctx, uc.auditRecorder, services.AuditEntry{
+			Action:     services.AuditActionUpdate,
+			Resource:   services.AuditResourceUsers,
+			ResourceID: &user.ID,
+			UserID:     &user.ID,
+			OldValues:  before,
+			NewValues:  services.Snapshot(user),
+			IPAddress:  ipAddress,
+			UserAgent:  userAgent,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &VerifyEmailResponse{
+		Message:   "Email address verified",
+		Activated: activated,
+	}, nil
+}
@@ -0,0 +1,78 @@
+package department
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/actor"
+	"bm-staff/pkg/errors"
+)
+
+// CreateDepartmentRequest represents the request to create a department
+type CreateDepartmentRequest struct {
+	Name        string `json:"name" validate:"required,min=1,max=100"`
+	Code        string `json:"code" validate:"required,min=2,max=50,uppercase"`
+	Description string `json:"description" validate:"omitempty,max=500"`
+	ParentID    string `json:"parent_id" validate:"omitempty,uuid"`
+	ManagerID   string `json:"manager_id" validate:"omitempty,uuid"`
+}
+
+// CreateDepartmentResponse represents the response after creating a department
+type CreateDepartmentResponse struct {
+	Department *entities.Department `json:"department"`
+}
+
+// CreateDepartmentUseCase handles department creation business logic
+type CreateDepartmentUseCase struct {
+	departmentRepo    repositories.DepartmentRepository
+	userRepo          repositories.UserRepository
+	departmentService *services.DepartmentService
+}
+
+// NewCreateDepartmentUseCase creates a new create department use case
+func NewCreateDepartmentUseCase(departmentRepo repositories.DepartmentRepository, userRepo repositories.UserRepository, departmentService *services.DepartmentService) *CreateDepartmentUseCase {
+	return &CreateDepartmentUseCase{
+		departmentRepo:    departmentRepo,
+		userRepo:          userRepo,
+		departmentService: departmentService,
+	}
+}
+
+// Execute creates a new department
+func (uc *CreateDepartmentUseCase) Execute(ctx context.Context, req *CreateDepartmentRequest) (*CreateDepartmentResponse, error) {
+	// Check code uniqueness
+	existing, err := uc.departmentRepo.GetByCode(ctx, req.Code)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to check department code")
+	}
+	if existing != nil {
+		return nil, errors.NewBusinessError("BIZ_002", "Department code already exists", map[string]any{
+			"code": req.Code,
+		})
+	}
+
+	managerID, err := resolveManager(ctx, uc.userRepo, req.ManagerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create department entity
+	department := entities.NewDepartment(req.Name, req.Code, req.Description, nil, managerID)
+	department.CreatedBy = actor.UserID(ctx)
+	department.UpdatedBy = department.CreatedBy
+
+	if department.ParentID, err = resolveParent(ctx, uc.departmentService, department, req.ParentID); err != nil {
+		return nil, err
+	}
+
+	// Create department in repository
+	if err := uc.departmentRepo.Create(ctx, department); err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to create department")
+	}
+
+	return &CreateDepartmentResponse{
+		Department: department,
+	}, nil
+}
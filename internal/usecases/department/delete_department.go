@@ -0,0 +1,72 @@
+package department
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/pkg/errors"
+)
+
+// DeleteDepartmentRequest represents the request to delete a department
+type DeleteDepartmentRequest struct {
+	ID string `json:"id" validate:"required,uuid"`
+}
+
+// DeleteDepartmentResponse represents the response after deleting a department
+type DeleteDepartmentResponse struct {
+	Success bool `json:"success"`
+}
+
+// DeleteDepartmentUseCase handles department deletion business logic
+type DeleteDepartmentUseCase struct {
+	departmentRepo repositories.DepartmentRepository
+	userRepo       repositories.UserRepository
+}
+
+// NewDeleteDepartmentUseCase creates a new delete department use case
+func NewDeleteDepartmentUseCase(departmentRepo repositories.DepartmentRepository, userRepo repositories.UserRepository) *DeleteDepartmentUseCase {
+	return &DeleteDepartmentUseCase{
+		departmentRepo: departmentRepo,
+		userRepo:       userRepo,
+	}
+}
+
+// Execute deletes a department by ID
+// Departments with child departments or users cannot be deleted.
+func (uc *DeleteDepartmentUseCase) Execute(ctx context.Context, req *DeleteDepartmentRequest) (*DeleteDepartmentResponse, error) {
+	department, err := getDepartment(ctx, uc.departmentRepo, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	children, err := uc.departmentRepo.CountChildren(ctx, department.ID)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to count child departments")
+	}
+	if children > 0 {
+		return nil, errors.NewBusinessError("BIZ_002", "Department has child departments", map[string]any{
+			"code":        department.Code,
+			"departments": children,
+		})
+	}
+
+	users, err := uc.userRepo.Count(ctx, &repositories.UserListFilter{DepartmentID: &department.ID})
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to count department users")
+	}
+	if users > 0 {
+		return nil, errors.NewBusinessError("BIZ_002", "Department still has users", map[string]any{
+			"code":  department.Code,
+			"users": users,
+		})
+	}
+
+	// Delete department from repository
+	if err := uc.departmentRepo.Delete(ctx, department.ID); err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to delete department")
+	}
+
+	return &DeleteDepartmentResponse{
+		Success: true,
+	}, nil
+}
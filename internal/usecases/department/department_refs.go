@@ -0,0 +1,75 @@
+package department
+
+import (
+	"context"
+	stderrors "errors"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// resolveParent parses and validates the parent of a department, an empty value makes it a root department
+func resolveParent(ctx context.Context, departmentService *services.DepartmentService, department *entities.Department, value string) (*uuid.UUID, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	parentID, err := uuid.Parse(value)
+	if err != nil {
+		return nil, errors.NewValidationError("VAL_002", "Invalid parent_id format", map[string]any{
+			"parent_id": value,
+		})
+	}
+
+	err = departmentService.ValidateParent(ctx, department, &parentID)
+	switch {
+	case err == nil:
+		return &parentID, nil
+	case stderrors.Is(err, services.ErrParentDepartmentNotFound):
+		return nil, errors.NewBusinessError("BIZ_001", "Parent department not found", map[string]any{
+			"parent_id": value,
+		})
+	case stderrors.Is(err, services.ErrParentDepartmentInactive):
+		return nil, errors.NewBusinessError("BIZ_002", "Parent department is inactive", map[string]any{
+			"parent_id": value,
+		})
+	case stderrors.Is(err, services.ErrDepartmentCycle):
+		return nil, errors.NewBusinessError("BIZ_002", "Parent department would create a cycle", map[string]any{
+			"code":      department.Code,
+			"parent_id": value,
+		})
+	default:
+		return nil, errors.WrapError(err, "SYS_001", "Failed to validate parent department")
+	}
+}
+
+// resolveManager parses the manager of a department and checks the user exists, an empty value means no manager
+func resolveManager(ctx context.Context, userRepo repositories.UserRepository, value string) (*uuid.UUID, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	managerID, err := uuid.Parse(value)
+	if err != nil {
+		return nil, errors.NewValidationError("VAL_002", "Invalid manager_id format", map[string]any{
+			"manager_id": value,
+		})
+	}
+
+	manager, err := userRepo.GetByID(ctx, managerID)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to get manager")
+	}
+
+	if manager == nil {
+		return nil, errors.NewBusinessError("BIZ_001", "Manager not found", map[string]any{
+			"manager_id": value,
+		})
+	}
+
+	return &managerID, nil
+}
@@ -0,0 +1,68 @@
+package department
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// GetDepartmentRequest represents the request to get a department
+type GetDepartmentRequest struct {
+	ID string `json:"id" validate:"required,uuid"`
+}
+
+// GetDepartmentResponse represents the response after getting a department
+type GetDepartmentResponse struct {
+	Department *entities.Department `json:"department"`
+}
+
+// GetDepartmentUseCase handles department retrieval business logic
+type GetDepartmentUseCase struct {
+	departmentRepo repositories.DepartmentRepository
+}
+
+// NewGetDepartmentUseCase creates a new get department use case
+func NewGetDepartmentUseCase(departmentRepo repositories.DepartmentRepository) *GetDepartmentUseCase {
+	return &GetDepartmentUseCase{
+		departmentRepo: departmentRepo,
+	}
+}
+
+// Execute retrieves a department by ID
+func (uc *GetDepartmentUseCase) Execute(ctx context.Context, req *GetDepartmentRequest) (*GetDepartmentResponse, error) {
+	department, err := getDepartment(ctx, uc.departmentRepo, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetDepartmentResponse{
+		Department: department,
+	}, nil
+}
+
+// getDepartment parses a department ID and loads the department, returning BIZ_001 when it does not exist
+func getDepartment(ctx context.Context, departmentRepo repositories.DepartmentRepository, id string) (*entities.Department, error) {
+	departmentID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, errors.NewValidationError("VAL_002", "Invalid department ID format", map[string]any{
+			"id": id,
+		})
+	}
+
+	department, err := departmentRepo.GetByID(ctx, departmentID)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to get department")
+	}
+
+	if department == nil {
+		return nil, errors.NewBusinessError("BIZ_001", "Department not found", map[string]any{
+			"id": id,
+		})
+	}
+
+	return department, nil
+}
@@ -0,0 +1,81 @@
+package department
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// DepartmentNode is a department with its child departments nested
+type DepartmentNode struct {
+	*entities.Department
+	Children []*DepartmentNode `json:"children"`
+}
+
+// GetDepartmentSubtreeRequest represents the request to get the subtree of a department
+type GetDepartmentSubtreeRequest struct {
+	ID string `json:"id" validate:"required,uuid"`
+}
+
+// GetDepartmentSubtreeResponse represents a department and all of its descendants
+type GetDepartmentSubtreeResponse struct {
+	Tree  *DepartmentNode `json:"tree"`
+	Total int             `json:"total"`
+}
+
+// GetDepartmentSubtreeUseCase handles department subtree retrieval business logic
+type GetDepartmentSubtreeUseCase struct {
+	departmentRepo repositories.DepartmentRepository
+}
+
+// NewGetDepartmentSubtreeUseCase creates a new get department subtree use case
+func NewGetDepartmentSubtreeUseCase(departmentRepo repositories.DepartmentRepository) *GetDepartmentSubtreeUseCase {
+	return &GetDepartmentSubtreeUseCase{
+		departmentRepo: departmentRepo,
+	}
+}
+
+// Execute retrieves a department with all of its descendants nested under their parents
+func (uc *GetDepartmentSubtreeUseCase) Execute(ctx context.Context, req *GetDepartmentSubtreeRequest) (*GetDepartmentSubtreeResponse, error) {
+	department, err := getDepartment(ctx, uc.departmentRepo, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	departments, err := uc.departmentRepo.GetSubtree(ctx, department.ID)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to get department subtree")
+	}
+
+	return &GetDepartmentSubtreeResponse{
+		Tree:  buildDepartmentTree(department, departments),
+		Total: len(departments),
+	}, nil
+}
+
+// buildDepartmentTree nests a pre-order list of departments under the given root
+func buildDepartmentTree(root *entities.Department, departments []*entities.Department) *DepartmentNode {
+	tree := &DepartmentNode{Department: root, Children: []*DepartmentNode{}}
+	nodes := map[uuid.UUID]*DepartmentNode{root.ID: tree}
+
+	for _, department := range departments {
+		if department.ID == root.ID || department.ParentID == nil {
+			continue
+		}
+
+		parent, ok := nodes[*department.ParentID]
+		if !ok {
+			continue
+		}
+
+		node := &DepartmentNode{Department: department, Children: []*DepartmentNode{}}
+		parent.Children = append(parent.Children, node)
+		nodes[department.ID] = node
+	}
+
+	return tree
+}
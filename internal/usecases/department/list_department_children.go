@@ -0,0 +1,54 @@
+package department
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/pkg/errors"
+)
+
+// ListDepartmentChildrenRequest represents the request to list the child departments of a department
+type ListDepartmentChildrenRequest struct {
+	ID string `json:"id" validate:"required,uuid"`
+}
+
+// ListDepartmentChildrenResponse represents the direct child departments of a department
+type ListDepartmentChildrenResponse struct {
+	Department *entities.Department   `json:"department"`
+	Children   []*entities.Department `json:"children"`
+}
+
+// ListDepartmentChildrenUseCase handles child department listing business logic
+type ListDepartmentChildrenUseCase struct {
+	departmentRepo repositories.DepartmentRepository
+}
+
+// NewListDepartmentChildrenUseCase creates a new list department children use case
+func NewListDepartmentChildrenUseCase(departmentRepo repositories.DepartmentRepository) *ListDepartmentChildrenUseCase {
+	return &ListDepartmentChildrenUseCase{
+		departmentRepo: departmentRepo,
+	}
+}
+
+// Execute lists the direct child departments of a department ordered by name
+func (uc *ListDepartmentChildrenUseCase) Execute(ctx context.Context, req *ListDepartmentChildrenRequest) (*ListDepartmentChildrenResponse, error) {
+	department, err := getDepartment(ctx, uc.departmentRepo, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	children, err := uc.departmentRepo.GetChildren(ctx, department.ID)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to list child departments")
+	}
+
+	if children == nil {
+		children = []*entities.Department{}
+	}
+
+	return &ListDepartmentChildrenResponse{
+		Department: department,
+		Children:   children,
+	}, nil
+}
@@ -0,0 +1,62 @@
+package department
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/pkg/errors"
+	"bm-staff/pkg/pagination"
+)
+
+// ListDepartmentsRequest represents the request to list departments
+type ListDepartmentsRequest struct {
+	Limit  int `form:"limit" validate:"omitempty,min=1,max=100"`
+	Offset int `form:"offset" validate:"omitempty,min=0"`
+}
+
+// ListDepartmentsResponse represents the response after listing departments
+type ListDepartmentsResponse struct {
+	Departments []*entities.Department `json:"departments"`
+	Pagination  pagination.Page        `json:"pagination"`
+}
+
+// ListDepartmentsUseCase handles department listing business logic
+type ListDepartmentsUseCase struct {
+	departmentRepo repositories.DepartmentRepository
+}
+
+// NewListDepartmentsUseCase creates a new list departments use case
+func NewListDepartmentsUseCase(departmentRepo repositories.DepartmentRepository) *ListDepartmentsUseCase {
+	return &ListDepartmentsUseCase{
+		departmentRepo: departmentRepo,
+	}
+}
+
+// Execute lists departments ordered by name
+func (uc *ListDepartmentsUseCase) Execute(ctx context.Context, req *ListDepartmentsRequest) (*ListDepartmentsResponse, error) {
+	limit, offset := pagination.Normalize(req.Limit, req.Offset)
+
+	departments, err := uc.departmentRepo.List(ctx, limit, offset)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to list departments")
+	}
+
+	total, err := uc.departmentRepo.Count(ctx)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to count departments")
+	}
+
+	if departments == nil {
+		departments = []*entities.Department{}
+	}
+
+	return &ListDepartmentsResponse{
+		Departments: departments,
+		Pagination: pagination.Page{
+			Limit:  limit,
+			Offset: offset,
+			Total:  total,
+		},
+	}, nil
+}
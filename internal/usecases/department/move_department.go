@@ -0,0 +1,62 @@
+package department
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/actor"
+	"bm-staff/pkg/errors"
+)
+
+// MoveDepartmentRequest represents the request to move a department under a new parent
+// An empty parent_id makes the department a root department.
+type MoveDepartmentRequest struct {
+	ID       string `json:"id" validate:"required,uuid"`
+	ParentID string `json:"parent_id" validate:"omitempty,uuid"`
+}
+
+// MoveDepartmentResponse represents the response after moving a department
+type MoveDepartmentResponse struct {
+	Department *entities.Department `json:"department"`
+}
+
+// MoveDepartmentUseCase handles department move business logic
+type MoveDepartmentUseCase struct {
+	departmentRepo    repositories.DepartmentRepository
+	departmentService *services.DepartmentService
+}
+
+// NewMoveDepartmentUseCase creates a new move department use case
+func NewMoveDepartmentUseCase(departmentRepo repositories.DepartmentRepository, departmentService *services.DepartmentService) *MoveDepartmentUseCase {
+	return &MoveDepartmentUseCase{
+		departmentRepo:    departmentRepo,
+		departmentService: departmentService,
+	}
+}
+
+// Execute moves a department, with its whole subtree, under a new parent
+// Moving a department under itself or one of its descendants is rejected.
+func (uc *MoveDepartmentUseCase) Execute(ctx context.Context, req *MoveDepartmentRequest) (*MoveDepartmentResponse, error) {
+	department, err := getDepartment(ctx, uc.departmentRepo, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	parentID, err := resolveParent(ctx, uc.departmentService, department, req.ParentID)
+	if err != nil {
+		return nil, err
+	}
+
+	department.SetParent(parentID, actor.UserID(ctx))
+
+	// Update department in repository
+	if err := uc.departmentRepo.Update(ctx, department); err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to move department")
+	}
+
+	return &MoveDepartmentResponse{
+		Department: department,
+	}, nil
+}
@@ -0,0 +1,100 @@
+package department
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/pkg/actor"
+	"bm-staff/pkg/errors"
+)
+
+// UpdateDepartmentRequest represents the request to update a department
+// The code is immutable and the parent is changed through the move endpoint.
+// A nil manager_id keeps the current manager, an empty one clears it.
+type UpdateDepartmentRequest struct {
+	ID          string  `json:"id" validate:"required,uuid"`
+	Name        string  `json:"name" validate:"required,min=1,max=100"`
+	Description string  `json:"description" validate:"omitempty,max=500"`
+	ManagerID   *string `json:"manager_id" validate:"omitempty,uuid"`
+	IsActive    *bool   `json:"is_active"`
+}
+
+// UpdateDepartmentResponse represents the response after updating a department
+type UpdateDepartmentResponse struct {
+	Department *entities.Department `json:"department"`
+}
+
+// UpdateDepartmentUseCase handles department update business logic
+type UpdateDepartmentUseCase struct {
+	departmentRepo repositories.DepartmentRepository
+	userRepo       repositories.UserRepository
+}
+
+// NewUpdateDepartmentUseCase creates a new update department use case
+func NewUpdateDepartmentUseCase(departmentRepo repositories.DepartmentRepository, userRepo repositories.UserRepository) *UpdateDepartmentUseCase {
+	return &UpdateDepartmentUseCase{
+		departmentRepo: departmentRepo,
+		userRepo:       userRepo,
+	}
+}
+
+// Execute updates an existing department
+func (uc *UpdateDepartmentUseCase) Execute(ctx context.Context, req *UpdateDepartmentRequest) (*UpdateDepartmentResponse, error) {
+	department, err := getDepartment(ctx, uc.departmentRepo, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedBy := actor.UserID(ctx)
+	department.UpdateInfo(req.Name, req.Description, updatedBy)
+
+	if req.ManagerID != nil {
+		managerID, err := resolveManager(ctx, uc.userRepo, *req.ManagerID)
+		if err != nil {
+			return nil, err
+		}
+		department.SetManager(managerID, updatedBy)
+	}
+
+	if req.IsActive != nil && *req.IsActive != department.IsActive {
+		if *req.IsActive {
+			department.Activate(updatedBy)
+		} else {
+			if err := uc.checkNoActiveUsers(ctx, department); err != nil {
+				return nil, err
+			}
+			department.Deactivate(updatedBy)
+		}
+	}
+
+	// Update department in repository
+	if err := uc.departmentRepo.Update(ctx, department); err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to update department")
+	}
+
+	return &UpdateDepartmentResponse{
+		Department: department,
+	}, nil
+}
+
+// checkNoActiveUsers refuses to deactivate a department still referenced by active users
+func (uc *UpdateDepartmentUseCase) checkNoActiveUsers(ctx context.Context, department *entities.Department) error {
+	status := entities.UserStatusActive
+	active, err := uc.userRepo.Count(ctx, &repositories.UserListFilter{
+		DepartmentID: &department.ID,
+		Status:       &status,
+	})
+	if err != nil {
+		return errors.WrapError(err, "SYS_001", "Failed to count department users")
+	}
+
+	if active > 0 {
+		return errors.NewBusinessError("BIZ_002", "Department still has active users", map[string]any{
+			"code":  department.Code,
+			"users": active,
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package permission
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// recordAudit writes the audit record of a change to a permission, inside the caller's transaction
+func recordAudit(ctx context.Context, auditRecorder *services.AuditRecorder, action string, permissionID uuid.UUID, oldValues, newValues string) error {
+	err := auditRecorder.Record(ctx, services.AuditEntry{
+		Action:     action,
+		Resource:   services.AuditResourcePermissions,
+		ResourceID: &permissionID,
+		OldValues:  oldValues,
+		NewValues:  newValues,
+	})
+	if err != nil {
+		return errors.WrapError(err, "SYS_001", "Failed to record audit log")
+	}
+
+	return nil
+}
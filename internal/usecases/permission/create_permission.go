@@ -0,0 +1,91 @@
+package permission
+
+import (
+	"context"
+	"strings"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/actor"
+	"bm-staff/pkg/errors"
+)
+
+// CreatePermissionRequest represents the request to create a permission
+// The permission code is derived as resource:action, an action of "*" registers a wildcard
+// that grants every action on the resource.
+type CreatePermissionRequest struct {
+	Name        string `json:"name" validate:"required,min=1,max=100"`
+	Resource    string `json:"resource" validate:"required,min=1,max=40,excludesall=:* "`
+	Action      string `json:"action" validate:"required,min=1,max=40,excludesall=: "`
+	Description string `json:"description" validate:"omitempty,max=500"`
+}
+
+// CreatePermissionResponse represents the response after creating a permission
+type CreatePermissionResponse struct {
+	Permission *entities.Permission `json:"permission"`
+}
+
+// CreatePermissionUseCase handles permission creation business logic
+type CreatePermissionUseCase struct {
+	permissionRepo repositories.PermissionRepository
+	transactor     repositories.Transactor
+	auditRecorder  *services.AuditRecorder
+}
+
+// NewCreatePermissionUseCase creates a new create permission use case
+func NewCreatePermissionUseCase(permissionRepo repositories.PermissionRepository, transactor repositories.Transactor, auditRecorder *services.AuditRecorder) *CreatePermissionUseCase {
+	return &CreatePermissionUseCase{
+		permissionRepo: permissionRepo,
+		transactor:     transactor,
+		auditRecorder:  auditRecorder,
+	}
+}
+
+// Execute creates a new permission
+func (uc *CreatePermissionUseCase) Execute(ctx context.Context, req *CreatePermissionRequest) (*CreatePermissionResponse, error) {
+	if req.Action != entities.PermissionWildcard && strings.Contains(req.Action, entities.PermissionWildcard) {
+		return nil, errors.NewValidationError("VAL_002", "Wildcard action must be \"*\"", map[string]any{
+			"action": req.Action,
+		})
+	}
+
+	code := req.Resource + ":" + req.Action
+	if len(code) > 50 {
+		return nil, errors.NewValidationError("VAL_003", "Permission code is too long", map[string]any{
+			"code": code,
+		})
+	}
+
+	// Check code uniqueness
+	existing, err := uc.permissionRepo.GetByCode(ctx, code)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to check permission code")
+	}
+	if existing != nil {
+		return nil, errors.NewBusinessError("BIZ_002", "Permission code already exists", map[string]any{
+			"code": code,
+		})
+	}
+
+	// Create permission entity
+	permission := entities.NewPermission(req.Name, code, req.Resource, req.Action, req.Description)
+	permission.CreatedBy = actor.UserID(ctx)
+	permission.UpdatedBy = permission.CreatedBy
+
+	// Create permission and record the change in one transaction
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.permissionRepo.Create(ctx, permission); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to create permission")
+		}
+
+		return recordAudit(ctx, uc.auditRecorder, services.AuditActionCreate, permission.ID, "", services.Snapshot(permission))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreatePermissionResponse{
+		Permission: permission,
+	}, nil
+}
@@ -0,0 +1,59 @@
+package permission
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/errors"
+)
+
+// DeletePermissionRequest represents the request to delete a permission
+type DeletePermissionRequest struct {
+	ID string `json:"id" validate:"required,uuid"`
+}
+
+// DeletePermissionResponse represents the response after deleting a permission
+type DeletePermissionResponse struct {
+	Success bool `json:"success"`
+}
+
+// DeletePermissionUseCase handles permission deletion business logic
+type DeletePermissionUseCase struct {
+	permissionRepo repositories.PermissionRepository
+	transactor     repositories.Transactor
+	auditRecorder  *services.AuditRecorder
+}
+
+// NewDeletePermissionUseCase creates a new delete permission use case
+func NewDeletePermissionUseCase(permissionRepo repositories.PermissionRepository, transactor repositories.Transactor, auditRecorder *services.AuditRecorder) *DeletePermissionUseCase {
+	return &DeletePermissionUseCase{
+		permissionRepo: permissionRepo,
+		transactor:     transactor,
+		auditRecorder:  auditRecorder,
+	}
+}
+
+// Execute deletes a permission by ID, roles granting its code no longer grant it
+func (uc *DeletePermissionUseCase) Execute(ctx context.Context, req *DeletePermissionRequest) (*DeletePermissionResponse, error) {
+	permission, err := getPermission(ctx, uc.permissionRepo, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Delete permission and record the change in one transaction
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.permissionRepo.Delete(ctx, permission.ID); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to delete permission")
+		}
+
+		return recordAudit(ctx, uc.auditRecorder, services.AuditActionDelete, permission.ID, services.Snapshot(permission), "")
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeletePermissionResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,68 @@
+package permission
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// GetPermissionRequest represents the request to get a permission
+type GetPermissionRequest struct {
+	ID string `json:"id" validate:"required,uuid"`
+}
+
+// GetPermissionResponse represents the response after getting a permission
+type GetPermissionResponse struct {
+	Permission *entities.Permission `json:"permission"`
+}
+
+// GetPermissionUseCase handles permission retrieval business logic
+type GetPermissionUseCase struct {
+	permissionRepo repositories.PermissionRepository
+}
+
+// NewGetPermissionUseCase creates a new get permission use case
+func NewGetPermissionUseCase(permissionRepo repositories.PermissionRepository) *GetPermissionUseCase {
+	return &GetPermissionUseCase{
+		permissionRepo: permissionRepo,
+	}
+}
+
+// Execute retrieves a permission by ID
+func (uc *GetPermissionUseCase) Execute(ctx context.Context, req *GetPermissionRequest) (*GetPermissionResponse, error) {
+	permission, err := getPermission(ctx, uc.permissionRepo, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetPermissionResponse{
+		Permission: permission,
+	}, nil
+}
+
+// getPermission parses a permission ID and loads the permission, returning BIZ_001 when it does not exist
+func getPermission(ctx context.Context, permissionRepo repositories.PermissionRepository, id string) (*entities.Permission, error) {
+	permissionID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, errors.NewValidationError("VAL_002", "Invalid permission ID format", map[string]any{
+			"id": id,
+		})
+	}
+
+	permission, err := permissionRepo.GetByID(ctx, permissionID)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to get permission")
+	}
+
+	if permission == nil {
+		return nil, errors.NewBusinessError("BIZ_001", "Permission not found", map[string]any{
+			"id": id,
+		})
+	}
+
+	return permission, nil
+}
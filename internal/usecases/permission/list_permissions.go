@@ -0,0 +1,62 @@
+package permission
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/pkg/errors"
+	"bm-staff/pkg/pagination"
+)
+
+// ListPermissionsRequest represents the request to list permissions
+type ListPermissionsRequest struct {
+	Limit  int `form:"limit" validate:"omitempty,min=1,max=100"`
+	Offset int `form:"offset" validate:"omitempty,min=0"`
+}
+
+// ListPermissionsResponse represents the response after listing permissions
+type ListPermissionsResponse struct {
+	Permissions []*entities.Permission `json:"permissions"`
+	Pagination  pagination.Page        `json:"pagination"`
+}
+
+// ListPermissionsUseCase handles permission listing business logic
+type ListPermissionsUseCase struct {
+	permissionRepo repositories.PermissionRepository
+}
+
+// NewListPermissionsUseCase creates a new list permissions use case
+func NewListPermissionsUseCase(permissionRepo repositories.PermissionRepository) *ListPermissionsUseCase {
+	return &ListPermissionsUseCase{
+		permissionRepo: permissionRepo,
+	}
+}
+
+// Execute lists permissions ordered by code
+func (uc *ListPermissionsUseCase) Execute(ctx context.Context, req *ListPermissionsRequest) (*ListPermissionsResponse, error) {
+	limit, offset := pagination.Normalize(req.Limit, req.Offset)
+
+	permissions, err := uc.permissionRepo.List(ctx, limit, offset)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to list permissions")
+	}
+
+	total, err := uc.permissionRepo.Count(ctx)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to count permissions")
+	}
+
+	if permissions == nil {
+		permissions = []*entities.Permission{}
+	}
+
+	return &ListPermissionsResponse{
+		Permissions: permissions,
+		Pagination: pagination.Page{
+			Limit:  limit,
+			Offset: offset,
+			Total:  total,
+		},
+	}, nil
+}
@@ -0,0 +1,77 @@
+package permission
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/actor"
+	"bm-staff/pkg/errors"
+)
+
+// UpdatePermissionRequest represents the request to update a permission
+// The permission code is immutable once created.
+type UpdatePermissionRequest struct {
+	ID          string `json:"id" validate:"required,uuid"`
+	Name        string `json:"name" validate:"required,min=1,max=100"`
+	Description string `json:"description" validate:"omitempty,max=500"`
+	IsActive    *bool  `json:"is_active"`
+}
+
+// UpdatePermissionResponse represents the response after updating a permission
+type UpdatePermissionResponse struct {
+	Permission *entities.Permission `json:"permission"`
+}
+
+// UpdatePermissionUseCase handles permission update business logic
+type UpdatePermissionUseCase struct {
+	permissionRepo repositories.PermissionRepository
+	transactor     repositories.Transactor
+	auditRecorder  *services.AuditRecorder
+}
+
+// NewUpdatePermissionUseCase creates a new update permission use case
+func NewUpdatePermissionUseCase(permissionRepo repositories.PermissionRepository, transactor repositories.Transactor, auditRecorder *services.AuditRecorder) *UpdatePermissionUseCase {
+	return &UpdatePermissionUseCase{
+		permissionRepo: permissionRepo,
+		transactor:     transactor,
+		auditRecorder:  auditRecorder,
+	}
+}
+
+// Execute updates an existing permission, deactivating it denies it for every role
+func (uc *UpdatePermissionUseCase) Execute(ctx context.Context, req *UpdatePermissionRequest) (*UpdatePermissionResponse, error) {
+	permission, err := getPermission(ctx, uc.permissionRepo, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	before := services.Snapshot(permission)
+	updatedBy := actor.UserID(ctx)
+	permission.UpdateInfo(req.Name, req.Description, updatedBy)
+
+	if req.IsActive != nil && *req.IsActive != permission.IsActive {
+		if *req.IsActive {
+			permission.Activate(updatedBy)
+		} else {
+			permission.Deactivate(updatedBy)
+		}
+	}
+
+	// Update permission and record the change in one transaction
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.permissionRepo.Update(ctx, permission); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to update permission")
+		}
+
+		return recordAudit(ctx, uc.auditRecorder, services.AuditActionUpdate, permission.ID, before, services.Snapshot(permission))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &UpdatePermissionResponse{
+		Permission: permission,
+	}, nil
+}
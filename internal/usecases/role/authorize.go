@@ -0,0 +1,61 @@
+package role
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/actor"
+	"bm-staff/pkg/errors"
+)
+
+// authorizeGrant checks the actor holds every permission code a role is given, so no role rises above its editor
+func authorizeGrant(ctx context.Context, authorizationService *services.AuthorizationService, codes []string) error {
+	allowed, err := actorHolds(ctx, authorizationService, codes)
+	if err != nil {
+		return err
+	}
+
+	if !allowed {
+		return errors.NewBusinessError(errors.ErrAuthInsufficient, "Insufficient permissions to grant these permissions", map[string]any{
+			"permissions": codes,
+		})
+	}
+
+	return nil
+}
+
+// authorizeSystemRole checks only holders of "*" change a system role
+func authorizeSystemRole(ctx context.Context, authorizationService *services.AuthorizationService, role *entities.Role) error {
+	if !role.IsSystemRole() {
+		return nil
+	}
+
+	allowed, err := actorHolds(ctx, authorizationService, []string{entities.PermissionWildcard})
+	if err != nil {
+		return err
+	}
+
+	if !allowed {
+		return errors.NewBusinessError(errors.ErrAuthInsufficient, "Insufficient permissions to change a system role", map[string]any{
+			"code": role.Code,
+		})
+	}
+
+	return nil
+}
+
+// actorHolds checks the request actor's role holds every permission code, directly or by inheritance
+func actorHolds(ctx context.Context, authorizationService *services.AuthorizationService, codes []string) (bool, error) {
+	current, ok := actor.FromContext(ctx)
+	if !ok {
+		return false, nil
+	}
+
+	allowed, err := authorizationService.CanGrantPermissions(ctx, current.RoleID, codes)
+	if err != nil {
+		return false, errors.WrapError(err, "SYS_001", "Failed to evaluate role permissions")
+	}
+
+	return allowed, nil
+}
@@ -0,0 +1,96 @@
+package role
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/actor"
+	"bm-staff/pkg/errors"
+)
+
+// CreateRoleRequest represents the request to create a role
+type CreateRoleRequest struct {
+	Name            string   `json:"name" validate:"required,min=1,max=100"`
+	Code            string   `json:"code" validate:"required,min=2,max=50,uppercase"`
+	Description     string   `json:"description" validate:"omitempty,max=500"`
+	ParentID        string   `json:"parent_id" validate:"omitempty,uuid"`
+	PermissionCodes []string `json:"permission_codes" validate:"omitempty,dive,required,max=50"`
+}
+
+// CreateRoleResponse represents the response after creating a role
+type CreateRoleResponse struct {
+	Role *entities.Role `json:"role"`
+}
+
+// CreateRoleUseCase handles role creation business logic
+type CreateRoleUseCase struct {
+	roleRepo             repositories.RoleRepository
+	permissionRepo       repositories.PermissionRepository
+	roleService          *services.RoleService
+	authorizationService *services.AuthorizationService
+	transactor           repositories.Transactor
+	auditRecorder        *services.AuditRecorder
+}
+
+// NewCreateRoleUseCase creates a new create role use case
+func NewCreateRoleUseCase(roleRepo repositories.RoleRepository, permissionRepo repositories.PermissionRepository, roleService *services.RoleService, authorizationService *services.AuthorizationService, transactor repositories.Transactor, auditRecorder *services.AuditRecorder) *CreateRoleUseCase {
+	return &CreateRoleUseCase{
+		roleRepo:             roleRepo,
+		permissionRepo:       permissionRepo,
+		roleService:          roleService,
+		authorizationService: authorizationService,
+		transactor:           transactor,
+		auditRecorder:        auditRecorder,
+	}
+}
+
+// Execute creates a new role, roles created through the API are never system roles
+// The actor must hold every permission the role grants.
+func (uc *CreateRoleUseCase) Execute(ctx context.Context, req *CreateRoleRequest) (*CreateRoleResponse, error) {
+	// Check code uniqueness
+	existing, err := uc.roleRepo.GetByCode(ctx, req.Code)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to check role code")
+	}
+	if existing != nil {
+		return nil, errors.NewBusinessError("BIZ_002", "Role code already exists", map[string]any{
+			"code": req.Code,
+		})
+	}
+
+	codes, err := resolvePermissionCodes(ctx, uc.permissionRepo, req.PermissionCodes)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeGrant(ctx, uc.authorizationService, codes); err != nil {
+		return nil, err
+	}
+
+	// Create role entity
+	role := entities.NewRole(req.Name, req.Code, req.Description, codes, false)
+	role.CreatedBy = actor.UserID(ctx)
+	role.UpdatedBy = role.CreatedBy
+
+	if role.ParentID, err = resolveParent(ctx, uc.roleRepo, uc.roleService, uc.authorizationService, role, req.ParentID); err != nil {
+		return nil, err
+	}
+
+	// Create role and record the change in one transaction
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.roleRepo.Create(ctx, role); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to create role")
+		}
+
+		return recordAudit(ctx, uc.auditRecorder, services.AuditActionCreate, role.ID, "", services.Snapshot(role))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateRoleResponse{
+		Role: role,
+	}, nil
+}
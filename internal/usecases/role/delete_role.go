@@ -0,0 +1,90 @@
+package role
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/errors"
+)
+
+// DeleteRoleRequest represents the request to delete a role
+type DeleteRoleRequest struct {
+	ID string `json:"id" validate:"required,uuid"`
+}
+
+// DeleteRoleResponse represents the response after deleting a role
+type DeleteRoleResponse struct {
+	Success bool `json:"success"`
+}
+
+// DeleteRoleUseCase handles role deletion business logic
+type DeleteRoleUseCase struct {
+	roleRepo      repositories.RoleRepository
+	userRepo      repositories.UserRepository
+	transactor    repositories.Transactor
+	auditRecorder *services.AuditRecorder
+}
+
+// NewDeleteRoleUseCase creates a new delete role use case
+func NewDeleteRoleUseCase(roleRepo repositories.RoleRepository, userRepo repositories.UserRepository, transactor repositories.Transactor, auditRecorder *services.AuditRecorder) *DeleteRoleUseCase {
+	return &DeleteRoleUseCase{
+		roleRepo:      roleRepo,
+		userRepo:      userRepo,
+		transactor:    transactor,
+		auditRecorder: auditRecorder,
+	}
+}
+
+// Execute deletes a role by ID
+// System roles, roles still assigned to users and roles inherited by other roles cannot be deleted.
+func (uc *DeleteRoleUseCase) Execute(ctx context.Context, req *DeleteRoleRequest) (*DeleteRoleResponse, error) {
+	role, err := getRole(ctx, uc.roleRepo, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if role.IsSystemRole() {
+		return nil, errors.NewBusinessError("BIZ_002", "System role cannot be deleted", map[string]any{
+			"code": role.Code,
+		})
+	}
+
+	assigned, err := uc.userRepo.Count(ctx, &repositories.UserListFilter{RoleID: &role.ID})
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to count role users")
+	}
+	if assigned > 0 {
+		return nil, errors.NewBusinessError("BIZ_002", "Role is still assigned to users", map[string]any{
+			"code":  role.Code,
+			"users": assigned,
+		})
+	}
+
+	children, err := uc.roleRepo.CountChildren(ctx, role.ID)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to count child roles")
+	}
+	if children > 0 {
+		return nil, errors.NewBusinessError("BIZ_002", "Role is inherited by other roles", map[string]any{
+			"code":  role.Code,
+			"roles": children,
+		})
+	}
+
+	// Delete role and record the change in one transaction
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.roleRepo.Delete(ctx, role.ID); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to delete role")
+		}
+
+		return recordAudit(ctx, uc.auditRecorder, services.AuditActionDelete, role.ID, services.Snapshot(role), "")
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeleteRoleResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,53 @@
+package role
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/errors"
+)
+
+// GetEffectivePermissionsRequest represents the request to get the effective permissions of a role
+type GetEffectivePermissionsRequest struct {
+	ID string `json:"id" validate:"required,uuid"`
+}
+
+// GetEffectivePermissionsResponse represents the flattened permissions of a role
+type GetEffectivePermissionsResponse struct {
+	Role        *entities.Role                 `json:"role"`
+	Permissions []services.EffectivePermission `json:"permissions"`
+}
+
+// GetEffectivePermissionsUseCase handles effective permission resolution
+type GetEffectivePermissionsUseCase struct {
+	roleRepo             repositories.RoleRepository
+	authorizationService *services.AuthorizationService
+}
+
+// NewGetEffectivePermissionsUseCase creates a new get effective permissions use case
+func NewGetEffectivePermissionsUseCase(roleRepo repositories.RoleRepository, authorizationService *services.AuthorizationService) *GetEffectivePermissionsUseCase {
+	return &GetEffectivePermissionsUseCase{
+		roleRepo:             roleRepo,
+		authorizationService: authorizationService,
+	}
+}
+
+// Execute resolves the permissions a role grants directly and inherits from its ancestors
+func (uc *GetEffectivePermissionsUseCase) Execute(ctx context.Context, req *GetEffectivePermissionsRequest) (*GetEffectivePermissionsResponse, error) {
+	role, err := getRole(ctx, uc.roleRepo, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, err := uc.authorizationService.EffectivePermissions(ctx, role.ID)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to resolve effective permissions")
+	}
+
+	return &GetEffectivePermissionsResponse{
+		Role:        role,
+		Permissions: permissions,
+	}, nil
+}
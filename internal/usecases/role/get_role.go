@@ -0,0 +1,68 @@
+package role
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// GetRoleRequest represents the request to get a role
+type GetRoleRequest struct {
+	ID string `json:"id" validate:"required,uuid"`
+}
+
+// GetRoleResponse represents the response after getting a role
+type GetRoleResponse struct {
+	Role *entities.Role `json:"role"`
+}
+
+// GetRoleUseCase handles role retrieval business logic
+type GetRoleUseCase struct {
+	roleRepo repositories.RoleRepository
+}
+
+// NewGetRoleUseCase creates a new get role use case
+func NewGetRoleUseCase(roleRepo repositories.RoleRepository) *GetRoleUseCase {
+	return &GetRoleUseCase{
+		roleRepo: roleRepo,
+	}
+}
+
+// Execute retrieves a role by ID
+func (uc *GetRoleUseCase) Execute(ctx context.Context, req *GetRoleRequest) (*GetRoleResponse, error) {
+	role, err := getRole(ctx, uc.roleRepo, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetRoleResponse{
+		Role: role,
+	}, nil
+}
+
+// getRole parses a role ID and loads the role, returning BIZ_001 when it does not exist
+func getRole(ctx context.Context, roleRepo repositories.RoleRepository, id string) (*entities.Role, error) {
+	roleID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, errors.NewValidationError("VAL_002", "Invalid role ID format", map[string]any{
+			"id": id,
+		})
+	}
+
+	role, err := roleRepo.GetByID(ctx, roleID)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to get role")
+	}
+
+	if role == nil {
+		return nil, errors.NewBusinessError("BIZ_001", "Role not found", map[string]any{
+			"id": id,
+		})
+	}
+
+	return role, nil
+}
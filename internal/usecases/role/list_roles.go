@@ -0,0 +1,62 @@
+package role
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/pkg/errors"
+	"bm-staff/pkg/pagination"
+)
+
+// ListRolesRequest represents the request to list roles
+type ListRolesRequest struct {
+	Limit  int `form:"limit" validate:"omitempty,min=1,max=100"`
+	Offset int `form:"offset" validate:"omitempty,min=0"`
+}
+
+// ListRolesResponse represents the response after listing roles
+type ListRolesResponse struct {
+	Roles      []*entities.Role `json:"roles"`
+	Pagination pagination.Page  `json:"pagination"`
+}
+
+// ListRolesUseCase handles role listing business logic
+type ListRolesUseCase struct {
+	roleRepo repositories.RoleRepository
+}
+
+// NewListRolesUseCase creates a new list roles use case
+func NewListRolesUseCase(roleRepo repositories.RoleRepository) *ListRolesUseCase {
+	return &ListRolesUseCase{
+		roleRepo: roleRepo,
+	}
+}
+
+// Execute lists roles ordered by name
+func (uc *ListRolesUseCase) Execute(ctx context.Context, req *ListRolesRequest) (*ListRolesResponse, error) {
+	limit, offset := pagination.Normalize(req.Limit, req.Offset)
+
+	roles, err := uc.roleRepo.List(ctx, limit, offset)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to list roles")
+	}
+
+	total, err := uc.roleRepo.Count(ctx)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to count roles")
+	}
+
+	if roles == nil {
+		roles = []*entities.Role{}
+	}
+
+	return &ListRolesResponse{
+		Roles: roles,
+		Pagination: pagination.Page{
+			Limit:  limit,
+			Offset: offset,
+			Total:  total,
+		},
+	}, nil
+}
@@ -0,0 +1,38 @@
+package role
+
+import (
+	"context"
+	"strings"
+
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/pkg/errors"
+)
+
+// resolvePermissionCodes validates and de-duplicates the permission codes granted to a role
+// Codes must be registered permissions, including the "*" and "resource:*" wildcards.
+func resolvePermissionCodes(ctx context.Context, permissionRepo repositories.PermissionRepository, codes []string) ([]string, error) {
+	resolved := make([]string, 0, len(codes))
+	seen := make(map[string]bool, len(codes))
+
+	for _, code := range codes {
+		code = strings.TrimSpace(code)
+		if code == "" || seen[code] {
+			continue
+		}
+		seen[code] = true
+
+		permission, err := permissionRepo.GetByCode(ctx, code)
+		if err != nil {
+			return nil, errors.WrapError(err, "SYS_001", "Failed to resolve permission")
+		}
+		if permission == nil {
+			return nil, errors.NewValidationError("VAL_002", "Unknown permission code", map[string]any{
+				"code": code,
+			})
+		}
+
+		resolved = append(resolved, code)
+	}
+
+	return resolved, nil
+}
@@ -0,0 +1,87 @@
+package role
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/actor"
+	"bm-staff/pkg/errors"
+)
+
+// RevokeRolePermissionRequest represents the request to revoke a single permission from a role
+type RevokeRolePermissionRequest struct {
+	ID             string `json:"id" validate:"required,uuid"`
+	PermissionCode string `json:"permission_code" validate:"required,max=50"`
+}
+
+// RevokeRolePermissionResponse represents the response after revoking a role permission
+type RevokeRolePermissionResponse struct {
+	Role *entities.Role `json:"role"`
+}
+
+// RevokeRolePermissionUseCase handles permission revocation from roles
+type RevokeRolePermissionUseCase struct {
+	roleRepo      repositories.RoleRepository
+	transactor    repositories.Transactor
+	auditRecorder *services.AuditRecorder
+}
+
+// NewRevokeRolePermissionUseCase creates a new revoke role permission use case
+func NewRevokeRolePermissionUseCase(roleRepo repositories.RoleRepository, transactor repositories.Transactor, auditRecorder *services.AuditRecorder) *RevokeRolePermissionUseCase {
+	return &RevokeRolePermissionUseCase{
+		roleRepo:      roleRepo,
+		transactor:    transactor,
+		auditRecorder: auditRecorder,
+	}
+}
+
+// Execute revokes a permission granted directly by a role
+func (uc *RevokeRolePermissionUseCase) Execute(ctx context.Context, req *RevokeRolePermissionRequest) (*RevokeRolePermissionResponse, error) {
+	role, err := getRole(ctx, uc.roleRepo, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if role.IsSystemRole() {
+		return nil, errors.NewBusinessError("BIZ_002", "Permissions cannot be removed from a system role", map[string]any{
+			"code":       role.Code,
+			"permission": req.PermissionCode,
+		})
+	}
+
+	current := role.PermissionCodes
+	remaining := make([]string, 0, len(current))
+	for _, code := range current {
+		if code != req.PermissionCode {
+			remaining = append(remaining, code)
+		}
+	}
+
+	if len(remaining) == len(current) {
+		return nil, errors.NewBusinessError("BIZ_001", "Permission is not granted by the role", map[string]any{
+			"code":       role.Code,
+			"permission": req.PermissionCode,
+		})
+	}
+
+	before := services.Snapshot(role)
+	role.UpdatePermissions(remaining, actor.UserID(ctx))
+
+	// Update role and record the change in one transaction
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.roleRepo.Update(ctx, role); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to update role permissions")
+		}
+
+		return recordAudit(ctx, uc.auditRecorder, services.AuditActionUpdate, role.ID, before, services.Snapshot(role))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &RevokeRolePermissionResponse{
+		Role: role,
+	}, nil
+}
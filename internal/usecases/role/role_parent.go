@@ -0,0 +1,83 @@
+package role
+
+import (
+	"context"
+	stderrors "errors"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/actor"
+	"bm-staff/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// resolveParent parses and validates the parent role of a role, an empty value means no parent
+// A new parent must not grant anything the actor does not hold, since the role inherits all of it.
+func resolveParent(ctx context.Context, roleRepo repositories.RoleRepository, roleService *services.RoleService, authorizationService *services.AuthorizationService, role *entities.Role, value string) (*uuid.UUID, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	parentID, err := uuid.Parse(value)
+	if err != nil {
+		return nil, errors.NewValidationError("VAL_002", "Invalid parent_id format", map[string]any{
+			"parent_id": value,
+		})
+	}
+
+	err = roleService.ValidateParent(ctx, role, &parentID)
+	switch {
+	case err == nil:
+		if role.ParentID != nil && *role.ParentID == parentID {
+			return &parentID, nil
+		}
+		if err := authorizeParent(ctx, roleRepo, authorizationService, parentID); err != nil {
+			return nil, err
+		}
+		return &parentID, nil
+	case stderrors.Is(err, services.ErrParentRoleNotFound):
+		return nil, errors.NewBusinessError("BIZ_001", "Parent role not found", map[string]any{
+			"parent_id": value,
+		})
+	case stderrors.Is(err, services.ErrRoleCycle):
+		return nil, errors.NewBusinessError("BIZ_002", "Parent role would create a cycle", map[string]any{
+			"code":      role.Code,
+			"parent_id": value,
+		})
+	default:
+		return nil, errors.WrapError(err, "SYS_001", "Failed to validate parent role")
+	}
+}
+
+// authorizeParent checks the actor holds every permission of a parent role, directly or by inheritance
+func authorizeParent(ctx context.Context, roleRepo repositories.RoleRepository, authorizationService *services.AuthorizationService, parentID uuid.UUID) error {
+	current, ok := actor.FromContext(ctx)
+	if !ok {
+		return errors.NewBusinessError(errors.ErrAuthInsufficient, "Insufficient permissions", nil)
+	}
+
+	parent, err := roleRepo.GetByID(ctx, parentID)
+	if err != nil {
+		return errors.WrapError(err, "SYS_001", "Failed to get parent role")
+	}
+	if parent == nil {
+		return errors.NewBusinessError("BIZ_001", "Parent role not found", map[string]any{
+			"parent_id": parentID.String(),
+		})
+	}
+
+	allowed, err := authorizationService.CanGrantRole(ctx, current.RoleID, parent)
+	if err != nil {
+		return errors.WrapError(err, "SYS_001", "Failed to evaluate role permissions")
+	}
+
+	if !allowed {
+		return errors.NewBusinessError(errors.ErrAuthInsufficient, "Insufficient permissions to inherit from this role", map[string]any{
+			"parent_id": parentID.String(),
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package role
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/actor"
+	"bm-staff/pkg/errors"
+)
+
+// SetRolePermissionsRequest represents the request to replace the permissions granted by a role
+type SetRolePermissionsRequest struct {
+	ID              string   `json:"id" validate:"required,uuid"`
+	PermissionCodes []string `json:"permission_codes" validate:"required,dive,required,max=50"`
+}
+
+// SetRolePermissionsResponse represents the response after replacing role permissions
+type SetRolePermissionsResponse struct {
+	Role *entities.Role `json:"role"`
+}
+
+// SetRolePermissionsUseCase handles permission assignment to roles
+type SetRolePermissionsUseCase struct {
+	roleRepo             repositories.RoleRepository
+	permissionRepo       repositories.PermissionRepository
+	authorizationService *services.AuthorizationService
+	transactor           repositories.Transactor
+	auditRecorder        *services.AuditRecorder
+}
+
+// NewSetRolePermissionsUseCase creates a new set role permissions use case
+func NewSetRolePermissionsUseCase(roleRepo repositories.RoleRepository, permissionRepo repositories.PermissionRepository, authorizationService *services.AuthorizationService, transactor repositories.Transactor, auditRecorder *services.AuditRecorder) *SetRolePermissionsUseCase {
+	return &SetRolePermissionsUseCase{
+		roleRepo:             roleRepo,
+		permissionRepo:       permissionRepo,
+		authorizationService: authorizationService,
+		transactor:           transactor,
+		auditRecorder:        auditRecorder,
+	}
+}
+
+// Execute replaces the permissions granted by a role
+// The actor must hold every permission granted. System roles may be granted additional permissions,
+// by holders of "*" only, but never lose existing ones.
+func (uc *SetRolePermissionsUseCase) Execute(ctx context.Context, req *SetRolePermissionsRequest) (*SetRolePermissionsResponse, error) {
+	role, err := getRole(ctx, uc.roleRepo, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeSystemRole(ctx, uc.authorizationService, role); err != nil {
+		return nil, err
+	}
+
+	codes, err := resolvePermissionCodes(ctx, uc.permissionRepo, req.PermissionCodes)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeGrant(ctx, uc.authorizationService, codes); err != nil {
+		return nil, err
+	}
+
+	if role.IsSystemRole() {
+		granted := make(map[string]bool, len(codes))
+		for _, code := range codes {
+			granted[code] = true
+		}
+		for _, code := range role.PermissionCodes {
+			if !granted[code] {
+				return nil, errors.NewBusinessError("BIZ_002", "Permissions cannot be removed from a system role", map[string]any{
+					"code":       role.Code,
+					"permission": code,
+				})
+			}
+		}
+	}
+
+	before := services.Snapshot(role)
+	role.UpdatePermissions(codes, actor.UserID(ctx))
+
+	// Update role and record the change in one transaction
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.roleRepo.Update(ctx, role); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to update role permissions")
+		}
+
+		return recordAudit(ctx, uc.auditRecorder, services.AuditActionUpdate, role.ID, before, services.Snapshot(role))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SetRolePermissionsResponse{
+		Role: role,
+	}, nil
+}
@@ -0,0 +1,138 @@
+package role
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/actor"
+	"bm-staff/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// UpdateRoleRequest represents the request to update a role
+// The role code is immutable once created. A nil parent_id keeps the current parent, an empty one clears it.
+type UpdateRoleRequest struct {
+	ID          string  `json:"id" validate:"required,uuid"`
+	Name        string  `json:"name" validate:"required,min=1,max=100"`
+	Description string  `json:"description" validate:"omitempty,max=500"`
+	IsActive    *bool   `json:"is_active"`
+	ParentID    *string `json:"parent_id" validate:"omitempty,uuid"`
+}
+
+// UpdateRoleResponse represents the response after updating a role
+type UpdateRoleResponse struct {
+	Role *entities.Role `json:"role"`
+}
+
+// UpdateRoleUseCase handles role update business logic
+type UpdateRoleUseCase struct {
+	roleRepo             repositories.RoleRepository
+	userRepo             repositories.UserRepository
+	roleService          *services.RoleService
+	authorizationService *services.AuthorizationService
+	tokenRevoker         *services.TokenRevoker
+	transactor           repositories.Transactor
+	auditRecorder        *services.AuditRecorder
+}
+
+// NewUpdateRoleUseCase creates a new update role use case
+func NewUpdateRoleUseCase(roleRepo repositories.RoleRepository, userRepo repositories.UserRepository, roleService *services.RoleService, authorizationService *services.AuthorizationService, tokenRevoker *services.TokenRevoker, transactor repositories.Transactor, auditRecorder *services.AuditRecorder) *UpdateRoleUseCase {
+	return &UpdateRoleUseCase{
+		roleRepo:             roleRepo,
+		userRepo:             userRepo,
+		roleService:          roleService,
+		authorizationService: authorizationService,
+		tokenRevoker:         tokenRevoker,
+		transactor:           transactor,
+		auditRecorder:        auditRecorder,
+	}
+}
+
+// Execute updates an existing role, system roles can only be changed by holders of "*"
+func (uc *UpdateRoleUseCase) Execute(ctx context.Context, req *UpdateRoleRequest) (*UpdateRoleResponse, error) {
+	role, err := getRole(ctx, uc.roleRepo, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeSystemRole(ctx, uc.authorizationService, role); err != nil {
+		return nil, err
+	}
+
+	before := services.Snapshot(role)
+	wasActive, previousParentID := role.IsActive, role.ParentID
+	updatedBy := actor.UserID(ctx)
+	role.UpdateInfo(req.Name, req.Description, updatedBy)
+
+	if req.IsActive != nil && *req.IsActive != role.IsActive {
+		if *req.IsActive {
+			role.Activate(updatedBy)
+		} else {
+			// Deactivating a system role would strip all of its permissions
+			if role.IsSystemRole() {
+				return nil, errors.NewBusinessError("BIZ_002", "System role cannot be deactivated", map[string]any{
+					"code": role.Code,
+				})
+			}
+			role.Deactivate(updatedBy)
+		}
+	}
+
+	if req.ParentID != nil {
+		parentID, err := resolveParent(ctx, uc.roleRepo, uc.roleService, uc.authorizationService, role, *req.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		role.SetParent(parentID, updatedBy)
+	}
+
+	// Update role and record the change in one transaction
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.roleRepo.Update(ctx, role); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to update role")
+		}
+
+		return recordAudit(ctx, uc.auditRecorder, services.AuditActionUpdate, role.ID, before, services.Snapshot(role))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Users of the role and of roles inheriting from it gain or lose permissions with its parent or active state
+	if role.IsActive != wasActive || !sameRoleID(role.ParentID, previousParentID) {
+		if err := revokeRoleHolders(ctx, uc.userRepo, uc.tokenRevoker, role.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return &UpdateRoleResponse{
+		Role: role,
+	}, nil
+}
+
+// sameRoleID reports whether two optional role IDs are equal
+func sameRoleID(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// revokeRoleHolders revokes the access tokens of the users whose role is the role or inherits from it
+func revokeRoleHolders(ctx context.Context, userRepo repositories.UserRepository, tokenRevoker *services.TokenRevoker, roleID uuid.UUID) error {
+	userIDs, err := userRepo.ListIDsByRole(ctx, roleID)
+	if err != nil {
+		return errors.WrapError(err, "SYS_001", "Failed to list role users")
+	}
+
+	for _, userID := range userIDs {
+		if err := tokenRevoker.RevokeUser(ctx, userID); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to revoke access tokens")
+		}
+	}
+
+	return nil
+}
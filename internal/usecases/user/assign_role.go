@@ -0,0 +1,162 @@
+package user
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/actor"
+	"bm-staff/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// AssignRoleRequest represents the request to assign a role to a user
+// An empty role ID removes the user's role.
+type AssignRoleRequest struct {
+	ID     string `json:"id" validate:"required,uuid"`
+	RoleID string `json:"role_id" validate:"omitempty,uuid"`
+}
+
+// AssignRoleResponse represents the response after assigning a role
+type AssignRoleResponse struct {
+	User *entities.User `json:"user"`
+}
+
+// AssignRoleUseCase handles role assignment business logic
+type AssignRoleUseCase struct {
+	userRepo             repositories.UserRepository
+	roleRepo             repositories.RoleRepository
+	authorizationService *services.AuthorizationService
+	tokenRevoker         *services.TokenRevoker
+	transactor           repositories.Transactor
+	auditRecorder        *services.AuditRecorder
+}
+
+// NewAssignRoleUseCase creates a new assign role use case
+func NewAssignRoleUseCase(userRepo repositories.UserRepository, roleRepo repositories.RoleRepository, authorizationService *services.AuthorizationService, tokenRevoker *services.TokenRevoker, transactor repositories.Transactor, auditRecorder *services.AuditRecorder) *AssignRoleUseCase {
+	return &AssignRoleUseCase{
+		userRepo:             userRepo,
+		roleRepo:             roleRepo,
+		authorizationService: authorizationService,
+		tokenRevoker:         tokenRevoker,
+		transactor:           transactor,
+		auditRecorder:        auditRecorder,
+	}
+}
+
+// Execute assigns a role to a user
+// The actor cannot change their own role, and must hold every permission of both the current and the new role.
+func (uc *AssignRoleUseCase) Execute(ctx context.Context, req *AssignRoleRequest) (*AssignRoleResponse, error) {
+	// Parse UUID
+	userID, err := uuid.Parse(req.ID)
+	if err != nil {
+		return nil, errors.NewValidationError("VAL_002", "Invalid user ID format", map[string]any{
+			"id": req.ID,
+		})
+	}
+
+	roleID, err := parseOptionalUUID("role_id", req.RoleID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get existing user
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to get user")
+	}
+
+	if user == nil {
+		return nil, errors.NewBusinessError("BIZ_001", "User not found", map[string]any{
+			"id": req.ID,
+		})
+	}
+
+	current, ok := actor.FromContext(ctx)
+	if !ok {
+		return nil, errors.NewBusinessError(errors.ErrAuthInsufficient, "Insufficient permissions", nil)
+	}
+
+	if current.UserID == user.ID {
+		return nil, errors.NewBusinessError(errors.ErrAuthInsufficient, "Users cannot change their own role", nil)
+	}
+
+	// Check the actor outranks the role being taken away
+	if user.RoleID != nil {
+		currentRole, err := uc.roleRepo.GetByID(ctx, *user.RoleID)
+		if err != nil {
+			return nil, errors.WrapError(err, "SYS_001", "Failed to get role")
+		}
+
+		if currentRole != nil {
+			if err := uc.authorizeGrant(ctx, current, currentRole); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if roleID != nil {
+		role, err := uc.roleRepo.GetByID(ctx, *roleID)
+		if err != nil {
+			return nil, errors.WrapError(err, "SYS_001", "Failed to get role")
+		}
+
+		if role == nil {
+			return nil, errors.NewBusinessError("BIZ_001", "Role not found", map[string]any{
+				"role_id": req.RoleID,
+			})
+		}
+
+		if !role.IsActive {
+			return nil, errors.NewBusinessError("BIZ_002", "Inactive role cannot be assigned", map[string]any{
+				"role_id": req.RoleID,
+			})
+		}
+
+		if err := uc.authorizeGrant(ctx, current, role); err != nil {
+			return nil, err
+		}
+	}
+
+	before := services.Snapshot(user)
+	user.UpdateOrganization(user.DepartmentID, roleID, user.ManagerID, user.EmployeeCode, actor.UserID(ctx))
+
+	// Update user and record the change in one transaction
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to assign role")
+		}
+
+		return recordAudit(ctx, uc.auditRecorder, services.AuditActionUpdate, user.ID, before, services.Snapshot(user))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Permissions are resolved from the role_id claim, deny the tokens carrying the previous role
+	if err := uc.tokenRevoker.RevokeUser(ctx, user.ID); err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to revoke access tokens")
+	}
+
+	return &AssignRoleResponse{
+		User: user,
+	}, nil
+}
+
+// authorizeGrant checks the actor holds every permission of a role they assign or take away
+func (uc *AssignRoleUseCase) authorizeGrant(ctx context.Context, current *actor.Actor, role *entities.Role) error {
+	allowed, err := uc.authorizationService.CanGrantRole(ctx, current.RoleID, role)
+	if err != nil {
+		return errors.WrapError(err, "SYS_001", "Failed to evaluate role permissions")
+	}
+
+	if !allowed {
+		return errors.NewBusinessError(errors.ErrAuthInsufficient, "Insufficient permissions to assign this role", map[string]any{
+			"role_id": role.ID.String(),
+		})
+	}
+
+	return nil
+}
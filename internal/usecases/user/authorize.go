@@ -0,0 +1,43 @@
+package user
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/actor"
+	"bm-staff/pkg/errors"
+)
+
+// authorize checks the request actor against the user policies for an action on the target user
+func authorize(ctx context.Context, policyEngine *services.PolicyEngine, action string, target *entities.User) error {
+	return authorizeResource(ctx, policyEngine, action, services.UserPolicyResource(target), map[string]any{
+		"action": action,
+		"id":     target.ID.String(),
+	})
+}
+
+// authorizeResource checks the request actor against the user policies for an action on a policy resource
+// The details describe the resource in the error returned when the action is denied.
+func authorizeResource(ctx context.Context, policyEngine *services.PolicyEngine, action string, resource services.PolicyResource, details map[string]any) error {
+	current, ok := actor.FromContext(ctx)
+	if !ok {
+		return errors.NewBusinessError(errors.ErrAuthInsufficient, "Insufficient permissions", nil)
+	}
+
+	subject := &services.PolicySubject{
+		UserID: current.UserID,
+		RoleID: current.RoleID,
+	}
+
+	allowed, err := policyEngine.Authorize(ctx, subject, action, resource)
+	if err != nil {
+		return errors.WrapError(err, "SYS_001", "Failed to evaluate access policies")
+	}
+
+	if !allowed {
+		return errors.NewBusinessError(errors.ErrAuthInsufficient, "Insufficient permissions", details)
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/errors"
+)
+
+// ChangePasswordRequest represents the request to change the current user's password
+type ChangePasswordRequest struct {
+	UserID          string `json:"-" validate:"required,uuid"`
+	CurrentPassword string `json:"current_password" validate:"required"`
+	NewPassword     string `json:"new_password" validate:"required,min=8,max=128"`
+	IPAddress       string `json:"-"`
+	UserAgent       string `json:"-"`
+}
+
+// ChangePasswordResponse represents the response after changing the password
+// The tokens replace the caller's session, every other session has been signed out.
+type ChangePasswordResponse struct {
+	Tokens *services.TokenPair `json:"tokens"`
+}
+
+// ChangePasswordUseCase handles self-service password changes
+type ChangePasswordUseCase struct {
+	userRepo         repositories.UserRepository
+	refreshTokenRepo repositories.RefreshTokenRepository
+	passwordService  *services.PasswordService
+	passwordPolicy   *services.PasswordPolicy
+	jwtService       *services.JWTService
+	tokenRevoker     *services.TokenRevoker
+	transactor       repositories.Transactor
+	auditRecorder    *services.AuditRecorder
+}
+
+// NewChangePasswordUseCase creates a new change password use case
+func NewChangePasswordUseCase(userRepo repositories.UserRepository, refreshTokenRepo repositories.RefreshTokenRepository, passwordService *services.PasswordService, passwordPolicy *services.PasswordPolicy, jwtService *services.JWTService, tokenRevoker *services.TokenRevoker, transactor repositories.Transactor, auditRecorder *services.AuditRecorder) *ChangePasswordUseCase {
+	return &ChangePasswordUseCase{
+		userRepo:         userRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		passwordService:  passwordService,
+		passwordPolicy:   passwordPolicy,
+		jwtService:       jwtService,
+		tokenRevoker:     tokenRevoker,
+		transactor:       transactor,
+		auditRecorder:    auditRecorder,
+	}
+}
+
+// Execute verifies the current password, stores the new one and revokes the other sessions
+// All refresh tokens and earlier access tokens are revoked; the caller continues with the returned token pair.
+func (uc *ChangePasswordUseCase) Execute(ctx context.Context, req *ChangePasswordRequest) (*ChangePasswordResponse, error) {
+	user, err := getUser(ctx, uc.userRepo, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !uc.passwordService.VerifyPassword(req.CurrentPassword, user.PasswordHash, user.Salt) {
+		return nil, errors.NewValidationError("VAL_003", "Current password is incorrect", nil)
+	}
+
+	// Check the password policy, including reuse of the current and earlier passwords
+	violations, err := uc.passwordPolicy.Validate(ctx, user, req.NewPassword)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to check password history")
+	}
+	if len(violations) > 0 {
+		return nil, passwordPolicyError(violations)
+	}
+
+	passwordHash, salt, err := uc.passwordService.HashPassword(req.NewPassword)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to hash password")
+	}
+
+	before := services.Snapshot(user)
+	history := entities.NewPasswordHistory(user.ID, user.PasswordHash, user.Salt, &user.ID)
+	user.ChangePassword(passwordHash, salt, &user.ID)
+
+	tokens, err := uc.jwtService.GenerateTokenPair(user.ID, user.Username, user.Email, user.RoleID)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to generate tokens")
+	}
+
+	refreshToken := entities.NewRefreshToken(
+		user.ID,
+		tokens.RefreshToken,
+		time.Now().Add(7*24*time.Hour), // 7 days
+		req.IPAddress,
+		req.UserAgent,
+	)
+
+	// Store the password, replace every refresh token by the new one and record the change in one transaction
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			return errors.WrapError(err, "BIZ_001", "Failed to update user")
+		}
+
+		if err := uc.passwordPolicy.Remember(ctx, history); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to save password history")
+		}
+
+		if err := uc.refreshTokenRepo.RevokeAllForUser(ctx, user.ID.String()); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to revoke sessions")
+		}
+
+		if err := uc.refreshTokenRepo.Create(ctx, refreshToken); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to save refresh token")
+		}
+
+		return recordAudit(ctx, uc.auditRecorder, services.AuditActionUpdate, user.ID, before, services.Snapshot(user))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Revoke every other access token; the one just issued belongs to the new session
+	if err := uc.tokenRevoker.RevokeUserExcept(ctx, user.ID, tokens.AccessTokenID()); err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to revoke access tokens")
+	}
+
+	return &ChangePasswordResponse{
+		Tokens: tokens,
+	}, nil
+}
+
+// passwordPolicyError returns the validation error listing the password policy rules a new password breaks
+func passwordPolicyError(violations []string) error {
+	return errors.NewValidationError("VAL_003", "Password does not meet the password policy", map[string]any{
+		"violations": violations,
+	})
+}
@@ -0,0 +1,112 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/errors"
+)
+
+// ConfirmTOTPRequest represents the request to finish setting up an authenticator app with one of its codes
+type ConfirmTOTPRequest struct {
+	UserID string `json:"-" validate:"required,uuid"`
+	Code   string `json:"code" validate:"required,numeric,len=6"`
+}
+
+// ConfirmTOTPResponse represents the recovery codes issued when two-factor authentication is enabled
+// They are only shown here; each one can replace an authenticator code once.
+type ConfirmTOTPResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// ConfirmTOTPUseCase confirms TOTP enrollments, enabling two-factor authentication
+type ConfirmTOTPUseCase struct {
+	userRepo      repositories.UserRepository
+	mfaRepo       repositories.UserMFARepository
+	mfaService    *services.MFAService
+	totpService   *services.TOTPService
+	throttle      *services.Throttle
+	transactor    repositories.Transactor
+	auditRecorder *services.AuditRecorder
+}
+
+// NewConfirmTOTPUseCase creates a new confirm TOTP use case
+func NewConfirmTOTPUseCase(
+	userRepo repositories.UserRepository,
+	mfaRepo repositories.UserMFARepository,
+	mfaService *services.MFAService,
+	totpService *services.TOTPService,
+	throttle *services.Throttle,
+	transactor repositories.Transactor,
+	auditRecorder *services.AuditRecorder,
+) *ConfirmTOTPUseCase {
+	return &ConfirmTOTPUseCase{
+		userRepo:      userRepo,
+		mfaRepo:       mfaRepo,
+		mfaService:    mfaService,
+		totpService:   totpService,
+		throttle:      throttle,
+		transactor:    transactor,
+		auditRecorder: auditRecorder,
+	}
+}
+
+// Execute checks the code against the pending secret, enables two-factor authentication and issues recovery codes
+func (uc *ConfirmTOTPUseCase) Execute(ctx context.Context, req *ConfirmTOTPRequest) (*ConfirmTOTPResponse, error) {
+	user, err := getUser(ctx, uc.userRepo, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	mfa, enrolled, err := uc.mfaService.Enrollment(ctx, user.ID)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to get two-factor authentication")
+	}
+	if !enrolled {
+		return nil, errors.NewBusinessError("BIZ_001", "No authenticator enrollment found, start one first", nil)
+	}
+	if mfa.IsConfirmed() {
+		return nil, errors.NewBusinessError("BIZ_002", "Two-factor authentication is already enabled", nil)
+	}
+
+	if err := allowMFACode(uc.throttle, user.ID); err != nil {
+		return nil, err
+	}
+
+	secret, err := uc.totpService.Open(mfa.Secret)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to decrypt secret")
+	}
+	step, ok := uc.totpService.Validate(secret, req.Code, time.Now())
+	if !ok {
+		return nil, errors.NewValidationError("VAL_003", "Invalid authentication code", nil)
+	}
+
+	before := services.Snapshot(mfa)
+	mfa.Confirm(step, &user.ID)
+
+	// Enable two-factor authentication, issue recovery codes and record it in one transaction
+	var recoveryCodes []string
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.mfaRepo.Update(ctx, mfa); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to confirm enrollment")
+		}
+
+		var err error
+		recoveryCodes, err = uc.mfaService.IssueRecoveryCodes(ctx, user.ID)
+		if err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to issue recovery codes")
+		}
+
+		return recordMFAAudit(ctx, uc.auditRecorder, services.AuditActionUpdate, mfa.ID, before, services.Snapshot(mfa))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ConfirmTOTPResponse{
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
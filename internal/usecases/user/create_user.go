@@ -21,27 +21,41 @@ type CreateUserRequest struct {
 
 // CreateUserResponse represents the response after creating a user
 type CreateUserResponse struct {
-	User *entities.User `json:"user"`
+	User             *entities.User `json:"user"`
+	VerificationSent bool           `json:"verification_sent"` // A verification link was queued for the email address
 }
 
 // CreateUserUseCase handles user creation business logic
 type CreateUserUseCase struct {
-	userRepo        repositories.UserRepository
-	userService     *services.UserService
-	passwordService *services.PasswordService
+	userRepo          repositories.UserRepository
+	userService       *services.UserService
+	passwordService   *services.PasswordService
+	passwordPolicy    *services.PasswordPolicy
+	emailVerification *services.EmailVerificationService
+	transactor        repositories.Transactor
+	auditRecorder     *services.AuditRecorder
 }
 
 // NewCreateUserUseCase creates a new create user use case
-func NewCreateUserUseCase(userRepo repositories.UserRepository, userService *services.UserService, passwordService *services.PasswordService) *CreateUserUseCase {
+func NewCreateUserUseCase(userRepo repositories.UserRepository, userService *services.UserService, passwordService *services.PasswordService, passwordPolicy *services.PasswordPolicy, emailVerification *services.EmailVerificationService, transactor repositories.Transactor, auditRecorder *services.AuditRecorder) *CreateUserUseCase {
 	return &CreateUserUseCase{
-		userRepo:        userRepo,
-		userService:     userService,
-		passwordService: passwordService,
+		userRepo:          userRepo,
+		userService:       userService,
+		passwordService:   passwordService,
+		passwordPolicy:    passwordPolicy,
+		emailVerification: emailVerification,
+		transactor:        transactor,
+		auditRecorder:     auditRecorder,
 	}
 }
 
 // Execute creates a new user
 func (uc *CreateUserUseCase) Execute(ctx context.Context, req *CreateUserRequest) (*CreateUserResponse, error) {
+	// Check the password policy
+	if violations := uc.passwordPolicy.Check(req.Password, req.Username, req.Email); len(violations) > 0 {
+		return nil, passwordPolicyError(violations)
+	}
+
 	// Hash password
 	passwordHash, salt, err := uc.passwordService.HashPassword(req.Password)
 	if err != nil {
@@ -66,12 +80,23 @@ func (uc *CreateUserUseCase) Execute(ctx context.Context, req *CreateUserRequest
 		})
 	}
 
-	// Create user in repository
-	if err := uc.userRepo.Create(ctx, user); err != nil {
-		return nil, errors.WrapError(err, "BIZ_001", "Failed to create user")
+	// Create user and its audit record in one transaction
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.userRepo.Create(ctx, user); err != nil {
+			return errors.WrapError(err, "BIZ_001", "Failed to create user")
+		}
+
+		return recordAudit(ctx, uc.auditRecorder, services.AuditActionCreate, user.ID, "", services.Snapshot(user))
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	// The account exists now; a failed send is reported and can be retried through the resend endpoint
+	verificationSent := uc.emailVerification.SendVerification(ctx, user) == nil
+
 	return &CreateUserResponse{
-		User: user,
+		User:             user,
+		VerificationSent: verificationSent,
 	}, nil
 }
@@ -22,15 +22,25 @@ type DeleteUserResponse struct {
 
 // DeleteUserUseCase handles user deletion business logic
 type DeleteUserUseCase struct {
-	userRepo    repositories.UserRepository
-	userService *services.UserService
+	userRepo         repositories.UserRepository
+	refreshTokenRepo repositories.RefreshTokenRepository
+	userService      *services.UserService
+	policyEngine     *services.PolicyEngine
+	tokenRevoker     *services.TokenRevoker
+	transactor       repositories.Transactor
+	auditRecorder    *services.AuditRecorder
 }
 
 // NewDeleteUserUseCase creates a new delete user use case
-func NewDeleteUserUseCase(userRepo repositories.UserRepository, userService *services.UserService) *DeleteUserUseCase {
+func NewDeleteUserUseCase(userRepo repositories.UserRepository, refreshTokenRepo repositories.RefreshTokenRepository, userService *services.UserService, policyEngine *services.PolicyEngine, tokenRevoker *services.TokenRevoker, transactor repositories.Transactor, auditRecorder *services.AuditRecorder) *DeleteUserUseCase {
 	return &DeleteUserUseCase{
-		userRepo:    userRepo,
-		userService: userService,
+		userRepo:         userRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		userService:      userService,
+		policyEngine:     policyEngine,
+		tokenRevoker:     tokenRevoker,
+		transactor:       transactor,
+		auditRecorder:    auditRecorder,
 	}
 }
 
@@ -56,6 +66,11 @@ func (uc *DeleteUserUseCase) Execute(ctx context.Context, req *DeleteUserRequest
 		})
 	}
 
+	// Check the actor may delete this user
+	if err := authorize(ctx, uc.policyEngine, services.PolicyActionDelete, user); err != nil {
+		return nil, err
+	}
+
 	// Check if user can be deleted according to business rules
 	if err := uc.userService.CanDelete(ctx, user); err != nil {
 		return nil, errors.NewBusinessError("BIZ_002", "User cannot be deleted", map[string]any{
@@ -63,9 +78,25 @@ func (uc *DeleteUserUseCase) Execute(ctx context.Context, req *DeleteUserRequest
 		})
 	}
 
-	// Delete user from repository
-	if err := uc.userRepo.Delete(ctx, userID); err != nil {
-		return nil, errors.WrapError(err, "BIZ_001", "Failed to delete user")
+	// Delete user, end their sessions and record the change in one transaction
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.userRepo.Delete(ctx, userID); err != nil {
+			return errors.WrapError(err, "BIZ_001", "Failed to delete user")
+		}
+
+		if err := uc.refreshTokenRepo.RevokeAllForUser(ctx, user.ID.String()); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to revoke refresh tokens")
+		}
+
+		return recordAudit(ctx, uc.auditRecorder, services.AuditActionDelete, user.ID, services.Snapshot(user), "")
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Access tokens are not stored, deny them until they expire
+	if err := uc.tokenRevoker.RevokeUser(ctx, user.ID); err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to revoke access tokens")
 	}
 
 	return &DeleteUserResponse{
@@ -0,0 +1,79 @@
+package user
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/errors"
+)
+
+// DisableMFARequest represents the request to turn off two-factor authentication for the current user
+type DisableMFARequest struct {
+	UserID string `json:"-" validate:"required,uuid"`
+	Code   string `json:"code" validate:"required,min=6,max=20"` // Authenticator or recovery code
+}
+
+// DisableMFAResponse represents the response after turning off two-factor authentication
+type DisableMFAResponse struct {
+	Success bool `json:"success"`
+}
+
+// DisableMFAUseCase turns off two-factor authentication
+type DisableMFAUseCase struct {
+	userRepo      repositories.UserRepository
+	mfaService    *services.MFAService
+	throttle      *services.Throttle
+	transactor    repositories.Transactor
+	auditRecorder *services.AuditRecorder
+}
+
+// NewDisableMFAUseCase creates a new disable MFA use case
+func NewDisableMFAUseCase(
+	userRepo repositories.UserRepository,
+	mfaService *services.MFAService,
+	throttle *services.Throttle,
+	transactor repositories.Transactor,
+	auditRecorder *services.AuditRecorder,
+) *DisableMFAUseCase {
+	return &DisableMFAUseCase{
+		userRepo:      userRepo,
+		mfaService:    mfaService,
+		throttle:      throttle,
+		transactor:    transactor,
+		auditRecorder: auditRecorder,
+	}
+}
+
+// Execute checks the code and removes the enrollment and recovery codes
+func (uc *DisableMFAUseCase) Execute(ctx context.Context, req *DisableMFARequest) (*DisableMFAResponse, error) {
+	user, err := getUser(ctx, uc.userRepo, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	mfa, err := confirmedEnrollment(ctx, uc.mfaService, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := verifyMFACode(ctx, uc.mfaService, uc.throttle, mfa, req.Code); err != nil {
+		return nil, err
+	}
+
+	// Remove the enrollment and record it in one transaction
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.mfaService.Disable(ctx, user.ID); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to disable two-factor authentication")
+		}
+
+		return recordMFAAudit(ctx, uc.auditRecorder, services.AuditActionDelete, mfa.ID, services.Snapshot(mfa), "")
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &DisableMFAResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,118 @@
+package user
+
+import (
+	"context"
+	"encoding/base64"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/errors"
+	"bm-staff/pkg/qrcode"
+)
+
+// qrCodeScale is the number of PNG pixels per QR code module
+const qrCodeScale = 6
+
+// EnrollTOTPRequest represents the request to start setting up an authenticator app for the current user
+type EnrollTOTPRequest struct {
+	UserID string `json:"-" validate:"required,uuid"`
+}
+
+// EnrollTOTPResponse represents the secret to load into an authenticator app
+// The secret is only shown here; logins are not protected until the enrollment is confirmed.
+type EnrollTOTPResponse struct {
+	Secret string `json:"secret"`
+	URI    string `json:"otpauth_uri"`
+	QRCode string `json:"qr_code"` // PNG of the URI as a data: URL
+}
+
+// EnrollTOTPUseCase starts TOTP enrollments
+type EnrollTOTPUseCase struct {
+	userRepo      repositories.UserRepository
+	mfaRepo       repositories.UserMFARepository
+	mfaService    *services.MFAService
+	totpService   *services.TOTPService
+	transactor    repositories.Transactor
+	auditRecorder *services.AuditRecorder
+}
+
+// NewEnrollTOTPUseCase creates a new enroll TOTP use case
+func NewEnrollTOTPUseCase(
+	userRepo repositories.UserRepository,
+	mfaRepo repositories.UserMFARepository,
+	mfaService *services.MFAService,
+	totpService *services.TOTPService,
+	transactor repositories.Transactor,
+	auditRecorder *services.AuditRecorder,
+) *EnrollTOTPUseCase {
+	return &EnrollTOTPUseCase{
+		userRepo:      userRepo,
+		mfaRepo:       mfaRepo,
+		mfaService:    mfaService,
+		totpService:   totpService,
+		transactor:    transactor,
+		auditRecorder: auditRecorder,
+	}
+}
+
+// Execute generates a new secret for the user, replacing an unconfirmed enrollment
+func (uc *EnrollTOTPUseCase) Execute(ctx context.Context, req *EnrollTOTPRequest) (*EnrollTOTPResponse, error) {
+	user, err := getUser(ctx, uc.userRepo, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, enrolled, err := uc.mfaService.Enrollment(ctx, user.ID)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to get two-factor authentication")
+	}
+	if enrolled && existing.IsConfirmed() {
+		return nil, errors.NewBusinessError("BIZ_002", "Two-factor authentication is already enabled", nil)
+	}
+
+	secret, err := uc.totpService.GenerateSecret()
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to generate secret")
+	}
+	sealed, err := uc.totpService.Seal(secret)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to encrypt secret")
+	}
+
+	uri := uc.totpService.URI(secret, user.Username)
+	code, err := qrcode.Encode(uri)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to generate QR code")
+	}
+	png, err := code.PNG(qrCodeScale)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to generate QR code")
+	}
+
+	mfa := entities.NewUserMFA(user.ID, sealed, &user.ID)
+
+	// Replace an unconfirmed enrollment and record the new one in one transaction
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if enrolled {
+			if err := uc.mfaRepo.DeleteByUserID(ctx, user.ID.String()); err != nil {
+				return errors.WrapError(err, "SYS_001", "Failed to replace enrollment")
+			}
+		}
+
+		if err := uc.mfaRepo.Create(ctx, mfa); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to save enrollment")
+		}
+
+		return recordMFAAudit(ctx, uc.auditRecorder, services.AuditActionCreate, mfa.ID, "", services.Snapshot(mfa))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &EnrollTOTPResponse{
+		Secret: secret,
+		URI:    uri,
+		QRCode: "data:image/png;base64," + base64.StdEncoding.EncodeToString(png),
+	}, nil
+}
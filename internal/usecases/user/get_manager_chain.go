@@ -0,0 +1,58 @@
+package user
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/errors"
+)
+
+// GetManagerChainRequest represents the request to get the managers of a user
+type GetManagerChainRequest struct {
+	ID string `json:"id" validate:"required,uuid"`
+}
+
+// GetManagerChainResponse represents the managers of a user, nearest first
+type GetManagerChainResponse struct {
+	User     *entities.User `json:"user"`
+	Managers []*OrgMember   `json:"managers"`
+}
+
+// GetManagerChainUseCase handles manager chain retrieval business logic
+type GetManagerChainUseCase struct {
+	userRepo     repositories.UserRepository
+	policyEngine *services.PolicyEngine
+}
+
+// NewGetManagerChainUseCase creates a new get manager chain use case
+func NewGetManagerChainUseCase(userRepo repositories.UserRepository, policyEngine *services.PolicyEngine) *GetManagerChainUseCase {
+	return &GetManagerChainUseCase{
+		userRepo:     userRepo,
+		policyEngine: policyEngine,
+	}
+}
+
+// Execute retrieves the managers of a user up to the top of the reporting line
+func (uc *GetManagerChainUseCase) Execute(ctx context.Context, req *GetManagerChainRequest) (*GetManagerChainResponse, error) {
+	user, err := getUser(ctx, uc.userRepo, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the actor may read this user
+	if err := authorize(ctx, uc.policyEngine, services.PolicyActionRead, user); err != nil {
+		return nil, err
+	}
+
+	managers, err := uc.userRepo.GetManagerChain(ctx, user.ID)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to get manager chain")
+	}
+
+	return &GetManagerChainResponse{
+		User:     user,
+		Managers: orgMembers(managers),
+	}, nil
+}
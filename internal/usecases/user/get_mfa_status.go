@@ -0,0 +1,63 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/errors"
+)
+
+// GetMFAStatusRequest represents the request to get the current user's two-factor authentication status
+type GetMFAStatusRequest struct {
+	UserID string `json:"-" validate:"required,uuid"`
+}
+
+// GetMFAStatusResponse represents the two-factor authentication status of a user
+type GetMFAStatusResponse struct {
+	Enabled           bool       `json:"enabled"`
+	EnabledAt         *time.Time `json:"enabled_at,omitempty"`
+	RecoveryCodesLeft int        `json:"recovery_codes_left"`
+}
+
+// GetMFAStatusUseCase reports whether two-factor authentication is enabled
+type GetMFAStatusUseCase struct {
+	userRepo   repositories.UserRepository
+	mfaService *services.MFAService
+}
+
+// NewGetMFAStatusUseCase creates a new get MFA status use case
+func NewGetMFAStatusUseCase(userRepo repositories.UserRepository, mfaService *services.MFAService) *GetMFAStatusUseCase {
+	return &GetMFAStatusUseCase{
+		userRepo:   userRepo,
+		mfaService: mfaService,
+	}
+}
+
+// Execute returns the two-factor authentication status of the user
+func (uc *GetMFAStatusUseCase) Execute(ctx context.Context, req *GetMFAStatusRequest) (*GetMFAStatusResponse, error) {
+	user, err := getUser(ctx, uc.userRepo, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	mfa, enrolled, err := uc.mfaService.Enrollment(ctx, user.ID)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to get two-factor authentication")
+	}
+	if !enrolled || !mfa.IsConfirmed() {
+		return &GetMFAStatusResponse{}, nil
+	}
+
+	left, err := uc.mfaService.RemainingRecoveryCodes(ctx, user.ID)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to count recovery codes")
+	}
+
+	return &GetMFAStatusResponse{
+		Enabled:           true,
+		EnabledAt:         mfa.ConfirmedAt,
+		RecoveryCodesLeft: left,
+	}, nil
+}
@@ -0,0 +1,277 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// GetOrgChartRequest represents the request to export the organization chart
+// Without root_id the whole organization is exported.
+type GetOrgChartRequest struct {
+	RootID string `form:"root_id" validate:"omitempty,uuid"`
+	Format string `form:"format" validate:"omitempty,oneof=json dot"`
+}
+
+// OrgChartDepartment is the department of an org chart node
+type OrgChartDepartment struct {
+	ID   uuid.UUID `json:"id"`
+	Code string    `json:"code"`
+	Name string    `json:"name"`
+}
+
+// OrgChartNode is a user in the org chart with the users reporting to them nested
+type OrgChartNode struct {
+	ID                uuid.UUID           `json:"id"`
+	Username          string              `json:"username"`
+	FullName          string              `json:"full_name"`
+	EmployeeCode      string              `json:"employee_code,omitempty"`
+	Status            entities.UserStatus `json:"status"`
+	ManagerID         *uuid.UUID          `json:"manager_id,omitempty"`
+	Department        *OrgChartDepartment `json:"department,omitempty"`
+	ManagesDepartment bool                `json:"manages_department"`
+	// Cycle marks a user placed at the top because their reporting line loops back to them
+	Cycle   bool            `json:"cycle,omitempty"`
+	Reports []*OrgChartNode `json:"reports"`
+}
+
+// GetOrgChartResponse represents the organization chart as a forest of reporting lines
+type GetOrgChartResponse struct {
+	Roots []*OrgChartNode `json:"roots"`
+	Total int             `json:"total"`
+}
+
+// GetOrgChartUseCase handles org chart export business logic
+type GetOrgChartUseCase struct {
+	userRepo       repositories.UserRepository
+	departmentRepo repositories.DepartmentRepository
+}
+
+// NewGetOrgChartUseCase creates a new get org chart use case
+func NewGetOrgChartUseCase(userRepo repositories.UserRepository, departmentRepo repositories.DepartmentRepository) *GetOrgChartUseCase {
+	return &GetOrgChartUseCase{
+		userRepo:       userRepo,
+		departmentRepo: departmentRepo,
+	}
+}
+
+// Execute builds the org chart from MANAGER_ID reporting lines, below root_id when set
+// Users are grouped with their department and flagged when they are its manager (BMSF_DEPARTMENT.MANAGER_ID).
+func (uc *GetOrgChartUseCase) Execute(ctx context.Context, req *GetOrgChartRequest) (*GetOrgChartResponse, error) {
+	users, rootID, err := uc.loadUsers(ctx, req.RootID)
+	if err != nil {
+		return nil, err
+	}
+
+	departments, err := uc.loadDepartments(ctx, users)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetOrgChartResponse{
+		Roots: buildOrgChart(users, rootID, departments),
+		Total: len(users),
+	}, nil
+}
+
+// loadUsers loads the whole organization, or the root user followed by everyone reporting to them
+func (uc *GetOrgChartUseCase) loadUsers(ctx context.Context, rootValue string) ([]*entities.User, *uuid.UUID, error) {
+	if rootValue == "" {
+		users, err := uc.userRepo.ListOrganization(ctx)
+		if err != nil {
+			return nil, nil, errors.WrapError(err, "SYS_001", "Failed to list organization")
+		}
+		return users, nil, nil
+	}
+
+	root, err := getUser(ctx, uc.userRepo, rootValue)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reports, err := uc.userRepo.GetReports(ctx, root.ID, true)
+	if err != nil {
+		return nil, nil, errors.WrapError(err, "SYS_001", "Failed to get reports")
+	}
+
+	return append([]*entities.User{root}, reports...), &root.ID, nil
+}
+
+// loadDepartments loads the departments of the given users
+func (uc *GetOrgChartUseCase) loadDepartments(ctx context.Context, users []*entities.User) (map[uuid.UUID]*entities.Department, error) {
+	departments := make(map[uuid.UUID]*entities.Department)
+	for _, user := range users {
+		if user.DepartmentID == nil {
+			continue
+		}
+		if _, ok := departments[*user.DepartmentID]; ok {
+			continue
+		}
+
+		department, err := uc.departmentRepo.GetByID(ctx, *user.DepartmentID)
+		if err != nil {
+			return nil, errors.WrapError(err, "SYS_001", "Failed to get department")
+		}
+		departments[*user.DepartmentID] = department
+	}
+
+	return departments, nil
+}
+
+// buildOrgChart nests users under their managers
+// Users without a manager in the set are roots. Users left over afterwards sit on a reporting line cycle;
+// the first of each cycle becomes a root flagged with Cycle so the chart stays complete.
+func buildOrgChart(users []*entities.User, rootID *uuid.UUID, departments map[uuid.UUID]*entities.Department) []*OrgChartNode {
+	nodes := make(map[uuid.UUID]*OrgChartNode, len(users))
+	for _, user := range users {
+		nodes[user.ID] = newOrgChartNode(user, departments)
+	}
+
+	isRoot := func(user *entities.User) bool {
+		if rootID != nil {
+			return user.ID == *rootID
+		}
+		return user.ManagerID == nil || nodes[*user.ManagerID] == nil
+	}
+
+	reports := make(map[uuid.UUID][]*OrgChartNode)
+	for _, user := range users {
+		if !isRoot(user) {
+			reports[*user.ManagerID] = append(reports[*user.ManagerID], nodes[user.ID])
+		}
+	}
+
+	placed := make(map[uuid.UUID]bool, len(users))
+	var place func(node *OrgChartNode)
+	place = func(node *OrgChartNode) {
+		placed[node.ID] = true
+		for _, report := range reports[node.ID] {
+			if !placed[report.ID] {
+				node.Reports = append(node.Reports, report)
+				place(report)
+			}
+		}
+	}
+
+	roots := []*OrgChartNode{}
+	for _, user := range users {
+		if isRoot(user) {
+			roots = append(roots, nodes[user.ID])
+			place(nodes[user.ID])
+		}
+	}
+	for _, user := range users {
+		if !placed[user.ID] {
+			nodes[user.ID].Cycle = true
+			roots = append(roots, nodes[user.ID])
+			place(nodes[user.ID])
+		}
+	}
+
+	return roots
+}
+
+// newOrgChartNode creates the org chart node of a user
+func newOrgChartNode(user *entities.User, departments map[uuid.UUID]*entities.Department) *OrgChartNode {
+	node := &OrgChartNode{
+		ID:           user.ID,
+		Username:     user.Username,
+		FullName:     user.GetFullName(),
+		EmployeeCode: user.EmployeeCode,
+		Status:       user.Status,
+		ManagerID:    user.ManagerID,
+		Reports:      []*OrgChartNode{},
+	}
+
+	if user.DepartmentID != nil {
+		if department := departments[*user.DepartmentID]; department != nil {
+			node.Department = &OrgChartDepartment{
+				ID:   department.ID,
+				Code: department.Code,
+				Name: department.Name,
+			}
+			node.ManagesDepartment = department.ManagerID != nil && *department.ManagerID == user.ID
+		}
+	}
+
+	return node
+}
+
+// DOT renders the org chart as a Graphviz digraph
+// Departments are drawn as clusters, department managers in bold and inactive users dashed.
+func (r *GetOrgChartResponse) DOT() string {
+	var nodes []*OrgChartNode
+	var walk func(node *OrgChartNode)
+	walk = func(node *OrgChartNode) {
+		nodes = append(nodes, node)
+		for _, report := range node.Reports {
+			walk(report)
+		}
+	}
+	for _, root := range r.Roots {
+		walk(root)
+	}
+
+	var b strings.Builder
+	b.WriteString("digraph org_chart {\n")
+	b.WriteString("\trankdir=TB;\n")
+	b.WriteString("\tnode [shape=box, style=rounded];\n")
+
+	// Department clusters, in order of first appearance
+	var clusters []*OrgChartDepartment
+	members := make(map[uuid.UUID][]*OrgChartNode)
+	for _, node := range nodes {
+		if node.Department == nil {
+			continue
+		}
+		if _, ok := members[node.Department.ID]; !ok {
+			clusters = append(clusters, node.Department)
+		}
+		members[node.Department.ID] = append(members[node.Department.ID], node)
+	}
+	for _, department := range clusters {
+		fmt.Fprintf(&b, "\tsubgraph %s {\n", dotQuote("cluster_"+department.ID.String()))
+		fmt.Fprintf(&b, "\t\tlabel=%s;\n", dotQuote(department.Name))
+		for _, node := range members[department.ID] {
+			fmt.Fprintf(&b, "\t\t%s;\n", dotQuote(node.ID.String()))
+		}
+		b.WriteString("\t}\n")
+	}
+
+	for _, node := range nodes {
+		attrs := []string{"label=" + dotQuote(node.FullName+"\n@"+node.Username)}
+		if node.ManagesDepartment {
+			attrs = append(attrs, "penwidth=2")
+		}
+		if node.Status != entities.UserStatusActive {
+			attrs = append(attrs, `style="rounded,dashed"`)
+		}
+		if node.Cycle {
+			attrs = append(attrs, "color=red")
+		}
+		fmt.Fprintf(&b, "\t%s [%s];\n", dotQuote(node.ID.String()), strings.Join(attrs, ", "))
+	}
+
+	for _, node := range nodes {
+		for _, report := range node.Reports {
+			fmt.Fprintf(&b, "\t%s -> %s;\n", dotQuote(node.ID.String()), dotQuote(report.ID.String()))
+		}
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// dotQuote quotes a Graphviz ID, escaping quotes and turning newlines into line breaks
+func dotQuote(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `"`, `\"`)
+	value = strings.ReplaceAll(value, "\n", `\n`)
+	return `"` + value + `"`
+}
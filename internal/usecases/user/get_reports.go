@@ -0,0 +1,61 @@
+package user
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/errors"
+)
+
+// GetReportsRequest represents the request to get the users reporting to a user
+type GetReportsRequest struct {
+	ID         string `json:"id" validate:"required,uuid"`
+	Transitive bool   `form:"transitive"`
+}
+
+// GetReportsResponse represents the users reporting to a user
+type GetReportsResponse struct {
+	User       *entities.User `json:"user"`
+	Reports    []*OrgMember   `json:"reports"`
+	Transitive bool           `json:"transitive"`
+}
+
+// GetReportsUseCase handles reporting line retrieval business logic
+type GetReportsUseCase struct {
+	userRepo     repositories.UserRepository
+	policyEngine *services.PolicyEngine
+}
+
+// NewGetReportsUseCase creates a new get reports use case
+func NewGetReportsUseCase(userRepo repositories.UserRepository, policyEngine *services.PolicyEngine) *GetReportsUseCase {
+	return &GetReportsUseCase{
+		userRepo:     userRepo,
+		policyEngine: policyEngine,
+	}
+}
+
+// Execute retrieves the direct reports of a user, or every user below them when transitive
+func (uc *GetReportsUseCase) Execute(ctx context.Context, req *GetReportsRequest) (*GetReportsResponse, error) {
+	user, err := getUser(ctx, uc.userRepo, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the actor may read this user
+	if err := authorize(ctx, uc.policyEngine, services.PolicyActionRead, user); err != nil {
+		return nil, err
+	}
+
+	reports, err := uc.userRepo.GetReports(ctx, user.ID, req.Transitive)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to get reports")
+	}
+
+	return &GetReportsResponse{
+		User:       user,
+		Reports:    orgMembers(reports),
+		Transitive: req.Transitive,
+	}, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"bm-staff/internal/domain/entities"
 	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
 	"bm-staff/pkg/errors"
 
 	"github.com/google/uuid"
@@ -22,39 +23,54 @@ type GetUserResponse struct {
 
 // GetUserUseCase handles user retrieval business logic
 type GetUserUseCase struct {
-	userRepo repositories.UserRepository
+	userRepo     repositories.UserRepository
+	policyEngine *services.PolicyEngine
 }
 
 // NewGetUserUseCase creates a new get user use case
-func NewGetUserUseCase(userRepo repositories.UserRepository) *GetUserUseCase {
+func NewGetUserUseCase(userRepo repositories.UserRepository, policyEngine *services.PolicyEngine) *GetUserUseCase {
 	return &GetUserUseCase{
-		userRepo: userRepo,
+		userRepo:     userRepo,
+		policyEngine: policyEngine,
 	}
 }
 
 // Execute retrieves a user by ID
 func (uc *GetUserUseCase) Execute(ctx context.Context, req *GetUserRequest) (*GetUserResponse, error) {
-	// Parse UUID
-	userID, err := uuid.Parse(req.ID)
+	user, err := getUser(ctx, uc.userRepo, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the actor may read this user
+	if err := authorize(ctx, uc.policyEngine, services.PolicyActionRead, user); err != nil {
+		return nil, err
+	}
+
+	return &GetUserResponse{
+		User: user,
+	}, nil
+}
+
+// getUser parses a user ID and loads the user, returning BIZ_001 when it does not exist
+func getUser(ctx context.Context, userRepo repositories.UserRepository, id string) (*entities.User, error) {
+	userID, err := uuid.Parse(id)
 	if err != nil {
 		return nil, errors.NewValidationError("VAL_002", "Invalid user ID format", map[string]any{
-			"id": req.ID,
+			"id": id,
 		})
 	}
 
-	// Get user from repository
-	user, err := uc.userRepo.GetByID(ctx, userID)
+	user, err := userRepo.GetByID(ctx, userID)
 	if err != nil {
-		return nil, errors.WrapError(err, "BIZ_001", "Failed to get user")
+		return nil, errors.WrapError(err, "SYS_001", "Failed to get user")
 	}
 
 	if user == nil {
 		return nil, errors.NewBusinessError("BIZ_001", "User not found", map[string]any{
-			"id": req.ID,
+			"id": id,
 		})
 	}
 
-	return &GetUserResponse{
-		User: user,
-	}, nil
+	return user, nil
 }
@@ -0,0 +1,57 @@
+package user
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/errors"
+)
+
+// ListSessionsRequest represents the request to list a user's active sessions
+type ListSessionsRequest struct {
+	UserID string `json:"user_id" validate:"required,uuid"`
+}
+
+// ListSessionsResponse represents the response with a user's active sessions
+type ListSessionsResponse struct {
+	Sessions []*Session `json:"sessions"`
+}
+
+// ListSessionsUseCase handles session listing business logic
+type ListSessionsUseCase struct {
+	userRepo         repositories.UserRepository
+	refreshTokenRepo repositories.RefreshTokenRepository
+	policyEngine     *services.PolicyEngine
+}
+
+// NewListSessionsUseCase creates a new list sessions use case
+func NewListSessionsUseCase(userRepo repositories.UserRepository, refreshTokenRepo repositories.RefreshTokenRepository, policyEngine *services.PolicyEngine) *ListSessionsUseCase {
+	return &ListSessionsUseCase{
+		userRepo:         userRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		policyEngine:     policyEngine,
+	}
+}
+
+// Execute lists the active sessions of a user, most recently used first
+func (uc *ListSessionsUseCase) Execute(ctx context.Context, req *ListSessionsRequest) (*ListSessionsResponse, error) {
+	user, err := getUser(ctx, uc.userRepo, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the actor may manage this user's sessions
+	if err := authorizeSessions(ctx, uc.policyEngine, user); err != nil {
+		return nil, err
+	}
+
+	tokens, err := uc.refreshTokenRepo.GetByUserID(ctx, user.ID.String())
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to get sessions")
+	}
+
+	return &ListSessionsResponse{
+		Sessions: buildSessions(tokens),
+	}, nil
+}
@@ -0,0 +1,297 @@
+package user
+
+import (
+	"context"
+	stderrors "errors"
+	"time"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/pkg/errors"
+	"bm-staff/pkg/pagination"
+
+	"github.com/google/uuid"
+)
+
+// ListUsersRequest represents the request to list users
+type ListUsersRequest struct {
+	Status       string `form:"status" validate:"omitempty,oneof=ACTIVE INACTIVE PENDING BLOCKED"`
+	DepartmentID string `form:"department_id" validate:"omitempty,uuid"`
+	RoleID       string `form:"role_id" validate:"omitempty,uuid"`
+	ManagerID    string `form:"manager_id" validate:"omitempty,uuid"`
+	CreatedFrom  string `form:"created_from" validate:"omitempty"` // RFC3339
+	CreatedTo    string `form:"created_to" validate:"omitempty"`   // RFC3339
+	Search       string `form:"search" validate:"omitempty,max=100"`
+	SortBy       string `form:"sort_by" validate:"omitempty"`
+	SortOrder    string `form:"sort_order" validate:"omitempty,oneof=asc desc"`
+	Limit        int    `form:"limit" validate:"omitempty,min=1,max=100"`
+	Offset       int    `form:"offset" validate:"omitempty,min=0"`
+	Cursor       string `form:"cursor" validate:"omitempty,max=512"`
+}
+
+// userCursorToken is the signed payload of a user listing cursor
+type userCursorToken struct {
+	CreatedAt time.Time `json:"c"`
+	ID        uuid.UUID `json:"i"`
+	Backward  bool      `json:"b,omitempty"`
+}
+
+// userCursorScope holds the filters and sort order a user listing cursor is bound to
+type userCursorScope struct {
+	Status       *entities.UserStatus       `json:"st,omitempty"`
+	DepartmentID *uuid.UUID                 `json:"dep,omitempty"`
+	RoleID       *uuid.UUID                 `json:"rol,omitempty"`
+	ManagerID    *uuid.UUID                 `json:"mgr,omitempty"`
+	CreatedFrom  *time.Time                 `json:"cf,omitempty"`
+	CreatedTo    *time.Time                 `json:"ct,omitempty"`
+	Search       string                     `json:"q,omitempty"`
+	SortBy       repositories.UserSortField `json:"sb"`
+	SortDesc     bool                       `json:"sd,omitempty"`
+}
+
+// newUserCursorScope captures the parts of a filter that determine the listing order
+func newUserCursorScope(filter *repositories.UserListFilter) userCursorScope {
+	return userCursorScope{
+		Status:       filter.Status,
+		DepartmentID: filter.DepartmentID,
+		RoleID:       filter.RoleID,
+		ManagerID:    filter.ManagerID,
+		CreatedFrom:  filter.CreatedFrom,
+		CreatedTo:    filter.CreatedTo,
+		Search:       filter.Search,
+		SortBy:       filter.SortBy,
+		SortDesc:     filter.SortDesc,
+	}
+}
+
+// ListUsersResponse represents the response after listing users
+type ListUsersResponse struct {
+	Users      []*entities.User `json:"users"`
+	Pagination pagination.Page  `json:"pagination"`
+}
+
+// ListUsersUseCase handles user listing business logic
+type ListUsersUseCase struct {
+	userRepo    repositories.UserRepository
+	cursorCodec *pagination.CursorCodec
+}
+
+// NewListUsersUseCase creates a new list users use case
+func NewListUsersUseCase(userRepo repositories.UserRepository, cursorCodec *pagination.CursorCodec) *ListUsersUseCase {
+	return &ListUsersUseCase{
+		userRepo:    userRepo,
+		cursorCodec: cursorCodec,
+	}
+}
+
+// Execute lists users matching the request filters
+func (uc *ListUsersUseCase) Execute(ctx context.Context, req *ListUsersRequest) (*ListUsersResponse, error) {
+	filter, err := buildUserListFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Cursor != "" {
+		if err := uc.applyCursor(filter, req); err != nil {
+			return nil, err
+		}
+	}
+
+	// Fetch one extra row to know whether another page exists
+	pageFilter := *filter
+	pageFilter.Limit = filter.Limit + 1
+
+	// Get the page of users
+	users, err := uc.userRepo.List(ctx, &pageFilter)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to list users")
+	}
+
+	hasMore := len(users) > filter.Limit
+	if hasMore {
+		users = users[:filter.Limit]
+	}
+
+	// Backward keyset pages are read nearest-first
+	if filter.Before != nil {
+		for i, j := 0, len(users)-1; i < j; i, j = i+1, j-1 {
+			users[i], users[j] = users[j], users[i]
+		}
+	}
+
+	// Get the total honouring the same filters
+	total, err := uc.userRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to count users")
+	}
+
+	if users == nil {
+		users = []*entities.User{}
+	}
+
+	page := pagination.Page{
+		Limit:  filter.Limit,
+		Offset: filter.Offset,
+		Total:  total,
+	}
+	if err := uc.setCursors(&page, filter, users, hasMore); err != nil {
+		return nil, err
+	}
+
+	return &ListUsersResponse{
+		Users:      users,
+		Pagination: page,
+	}, nil
+}
+
+// applyCursor switches the filter to keyset pagination from a signed cursor token
+func (uc *ListUsersUseCase) applyCursor(filter *repositories.UserListFilter, req *ListUsersRequest) error {
+	if req.Offset > 0 {
+		return errors.NewValidationError("VAL_002", "cursor cannot be combined with offset", nil)
+	}
+	if req.SortBy != "" && repositories.UserSortField(req.SortBy) != repositories.UserSortByCreatedAt {
+		return errors.NewValidationError("VAL_002", "cursor pagination only supports sort_by=created_at", map[string]any{
+			"sort_by": req.SortBy,
+		})
+	}
+
+	var token userCursorToken
+	if err := uc.cursorCodec.Decode(req.Cursor, newUserCursorScope(filter), &token); err != nil {
+		if stderrors.Is(err, pagination.ErrCursorMismatch) {
+			return errors.NewValidationError("VAL_002", "Cursor does not match the listing filters or sort order", nil)
+		}
+		return errors.NewValidationError("VAL_002", "Invalid cursor", nil)
+	}
+
+	cursor := &repositories.UserCursor{CreatedAt: token.CreatedAt, ID: token.ID}
+	if token.Backward {
+		filter.Before = cursor
+	} else {
+		filter.After = cursor
+	}
+	filter.Offset = 0
+
+	return nil
+}
+
+// setCursors fills the next/previous page cursors, only listings ordered by created_at support them
+func (uc *ListUsersUseCase) setCursors(page *pagination.Page, filter *repositories.UserListFilter, users []*entities.User, hasMore bool) error {
+	if filter.SortBy != repositories.UserSortByCreatedAt || len(users) == 0 {
+		return nil
+	}
+
+	scope := newUserCursorScope(filter)
+	hasNext := hasMore
+	hasPrev := filter.Offset > 0 || filter.After != nil
+	if filter.Before != nil {
+		hasNext, hasPrev = true, hasMore
+	}
+
+	if hasNext {
+		last := users[len(users)-1]
+		next, err := uc.cursorCodec.Encode(scope, userCursorToken{CreatedAt: last.CreatedAt, ID: last.ID})
+		if err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to encode cursor")
+		}
+		page.NextCursor = next
+	}
+
+	if hasPrev {
+		first := users[0]
+		prev, err := uc.cursorCodec.Encode(scope, userCursorToken{CreatedAt: first.CreatedAt, ID: first.ID, Backward: true})
+		if err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to encode cursor")
+		}
+		page.PrevCursor = prev
+	}
+
+	return nil
+}
+
+// buildUserListFilter converts a list request into a repository filter
+func buildUserListFilter(req *ListUsersRequest) (*repositories.UserListFilter, error) {
+	filter := &repositories.UserListFilter{
+		Search:   req.Search,
+		SortBy:   repositories.UserSortByCreatedAt,
+		SortDesc: true,
+	}
+	filter.Limit, filter.Offset = pagination.Normalize(req.Limit, req.Offset)
+
+	if req.Status != "" {
+		status := entities.UserStatus(req.Status)
+		if !status.IsValid() {
+			return nil, errors.NewValidationError("VAL_002", "Invalid user status", map[string]any{
+				"status": req.Status,
+			})
+		}
+		filter.Status = &status
+	}
+
+	var err error
+	if filter.DepartmentID, err = parseOptionalUUID("department_id", req.DepartmentID); err != nil {
+		return nil, err
+	}
+	if filter.RoleID, err = parseOptionalUUID("role_id", req.RoleID); err != nil {
+		return nil, err
+	}
+	if filter.ManagerID, err = parseOptionalUUID("manager_id", req.ManagerID); err != nil {
+		return nil, err
+	}
+	if filter.CreatedFrom, err = parseOptionalTime("created_from", req.CreatedFrom); err != nil {
+		return nil, err
+	}
+	if filter.CreatedTo, err = parseOptionalTime("created_to", req.CreatedTo); err != nil {
+		return nil, err
+	}
+	if filter.CreatedFrom != nil && filter.CreatedTo != nil && filter.CreatedFrom.After(*filter.CreatedTo) {
+		return nil, errors.NewValidationError("VAL_003", "created_from must be before created_to", nil)
+	}
+
+	if req.SortBy != "" {
+		sortBy := repositories.UserSortField(req.SortBy)
+		if !sortBy.IsValid() {
+			return nil, errors.NewValidationError("VAL_002", "Invalid sort field", map[string]any{
+				"sort_by": req.SortBy,
+			})
+		}
+		filter.SortBy = sortBy
+		// Explicit sort fields default to ascending order
+		filter.SortDesc = false
+	}
+	switch req.SortOrder {
+	case "asc":
+		filter.SortDesc = false
+	case "desc":
+		filter.SortDesc = true
+	}
+
+	return filter, nil
+}
+
+// parseOptionalUUID parses a UUID query value, returning nil when empty
+func parseOptionalUUID(field, value string) (*uuid.UUID, error) {
+	if value == "" {
+		return nil, nil
+	}
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil, errors.NewValidationError("VAL_002", "Invalid "+field+" format", map[string]any{
+			field: value,
+		})
+	}
+	return &id, nil
+}
+
+// parseOptionalTime parses an RFC3339 query value, returning nil when empty
+func parseOptionalTime(field, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, errors.NewValidationError("VAL_002", "Invalid "+field+" format, expected RFC3339", map[string]any{
+			field: value,
+		})
+	}
+	return &t, nil
+}
@@ -0,0 +1,73 @@
+package user
+
+import (
+	"context"
+	stderrors "errors"
+	"math"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// confirmedEnrollment loads the confirmed TOTP enrollment of a user, returning BIZ_001 when
+// two-factor authentication is not enabled
+func confirmedEnrollment(ctx context.Context, mfaService *services.MFAService, userID uuid.UUID) (*entities.UserMFA, error) {
+	mfa, enrolled, err := mfaService.Enrollment(ctx, userID)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to get two-factor authentication")
+	}
+
+	if !enrolled || !mfa.IsConfirmed() {
+		return nil, errors.NewBusinessError("BIZ_001", "Two-factor authentication is not enabled", nil)
+	}
+
+	return mfa, nil
+}
+
+// allowMFACode counts a code entry of the user, returning BIZ_003 when too many were entered
+// Entries share the per-user throttle of the second login step, so codes cannot be guessed here instead.
+func allowMFACode(throttle *services.Throttle, userID uuid.UUID) error {
+	if allowed, wait := throttle.Allow(userID.String()); !allowed {
+		return errors.NewBusinessError("BIZ_003", "Too many authentication codes entered, try again later", map[string]any{
+			"retry_after": int(math.Ceil(wait.Seconds())),
+		})
+	}
+
+	return nil
+}
+
+// verifyMFACode checks an authenticator or recovery code of the user, returning VAL_003 when it is wrong
+func verifyMFACode(ctx context.Context, mfaService *services.MFAService, throttle *services.Throttle, mfa *entities.UserMFA, code string) error {
+	if err := allowMFACode(throttle, mfa.UserID); err != nil {
+		return err
+	}
+
+	_, err := mfaService.VerifyCode(ctx, mfa, code)
+	if stderrors.Is(err, services.ErrInvalidMFACode) {
+		return errors.NewValidationError("VAL_003", "Invalid authentication code", nil)
+	}
+	if err != nil {
+		return errors.WrapError(err, "SYS_001", "Failed to verify authentication code")
+	}
+
+	return nil
+}
+
+// recordMFAAudit writes the audit record of a change to a TOTP enrollment, inside the caller's transaction
+func recordMFAAudit(ctx context.Context, auditRecorder *services.AuditRecorder, action string, mfaID uuid.UUID, oldValues, newValues string) error {
+	err := auditRecorder.Record(ctx, services.AuditEntry{
+		Action:     action,
+		Resource:   services.AuditResourceUserMFA,
+		ResourceID: &mfaID,
+		OldValues:  oldValues,
+		NewValues:  newValues,
+	})
+	if err != nil {
+		return errors.WrapError(err, "SYS_001", "Failed to record audit log")
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package user
+
+import (
+	"bm-staff/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// OrgMember is the org chart view of a user listed in another user's reporting line
+// Reading a user does not allow reading their managers or reports, so only their place in the organization is shown.
+type OrgMember struct {
+	ID           uuid.UUID  `json:"id"`
+	FullName     string     `json:"full_name"`
+	DepartmentID *uuid.UUID `json:"department_id,omitempty"`
+	ManagerID    *uuid.UUID `json:"manager_id,omitempty"`
+}
+
+// orgMembers projects users onto their org chart view, keeping their order
+func orgMembers(users []*entities.User) []*OrgMember {
+	members := make([]*OrgMember, 0, len(users))
+	for _, user := range users {
+		members = append(members, &OrgMember{
+			ID:           user.ID,
+			FullName:     user.GetFullName(),
+			DepartmentID: user.DepartmentID,
+			ManagerID:    user.ManagerID,
+		})
+	}
+	return members
+}
@@ -0,0 +1,82 @@
+package user
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/errors"
+)
+
+// RegenerateRecoveryCodesRequest represents the request to replace the current user's recovery codes
+type RegenerateRecoveryCodesRequest struct {
+	UserID string `json:"-" validate:"required,uuid"`
+	Code   string `json:"code" validate:"required,min=6,max=20"` // Authenticator or recovery code
+}
+
+// RegenerateRecoveryCodesResponse represents the new recovery codes, shown only once
+type RegenerateRecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// RegenerateRecoveryCodesUseCase replaces recovery codes
+type RegenerateRecoveryCodesUseCase struct {
+	userRepo      repositories.UserRepository
+	mfaService    *services.MFAService
+	throttle      *services.Throttle
+	transactor    repositories.Transactor
+	auditRecorder *services.AuditRecorder
+}
+
+// NewRegenerateRecoveryCodesUseCase creates a new regenerate recovery codes use case
+func NewRegenerateRecoveryCodesUseCase(
+	userRepo repositories.UserRepository,
+	mfaService *services.MFAService,
+	throttle *services.Throttle,
+	transactor repositories.Transactor,
+	auditRecorder *services.AuditRecorder,
+) *RegenerateRecoveryCodesUseCase {
+	return &RegenerateRecoveryCodesUseCase{
+		userRepo:      userRepo,
+		mfaService:    mfaService,
+		throttle:      throttle,
+		transactor:    transactor,
+		auditRecorder: auditRecorder,
+	}
+}
+
+// Execute checks the code and issues a new set of recovery codes; the previous ones stop working
+func (uc *RegenerateRecoveryCodesUseCase) Execute(ctx context.Context, req *RegenerateRecoveryCodesRequest) (*RegenerateRecoveryCodesResponse, error) {
+	user, err := getUser(ctx, uc.userRepo, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	mfa, err := confirmedEnrollment(ctx, uc.mfaService, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := verifyMFACode(ctx, uc.mfaService, uc.throttle, mfa, req.Code); err != nil {
+		return nil, err
+	}
+
+	// Replace the recovery codes and record it in one transaction
+	var recoveryCodes []string
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		recoveryCodes, err = uc.mfaService.IssueRecoveryCodes(ctx, user.ID)
+		if err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to issue recovery codes")
+		}
+
+		return recordMFAAudit(ctx, uc.auditRecorder, services.AuditActionMFARecoveryCodes, mfa.ID, "", "")
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &RegenerateRecoveryCodesResponse{
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
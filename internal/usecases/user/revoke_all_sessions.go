@@ -0,0 +1,75 @@
+package user
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/errors"
+)
+
+// RevokeAllSessionsRequest represents the request to sign a user out everywhere
+type RevokeAllSessionsRequest struct {
+	UserID string `json:"user_id" validate:"required,uuid"`
+}
+
+// RevokeAllSessionsResponse represents the response after revoking every session of a user
+type RevokeAllSessionsResponse struct {
+	Success bool `json:"success"`
+}
+
+// RevokeAllSessionsUseCase handles signing a user out of every session
+type RevokeAllSessionsUseCase struct {
+	userRepo         repositories.UserRepository
+	refreshTokenRepo repositories.RefreshTokenRepository
+	policyEngine     *services.PolicyEngine
+	tokenRevoker     *services.TokenRevoker
+	transactor       repositories.Transactor
+	auditRecorder    *services.AuditRecorder
+}
+
+// NewRevokeAllSessionsUseCase creates a new revoke all sessions use case
+func NewRevokeAllSessionsUseCase(userRepo repositories.UserRepository, refreshTokenRepo repositories.RefreshTokenRepository, policyEngine *services.PolicyEngine, tokenRevoker *services.TokenRevoker, transactor repositories.Transactor, auditRecorder *services.AuditRecorder) *RevokeAllSessionsUseCase {
+	return &RevokeAllSessionsUseCase{
+		userRepo:         userRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		policyEngine:     policyEngine,
+		tokenRevoker:     tokenRevoker,
+		transactor:       transactor,
+		auditRecorder:    auditRecorder,
+	}
+}
+
+// Execute revokes every refresh token of a user and denies the access tokens issued so far,
+// including the one of the current request
+func (uc *RevokeAllSessionsUseCase) Execute(ctx context.Context, req *RevokeAllSessionsRequest) (*RevokeAllSessionsResponse, error) {
+	user, err := getUser(ctx, uc.userRepo, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the actor may manage this user's sessions
+	if err := authorizeSessions(ctx, uc.policyEngine, user); err != nil {
+		return nil, err
+	}
+
+	// Revoke the refresh tokens and record it in one transaction
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.refreshTokenRepo.RevokeAllForUser(ctx, user.ID.String()); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to revoke sessions")
+		}
+
+		return recordSessionAudit(ctx, uc.auditRecorder, user.ID, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.tokenRevoker.RevokeUser(ctx, user.ID); err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to revoke access tokens")
+	}
+
+	return &RevokeAllSessionsResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,98 @@
+package user
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// RevokeSessionRequest represents the request to sign a user out of one session
+type RevokeSessionRequest struct {
+	UserID    string `json:"user_id" validate:"required,uuid"`
+	SessionID string `json:"session_id" validate:"required,uuid"`
+}
+
+// RevokeSessionResponse represents the response after revoking a session
+type RevokeSessionResponse struct {
+	Success bool `json:"success"`
+}
+
+// RevokeSessionUseCase handles session revocation business logic
+type RevokeSessionUseCase struct {
+	userRepo         repositories.UserRepository
+	refreshTokenRepo repositories.RefreshTokenRepository
+	policyEngine     *services.PolicyEngine
+	transactor       repositories.Transactor
+	auditRecorder    *services.AuditRecorder
+}
+
+// NewRevokeSessionUseCase creates a new revoke session use case
+func NewRevokeSessionUseCase(userRepo repositories.UserRepository, refreshTokenRepo repositories.RefreshTokenRepository, policyEngine *services.PolicyEngine, transactor repositories.Transactor, auditRecorder *services.AuditRecorder) *RevokeSessionUseCase {
+	return &RevokeSessionUseCase{
+		userRepo:         userRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		policyEngine:     policyEngine,
+		transactor:       transactor,
+		auditRecorder:    auditRecorder,
+	}
+}
+
+// Execute revokes the refresh token family of a session
+// Access tokens already issued to the session stay valid until they expire.
+func (uc *RevokeSessionUseCase) Execute(ctx context.Context, req *RevokeSessionRequest) (*RevokeSessionResponse, error) {
+	user, err := getUser(ctx, uc.userRepo, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the actor may manage this user's sessions
+	if err := authorizeSessions(ctx, uc.policyEngine, user); err != nil {
+		return nil, err
+	}
+
+	sessionID, err := uuid.Parse(req.SessionID)
+	if err != nil {
+		return nil, errors.NewValidationError("VAL_002", "Invalid session ID format", map[string]any{
+			"session_id": req.SessionID,
+		})
+	}
+
+	// Only active sessions of this user can be revoked
+	tokens, err := uc.refreshTokenRepo.GetByUserID(ctx, user.ID.String())
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to get sessions")
+	}
+
+	found := false
+	for _, session := range buildSessions(tokens) {
+		if session.ID == sessionID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, errors.NewBusinessError("BIZ_001", "Session not found", map[string]any{
+			"session_id": req.SessionID,
+		})
+	}
+
+	// Revoke the session and record it in one transaction
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.refreshTokenRepo.RevokeFamily(ctx, sessionID.String()); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to revoke session")
+		}
+
+		return recordSessionAudit(ctx, uc.auditRecorder, user.ID, &sessionID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &RevokeSessionResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,125 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/errors"
+)
+
+// SendPhoneVerificationRequest represents the request to text a verification code to the current user's phone
+type SendPhoneVerificationRequest struct {
+	UserID string `json:"-" validate:"required,uuid"`
+}
+
+// SendPhoneVerificationResponse represents the response after sending a verification code
+type SendPhoneVerificationResponse struct {
+	Phone     string    `json:"phone"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// SendPhoneVerificationUseCase texts one-time codes that verify a user's phone number
+type SendPhoneVerificationUseCase struct {
+	userRepo         repositories.UserRepository
+	verificationRepo repositories.PhoneVerificationRepository
+	otpService       *services.OTPService
+	smsSender        services.SMSSender
+	throttle         *services.Throttle
+	transactor       repositories.Transactor
+	auditRecorder    *services.AuditRecorder
+	codeExpiry       time.Duration
+}
+
+// NewSendPhoneVerificationUseCase creates a new send phone verification use case
+func NewSendPhoneVerificationUseCase(
+	userRepo repositories.UserRepository,
+	verificationRepo repositories.PhoneVerificationRepository,
+	otpService *services.OTPService,
+	smsSender services.SMSSender,
+	throttle *services.Throttle,
+	transactor repositories.Transactor,
+	auditRecorder *services.AuditRecorder,
+	codeExpiry time.Duration,
+) *SendPhoneVerificationUseCase {
+	return &SendPhoneVerificationUseCase{
+		userRepo:         userRepo,
+		verificationRepo: verificationRepo,
+		otpService:       otpService,
+		smsSender:        smsSender,
+		throttle:         throttle,
+		transactor:       transactor,
+		auditRecorder:    auditRecorder,
+		codeExpiry:       codeExpiry,
+	}
+}
+
+// Execute texts a new code to the user's phone number; earlier codes stop working
+func (uc *SendPhoneVerificationUseCase) Execute(ctx context.Context, req *SendPhoneVerificationRequest) (*SendPhoneVerificationResponse, error) {
+	user, err := getUser(ctx, uc.userRepo, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Phone == "" {
+		return nil, errors.NewValidationError("VAL_001", "The account has no phone number", nil)
+	}
+
+	if user.PhoneVerified {
+		return nil, errors.NewBusinessError("BIZ_002", "Phone number is already verified", nil)
+	}
+
+	if allowed, wait := uc.throttle.Allow(user.ID.String()); !allowed {
+		return nil, errors.NewBusinessError("BIZ_003", "Too many verification codes requested, try again later", map[string]any{
+			"retry_after": int(math.Ceil(wait.Seconds())),
+		})
+	}
+
+	code, err := uc.otpService.Generate()
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to generate verification code")
+	}
+
+	verification := entities.NewPhoneVerification(user.ID, user.Phone, time.Now().Add(uc.codeExpiry))
+	verification.CodeHash = uc.otpService.Hash(verification.ID.String(), code)
+
+	// Replace outstanding codes and record the new one in one transaction
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.verificationRepo.InvalidateAllForUser(ctx, user.ID.String()); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to invalidate verification codes")
+		}
+
+		if err := uc.verificationRepo.Create(ctx, verification); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to save verification code")
+		}
+
+		err := uc.auditRecorder.Record(ctx, services.AuditEntry{
+			Action:     services.AuditActionCreate,
+			Resource:   services.AuditResourcePhoneVerifications,
+			ResourceID: &verification.ID,
+			NewValues:  services.Snapshot(verification),
+		})
+		if err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to record audit log")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	message := fmt.Sprintf("Your BM Staff verification code is %s. It expires in %s. Do not share it with anyone.", code, uc.codeExpiry)
+	if err := uc.smsSender.Send(ctx, user.Phone, message); err != nil {
+		return nil, errors.WrapError(err, "EXT_002", "Failed to send verification code")
+	}
+
+	return &SendPhoneVerificationResponse{
+		Phone:     user.Phone,
+		ExpiresAt: verification.ExpiresAt,
+	}, nil
+}
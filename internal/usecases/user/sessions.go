@@ -0,0 +1,88 @@
+package user
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/actor"
+	"bm-staff/pkg/errors"
+	"bm-staff/pkg/useragent"
+
+	"github.com/google/uuid"
+)
+
+// Session is a signed-in device of a user: the valid refresh token of a token family
+type Session struct {
+	ID         uuid.UUID      `json:"id"` // Token family ID, stable across refresh token rotations
+	IPAddress  string         `json:"ip_address"`
+	UserAgent  string         `json:"user_agent"`
+	Client     useragent.Info `json:"client"`
+	CreatedAt  time.Time      `json:"created_at"`   // Login that started the session
+	LastUsedAt time.Time      `json:"last_used_at"` // Last login or token refresh
+	ExpiresAt  time.Time      `json:"expires_at"`
+}
+
+// buildSessions groups refresh tokens by family into the user's active sessions, most recently used first
+func buildSessions(tokens []*entities.RefreshToken) []*Session {
+	startedAt := make(map[uuid.UUID]time.Time)
+	for _, token := range tokens {
+		family := token.Family()
+		if started, ok := startedAt[family]; !ok || token.CreatedAt.Before(started) {
+			startedAt[family] = token.CreatedAt
+		}
+	}
+
+	sessions := make([]*Session, 0)
+	for _, token := range tokens {
+		if !token.IsValid() {
+			continue
+		}
+		sessions = append(sessions, &Session{
+			ID:         token.Family(),
+			IPAddress:  token.IPAddress,
+			UserAgent:  token.UserAgent,
+			Client:     useragent.Parse(token.UserAgent),
+			CreatedAt:  startedAt[token.Family()],
+			LastUsedAt: token.CreatedAt,
+			ExpiresAt:  token.ExpiresAt,
+		})
+	}
+
+	sort.SliceStable(sessions, func(i, j int) bool {
+		return sessions[i].LastUsedAt.After(sessions[j].LastUsedAt)
+	})
+	return sessions
+}
+
+// authorizeSessions lets users manage their own sessions and otherwise requires the right to update the user
+func authorizeSessions(ctx context.Context, policyEngine *services.PolicyEngine, target *entities.User) error {
+	if current, ok := actor.FromContext(ctx); ok && current.UserID == target.ID {
+		return nil
+	}
+
+	return authorize(ctx, policyEngine, services.PolicyActionUpdate, target)
+}
+
+// recordSessionAudit writes the audit record of revoked sessions, inside the caller's transaction
+// A nil sessionID stands for every session of the user.
+func recordSessionAudit(ctx context.Context, auditRecorder *services.AuditRecorder, userID uuid.UUID, sessionID *uuid.UUID) error {
+	revoked := map[string]any{"user_id": userID, "session_id": "all"}
+	if sessionID != nil {
+		revoked["session_id"] = sessionID
+	}
+
+	err := auditRecorder.Record(ctx, services.AuditEntry{
+		Action:     services.AuditActionLogout,
+		Resource:   services.AuditResourceRefreshTokens,
+		ResourceID: sessionID,
+		NewValues:  services.Snapshot(revoked),
+	})
+	if err != nil {
+		return errors.WrapError(err, "SYS_001", "Failed to record audit log")
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package user
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/actor"
+	"bm-staff/pkg/errors"
+)
+
+// UnlockUserRequest represents the request to lift the lockout of a user after failed logins
+type UnlockUserRequest struct {
+	ID string `json:"id" validate:"required,uuid"`
+}
+
+// UnlockUserResponse represents the response after unlocking a user
+type UnlockUserResponse struct {
+	User *entities.User `json:"user"`
+}
+
+// UnlockUserUseCase handles unlocking user accounts
+type UnlockUserUseCase struct {
+	userRepo      repositories.UserRepository
+	loginThrottle *services.LoginThrottle
+	transactor    repositories.Transactor
+	auditRecorder *services.AuditRecorder
+}
+
+// NewUnlockUserUseCase creates a new unlock user use case
+func NewUnlockUserUseCase(userRepo repositories.UserRepository, loginThrottle *services.LoginThrottle, transactor repositories.Transactor, auditRecorder *services.AuditRecorder) *UnlockUserUseCase {
+	return &UnlockUserUseCase{
+		userRepo:      userRepo,
+		loginThrottle: loginThrottle,
+		transactor:    transactor,
+		auditRecorder: auditRecorder,
+	}
+}
+
+// Execute clears the failed logins and lockout of a user, along with the failures counted for their username
+// Failures counted per client IP are kept. Access is granted by the users:unlock permission on the route.
+func (uc *UnlockUserUseCase) Execute(ctx context.Context, req *UnlockUserRequest) (*UnlockUserResponse, error) {
+	user, err := getUser(ctx, uc.userRepo, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	before := services.Snapshot(user)
+	user.UnlockAccount(actor.UserID(ctx))
+
+	// Unlock the account and record the change in one transaction
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			return errors.WrapError(err, "BIZ_001", "Failed to update user")
+		}
+
+		return recordAudit(ctx, uc.auditRecorder, services.AuditActionUnlock, user.ID, before, services.Snapshot(user))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.loginThrottle.Reset(ctx, user.Username); err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to reset login attempts")
+	}
+
+	return &UnlockUserResponse{
+		User: user,
+	}, nil
+}
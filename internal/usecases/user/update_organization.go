@@ -0,0 +1,178 @@
+package user
+
+import (
+	"context"
+	stderrors "errors"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/actor"
+	"bm-staff/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// UpdateOrganizationRequest represents the request to update a user's department, manager and employee code
+// Empty department_id or manager_id values clear them. The role is assigned separately.
+type UpdateOrganizationRequest struct {
+	ID           string `json:"id" validate:"required,uuid"`
+	DepartmentID string `json:"department_id" validate:"omitempty,uuid"`
+	ManagerID    string `json:"manager_id" validate:"omitempty,uuid"`
+	EmployeeCode string `json:"employee_code" validate:"omitempty,max=50"`
+}
+
+// UpdateOrganizationResponse represents the response after updating a user's organization
+type UpdateOrganizationResponse struct {
+	User *entities.User `json:"user"`
+}
+
+// UpdateOrganizationUseCase handles organization update business logic
+type UpdateOrganizationUseCase struct {
+	userRepo       repositories.UserRepository
+	departmentRepo repositories.DepartmentRepository
+	userService    *services.UserService
+	policyEngine   *services.PolicyEngine
+	transactor     repositories.Transactor
+	auditRecorder  *services.AuditRecorder
+}
+
+// NewUpdateOrganizationUseCase creates a new update organization use case
+func NewUpdateOrganizationUseCase(userRepo repositories.UserRepository, departmentRepo repositories.DepartmentRepository, userService *services.UserService, policyEngine *services.PolicyEngine, transactor repositories.Transactor, auditRecorder *services.AuditRecorder) *UpdateOrganizationUseCase {
+	return &UpdateOrganizationUseCase{
+		userRepo:       userRepo,
+		departmentRepo: departmentRepo,
+		userService:    userService,
+		policyEngine:   policyEngine,
+		transactor:     transactor,
+		auditRecorder:  auditRecorder,
+	}
+}
+
+// Execute updates the department, manager and employee code of a user
+// A manager that is the user or reports to the user, directly or transitively, is rejected. The actor must also
+// be allowed to update users of the new department and the new manager, so users cannot be moved out of reach.
+func (uc *UpdateOrganizationUseCase) Execute(ctx context.Context, req *UpdateOrganizationRequest) (*UpdateOrganizationResponse, error) {
+	user, err := getUser(ctx, uc.userRepo, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the actor may update this user
+	if err := authorize(ctx, uc.policyEngine, services.PolicyActionUpdate, user); err != nil {
+		return nil, err
+	}
+
+	departmentID, err := uc.resolveDepartment(ctx, req.DepartmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	managerID, err := uc.resolveManager(ctx, user, req.ManagerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.authorizeDestination(ctx, user, departmentID, managerID); err != nil {
+		return nil, err
+	}
+
+	before := services.Snapshot(user)
+	user.UpdateOrganization(departmentID, user.RoleID, managerID, req.EmployeeCode, actor.UserID(ctx))
+
+	// Update user and record the change in one transaction
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			return errors.WrapError(err, "SYS_001", "Failed to update organization")
+		}
+
+		return recordAudit(ctx, uc.auditRecorder, services.AuditActionUpdate, user.ID, before, services.Snapshot(user))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &UpdateOrganizationResponse{
+		User: user,
+	}, nil
+}
+
+// authorizeDestination checks the actor may update users of a new department and the new manager
+// Managers may make a user report to themselves.
+func (uc *UpdateOrganizationUseCase) authorizeDestination(ctx context.Context, user *entities.User, departmentID, managerID *uuid.UUID) error {
+	if departmentID != nil && (user.DepartmentID == nil || *user.DepartmentID != *departmentID) {
+		resource := services.DepartmentMemberPolicyResource(*departmentID)
+		err := authorizeResource(ctx, uc.policyEngine, services.PolicyActionUpdate, resource, map[string]any{
+			"action":        services.PolicyActionUpdate,
+			"department_id": departmentID.String(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if managerID == nil || (user.ManagerID != nil && *user.ManagerID == *managerID) {
+		return nil
+	}
+	if current, ok := actor.FromContext(ctx); ok && current.UserID == *managerID {
+		return nil
+	}
+
+	manager, err := getUser(ctx, uc.userRepo, managerID.String())
+	if err != nil {
+		return err
+	}
+	return authorize(ctx, uc.policyEngine, services.PolicyActionUpdate, manager)
+}
+
+// resolveDepartment parses the department of a user and checks it exists and is active
+func (uc *UpdateOrganizationUseCase) resolveDepartment(ctx context.Context, value string) (*uuid.UUID, error) {
+	departmentID, err := parseOptionalUUID("department_id", value)
+	if err != nil || departmentID == nil {
+		return nil, err
+	}
+
+	department, err := uc.departmentRepo.GetByID(ctx, *departmentID)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to get department")
+	}
+
+	if department == nil {
+		return nil, errors.NewBusinessError("BIZ_001", "Department not found", map[string]any{
+			"department_id": value,
+		})
+	}
+
+	if !department.IsActive {
+		return nil, errors.NewBusinessError("BIZ_002", "Inactive department cannot be assigned", map[string]any{
+			"department_id": value,
+		})
+	}
+
+	return departmentID, nil
+}
+
+// resolveManager parses the manager of a user and rejects reporting line cycles
+func (uc *UpdateOrganizationUseCase) resolveManager(ctx context.Context, user *entities.User, value string) (*uuid.UUID, error) {
+	managerID, err := parseOptionalUUID("manager_id", value)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.userService.ValidateManager(ctx, user, managerID)
+	switch {
+	case err == nil:
+		return managerID, nil
+	case stderrors.Is(err, services.ErrManagerNotFound):
+		return nil, errors.NewBusinessError("BIZ_001", "Manager not found", map[string]any{
+			"manager_id": value,
+		})
+	case stderrors.Is(err, services.ErrManagerCycle):
+		return nil, errors.NewBusinessError("BIZ_002", "Manager would create a reporting line cycle", map[string]any{
+			"id":         user.ID.String(),
+			"manager_id": value,
+		})
+	default:
+		return nil, errors.WrapError(err, "SYS_001", "Failed to validate manager")
+	}
+}
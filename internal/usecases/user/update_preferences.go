@@ -0,0 +1,74 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/errors"
+)
+
+// UpdatePreferencesRequest represents the request to update the current user's preferences
+type UpdatePreferencesRequest struct {
+	UserID           string `json:"-" validate:"required,uuid"`
+	Language         string `json:"language" validate:"required,min=2,max=10"`
+	Timezone         string `json:"timezone" validate:"required,max=50"`
+	NotificationPref string `json:"notification_pref" validate:"required,oneof=ALL EMAIL SMS NONE"`
+}
+
+// UpdatePreferencesResponse represents the response after updating the current user's preferences
+type UpdatePreferencesResponse struct {
+	User *entities.User `json:"user"`
+}
+
+// UpdatePreferencesUseCase handles self-service preference updates
+type UpdatePreferencesUseCase struct {
+	userRepo      repositories.UserRepository
+	transactor    repositories.Transactor
+	auditRecorder *services.AuditRecorder
+}
+
+// NewUpdatePreferencesUseCase creates a new update preferences use case
+func NewUpdatePreferencesUseCase(userRepo repositories.UserRepository, transactor repositories.Transactor, auditRecorder *services.AuditRecorder) *UpdatePreferencesUseCase {
+	return &UpdatePreferencesUseCase{
+		userRepo:      userRepo,
+		transactor:    transactor,
+		auditRecorder: auditRecorder,
+	}
+}
+
+// Execute updates the language, timezone and notification preference of the current user
+func (uc *UpdatePreferencesUseCase) Execute(ctx context.Context, req *UpdatePreferencesRequest) (*UpdatePreferencesResponse, error) {
+	// Timezones must be IANA names the server can load
+	if _, err := time.LoadLocation(req.Timezone); err != nil {
+		return nil, errors.NewValidationError("VAL_002", "Invalid timezone", map[string]any{
+			"timezone": req.Timezone,
+		})
+	}
+
+	user, err := getUser(ctx, uc.userRepo, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	before := services.Snapshot(user)
+	user.UpdatePreferences(req.Language, req.Timezone, req.NotificationPref, &user.ID)
+
+	// Update preferences and record the change in one transaction
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			return errors.WrapError(err, "BIZ_001", "Failed to update user")
+		}
+
+		return recordAudit(ctx, uc.auditRecorder, services.AuditActionUpdate, user.ID, before, services.Snapshot(user))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &UpdatePreferencesResponse{
+		User: user,
+	}, nil
+}
@@ -0,0 +1,120 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/errors"
+)
+
+// UpdateProfileRequest represents a partial update of the current user's own profile
+// Omitted fields are left unchanged; an empty date_of_birth clears it.
+type UpdateProfileRequest struct {
+	UserID      string  `json:"-" validate:"required,uuid"`
+	FirstName   *string `json:"first_name" validate:"omitempty,min=1,max=100"`
+	LastName    *string `json:"last_name" validate:"omitempty,min=1,max=100"`
+	Phone       *string `json:"phone" validate:"omitempty,min=10,max=20"`
+	Avatar      *string `json:"avatar" validate:"omitempty,url,max=500"`
+	Gender      *string `json:"gender" validate:"omitempty,max=10"`
+	Address     *string `json:"address" validate:"omitempty,max=500"`
+	City        *string `json:"city" validate:"omitempty,max=100"`
+	Country     *string `json:"country" validate:"omitempty,max=100"`
+	DateOfBirth *string `json:"date_of_birth" validate:"omitempty,datetime=2006-01-02"`
+}
+
+// UpdateProfileResponse represents the response after updating the current user's profile
+type UpdateProfileResponse struct {
+	User *entities.User `json:"user"`
+}
+
+// UpdateProfileUseCase handles self-service profile updates
+type UpdateProfileUseCase struct {
+	userRepo      repositories.UserRepository
+	userService   *services.UserService
+	transactor    repositories.Transactor
+	auditRecorder *services.AuditRecorder
+}
+
+// NewUpdateProfileUseCase creates a new update profile use case
+func NewUpdateProfileUseCase(userRepo repositories.UserRepository, userService *services.UserService, transactor repositories.Transactor, auditRecorder *services.AuditRecorder) *UpdateProfileUseCase {
+	return &UpdateProfileUseCase{
+		userRepo:      userRepo,
+		userService:   userService,
+		transactor:    transactor,
+		auditRecorder: auditRecorder,
+	}
+}
+
+// Execute updates the personal profile fields of the current user
+// Username, email, status and organization are managed by administrators.
+func (uc *UpdateProfileUseCase) Execute(ctx context.Context, req *UpdateProfileRequest) (*UpdateProfileResponse, error) {
+	user, err := getUser(ctx, uc.userRepo, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	dateOfBirth := user.DateOfBirth
+	if req.DateOfBirth != nil {
+		dateOfBirth = nil
+		if *req.DateOfBirth != "" {
+			parsed, err := time.Parse("2006-01-02", *req.DateOfBirth)
+			if err != nil {
+				return nil, errors.NewValidationError("VAL_002", "Invalid date of birth format", map[string]any{
+					"date_of_birth": *req.DateOfBirth,
+				})
+			}
+			dateOfBirth = &parsed
+		}
+	}
+
+	before := services.Snapshot(user)
+	user.UpdateProfile(
+		valueOr(req.FirstName, user.FirstName),
+		valueOr(req.LastName, user.LastName),
+		valueOr(req.Phone, user.Phone),
+		&user.ID,
+	)
+	user.UpdateExtendedProfile(
+		valueOr(req.Avatar, user.Avatar),
+		valueOr(req.Gender, user.Gender),
+		valueOr(req.Address, user.Address),
+		valueOr(req.City, user.City),
+		valueOr(req.Country, user.Country),
+		dateOfBirth,
+		&user.ID,
+	)
+
+	// Validate user according to business rules
+	if err := uc.userService.ValidateUser(ctx, user); err != nil {
+		return nil, errors.NewValidationError("VAL_001", "User validation failed", map[string]any{
+			"error": err.Error(),
+		})
+	}
+
+	// Update profile and record the change in one transaction
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			return errors.WrapError(err, "BIZ_001", "Failed to update user")
+		}
+
+		return recordAudit(ctx, uc.auditRecorder, services.AuditActionUpdate, user.ID, before, services.Snapshot(user))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &UpdateProfileResponse{
+		User: user,
+	}, nil
+}
+
+// valueOr returns the value of an optional field, or the current value when it was omitted
+func valueOr(value *string, current string) string {
+	if value == nil {
+		return current
+	}
+	return *value
+}
@@ -2,10 +2,12 @@ package user
 
 import (
 	"context"
+	"strings"
 
 	"bm-staff/internal/domain/entities"
 	"bm-staff/internal/domain/repositories"
 	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/actor"
 	"bm-staff/pkg/errors"
 
 	"github.com/google/uuid"
@@ -23,20 +25,29 @@ type UpdateUserRequest struct {
 
 // UpdateUserResponse represents the response after updating a user
 type UpdateUserResponse struct {
-	User *entities.User `json:"user"`
+	User             *entities.User `json:"user"`
+	VerificationSent bool           `json:"verification_sent"` // The email changed and a verification link was queued for the new address
 }
 
 // UpdateUserUseCase handles user update business logic
 type UpdateUserUseCase struct {
-	userRepo    repositories.UserRepository
-	userService *services.UserService
+	userRepo          repositories.UserRepository
+	userService       *services.UserService
+	policyEngine      *services.PolicyEngine
+	emailVerification *services.EmailVerificationService
+	transactor        repositories.Transactor
+	auditRecorder     *services.AuditRecorder
 }
 
 // NewUpdateUserUseCase creates a new update user use case
-func NewUpdateUserUseCase(userRepo repositories.UserRepository, userService *services.UserService) *UpdateUserUseCase {
+func NewUpdateUserUseCase(userRepo repositories.UserRepository, userService *services.UserService, policyEngine *services.PolicyEngine, emailVerification *services.EmailVerificationService, transactor repositories.Transactor, auditRecorder *services.AuditRecorder) *UpdateUserUseCase {
 	return &UpdateUserUseCase{
-		userRepo:    userRepo,
-		userService: userService,
+		userRepo:          userRepo,
+		userService:       userService,
+		policyEngine:      policyEngine,
+		emailVerification: emailVerification,
+		transactor:        transactor,
+		auditRecorder:     auditRecorder,
 	}
 }
 
@@ -62,13 +73,34 @@ func (uc *UpdateUserUseCase) Execute(ctx context.Context, req *UpdateUserRequest
 		})
 	}
 
+	// Check the actor may update this user
+	if err := authorize(ctx, uc.policyEngine, services.PolicyActionUpdate, user); err != nil {
+		return nil, err
+	}
+
+	// Check the actor may change the username and email, which recover the account
+	emailChanged := !strings.EqualFold(user.Email, req.Email)
+	if emailChanged || user.Username != req.Username {
+		if err := authorize(ctx, uc.policyEngine, services.PolicyActionUpdateIdentity, user); err != nil {
+			return nil, err
+		}
+	}
+
 	// Update user fields
+	before := services.Snapshot(user)
 	user.Username = req.Username
 	user.Email = req.Email
 	user.FirstName = req.FirstName
 	user.LastName = req.LastName
+	if user.Phone != req.Phone {
+		user.PhoneVerified = false
+	}
 	user.Phone = req.Phone
-	user.UpdateVersion(nil) // TODO: Pass actual user ID from context
+	if emailChanged {
+		// The new address has to be verified again
+		user.EmailVerified = false
+	}
+	user.UpdateVersion(actor.UserID(ctx))
 
 	// Validate user according to business rules
 	if err := uc.userService.ValidateUser(ctx, user); err != nil {
@@ -77,12 +109,25 @@ func (uc *UpdateUserUseCase) Execute(ctx context.Context, req *UpdateUserRequest
 		})
 	}
 
-	// Update user in repository
-	if err := uc.userRepo.Update(ctx, user); err != nil {
-		return nil, errors.WrapError(err, "BIZ_001", "Failed to update user")
+	// Update user and record the change in one transaction
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			return errors.WrapError(err, "BIZ_001", "Failed to update user")
+		}
+
+		return recordAudit(ctx, uc.auditRecorder, services.AuditActionUpdate, user.ID, before, services.Snapshot(user))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	verificationSent := false
+	if emailChanged {
+		verificationSent = uc.emailVerification.SendVerification(ctx, user) == nil
 	}
 
 	return &UpdateUserResponse{
-		User: user,
+		User:             user,
+		VerificationSent: verificationSent,
 	}, nil
 }
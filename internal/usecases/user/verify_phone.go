@@ -0,0 +1,105 @@
+package user
+
+import (
+	"context"
+
+	"bm-staff/internal/domain/entities"
+	"bm-staff/internal/domain/repositories"
+	"bm-staff/internal/domain/services"
+	"bm-staff/pkg/errors"
+)
+
+// VerifyPhoneRequest represents the request to confirm the current user's phone number with a texted code
+type VerifyPhoneRequest struct {
+	UserID string `json:"-" validate:"required,uuid"`
+	Code   string `json:"code" validate:"required,numeric,min=4,max=10"`
+}
+
+// VerifyPhoneResponse represents the response after verifying the phone number
+type VerifyPhoneResponse struct {
+	User *entities.User `json:"user"`
+}
+
+// VerifyPhoneUseCase checks texted codes and marks phone numbers verified
+type VerifyPhoneUseCase struct {
+	userRepo         repositories.UserRepository
+	verificationRepo repositories.PhoneVerificationRepository
+	otpService       *services.OTPService
+	transactor       repositories.Transactor
+	auditRecorder    *services.AuditRecorder
+	maxAttempts      int
+}
+
+// NewVerifyPhoneUseCase creates a new verify phone use case; a code is void after maxAttempts entries
+func NewVerifyPhoneUseCase(
+	userRepo repositories.UserRepository,
+	verificationRepo repositories.PhoneVerificationRepository,
+	otpService *services.OTPService,
+	transactor repositories.Transactor,
+	auditRecorder *services.AuditRecorder,
+	maxAttempts int,
+) *VerifyPhoneUseCase {
+	return &VerifyPhoneUseCase{
+		userRepo:         userRepo,
+		verificationRepo: verificationRepo,
+		otpService:       otpService,
+		transactor:       transactor,
+		auditRecorder:    auditRecorder,
+		maxAttempts:      maxAttempts,
+	}
+}
+
+// Execute checks the code against the latest one sent and marks the phone number verified
+func (uc *VerifyPhoneUseCase) Execute(ctx context.Context, req *VerifyPhoneRequest) (*VerifyPhoneResponse, error) {
+	user, err := getUser(ctx, uc.userRepo, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	invalidCode := errors.NewValidationError("VAL_003", "Invalid or expired verification code", nil)
+
+	verification, err := uc.verificationRepo.GetLatestForUser(ctx, user.ID.String())
+	if err != nil || !verification.IsValid() || verification.Phone != user.Phone {
+		return nil, invalidCode
+	}
+
+	// Count the entry before comparing, so parallel guesses share the attempt limit
+	reserved, err := uc.verificationRepo.ReserveAttempt(ctx, verification.ID.String(), uc.maxAttempts)
+	if err != nil {
+		return nil, errors.WrapError(err, "SYS_001", "Failed to check verification code")
+	}
+	if !reserved {
+		return nil, errors.NewBusinessError("BIZ_003", "Too many wrong codes, request a new verification code", nil)
+	}
+
+	if !uc.otpService.Matches(verification.ID.String(), req.Code, verification.CodeHash) {
+		return nil, errors.NewValidationError("VAL_003", "Invalid or expired verification code", map[string]any{
+			"attempts_left": max(uc.maxAttempts-verification.Attempts-1, 0),
+		})
+	}
+
+	before := services.Snapshot(user)
+	user.VerifyPhone(&user.ID)
+	verification.MarkConsumed(&user.ID)
+
+	// Consume the code, store the verification and record it in one transaction
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		// Fails when a concurrent request consumed the code first
+		if err := uc.verificationRepo.MarkConsumed(ctx, verification); err != nil {
+			return invalidCode
+		}
+
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			return errors.WrapError(err, "BIZ_001", "Failed to update user")
+		}
+
+		return recordAudit(ctx, uc.auditRecorder, services.AuditActionUpdate, user.ID, before, services.Snapshot(user))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &VerifyPhoneResponse{
+		User: user,
+	}, nil
+}
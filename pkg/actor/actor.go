@@ -0,0 +1,41 @@
+package actor
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// contextKey is the private type for the actor context key
+type contextKey struct{}
+
+// Actor identifies the authenticated caller of a request
+type Actor struct {
+	UserID    uuid.UUID
+	Username  string
+	RoleID    *uuid.UUID
+	IPAddress string
+	UserAgent string
+	SessionID string // JWT ID (jti) of the access token
+}
+
+// WithActor returns a copy of ctx carrying the actor
+func WithActor(ctx context.Context, a *Actor) context.Context {
+	return context.WithValue(ctx, contextKey{}, a)
+}
+
+// FromContext returns the actor carried by ctx, if any
+func FromContext(ctx context.Context) (*Actor, bool) {
+	a, ok := ctx.Value(contextKey{}).(*Actor)
+	return a, ok && a != nil
+}
+
+// UserID returns the acting user's ID for audit fields, or nil for anonymous requests
+func UserID(ctx context.Context) *uuid.UUID {
+	a, ok := FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	id := a.UserID
+	return &id
+}
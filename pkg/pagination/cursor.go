@@ -0,0 +1,117 @@
+package pagination
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidCursor is returned when a cursor token is malformed or its signature does not match
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// ErrCursorMismatch is returned when a cursor token was issued for different filters or sort order
+var ErrCursorMismatch = errors.New("cursor does not match the listing filters")
+
+// scopeDigestSize is the number of SHA-256 bytes kept to identify a cursor scope
+const scopeDigestSize = 16
+
+// cursorEnvelope binds a cursor position to the digest of the listing scope it was issued for
+type cursorEnvelope struct {
+	Scope    string          `json:"s"`
+	Position json.RawMessage `json:"p"`
+}
+
+// CursorCodec encodes keyset pagination positions into opaque, signed cursor tokens
+// Token format: base64url(json envelope) + "." + base64url(HMAC-SHA256(envelope))
+// The scope is whatever determines the row order of a listing (its filters and sort); a cursor only
+// decodes against the scope it was encoded with, so it cannot seek a differently filtered listing.
+type CursorCodec struct {
+	secret []byte
+}
+
+// NewCursorCodec creates a new cursor codec signing tokens with the given secret
+func NewCursorCodec(secret string) *CursorCodec {
+	return &CursorCodec{
+		secret: []byte(secret),
+	}
+}
+
+// Encode serializes and signs a cursor payload for the given listing scope
+func (c *CursorCodec) Encode(scope, payload any) (string, error) {
+	digest, err := scopeDigest(scope)
+	if err != nil {
+		return "", err
+	}
+
+	position, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode cursor: %w", err)
+	}
+
+	data, err := json.Marshal(cursorEnvelope{Scope: digest, Position: position})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode cursor: %w", err)
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(data)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(c.sign(encoded)), nil
+}
+
+// Decode verifies a cursor token issued for the given listing scope and deserializes its payload
+func (c *CursorCodec) Decode(token string, scope, payload any) error {
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return ErrInvalidCursor
+	}
+
+	expected, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(expected, c.sign(encoded)) {
+		return ErrInvalidCursor
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return ErrInvalidCursor
+	}
+
+	var envelope cursorEnvelope
+	if err := json.Unmarshal(data, &envelope); err != nil || envelope.Position == nil {
+		return ErrInvalidCursor
+	}
+
+	digest, err := scopeDigest(scope)
+	if err != nil {
+		return err
+	}
+	if !hmac.Equal([]byte(envelope.Scope), []byte(digest)) {
+		return ErrCursorMismatch
+	}
+
+	if err := json.Unmarshal(envelope.Position, payload); err != nil {
+		return ErrInvalidCursor
+	}
+
+	return nil
+}
+
+// scopeDigest identifies a listing scope by a truncated SHA-256 of its JSON form
+func scopeDigest(scope any) (string, error) {
+	data, err := json.Marshal(scope)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode cursor scope: %w", err)
+	}
+
+	sum := sha256.Sum256(data)
+	return base64.RawURLEncoding.EncodeToString(sum[:scopeDigestSize]), nil
+}
+
+// sign computes the HMAC-SHA256 signature of an encoded payload
+func (c *CursorCodec) sign(encoded string) []byte {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write([]byte(encoded))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,91 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testPosition struct {
+	CreatedAt time.Time `json:"c"`
+	ID        string    `json:"i"`
+}
+
+type testScope struct {
+	Status string `json:"st,omitempty"`
+	Desc   bool   `json:"d,omitempty"`
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	codec := NewCursorCodec("secret")
+	scope := testScope{Status: "ACTIVE", Desc: true}
+	want := testPosition{CreatedAt: time.Date(2024, 5, 1, 12, 30, 0, 123456789, time.UTC), ID: "0b7e5f8e-4a0b-4f57-9d3e-0f3c6a1d2b4e"}
+
+	token, err := codec.Encode(scope, want)
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	if strings.ContainsAny(token, "+/=") {
+		t.Errorf("Encode() = %q, want a URL-safe token", token)
+	}
+
+	var got testPosition
+	if err := codec.Decode(token, scope, &got); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if !got.CreatedAt.Equal(want.CreatedAt) || got.ID != want.ID {
+		t.Errorf("Decode() = %+v, want %+v", got, want)
+	}
+}
+
+func TestCursorDecodeRejects(t *testing.T) {
+	codec := NewCursorCodec("secret")
+	scope := testScope{Status: "ACTIVE"}
+	token, err := codec.Encode(scope, testPosition{ID: "a"})
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	encoded, signature, _ := strings.Cut(token, ".")
+
+	// Swap the payload for one that moves the position, keeping the original signature
+	forged := base64.RawURLEncoding.EncodeToString([]byte(`{"s":"x","p":{"i":"b"}}`)) + "." + signature
+
+	// A correctly signed envelope that carries no position
+	empty := base64.RawURLEncoding.EncodeToString([]byte(`{"s":"x"}`))
+	positionless := empty + "." + base64.RawURLEncoding.EncodeToString(codec.sign(empty))
+
+	tests := []struct {
+		name  string
+		codec *CursorCodec
+		token string
+		scope any
+		want  error
+	}{
+		{name: "missing signature", codec: codec, token: encoded, scope: scope, want: ErrInvalidCursor},
+		{name: "signature not base64", codec: codec, token: encoded + ".%%%", scope: scope, want: ErrInvalidCursor},
+		{name: "tampered payload", codec: codec, token: forged, scope: scope, want: ErrInvalidCursor},
+		{name: "truncated signature", codec: codec, token: token[:len(token)-2], scope: scope, want: ErrInvalidCursor},
+		{name: "other secret", codec: NewCursorCodec("other"), token: token, scope: scope, want: ErrInvalidCursor},
+		{name: "no position", codec: codec, token: positionless, scope: scope, want: ErrInvalidCursor},
+		{name: "other filter", codec: codec, token: token, scope: testScope{Status: "BLOCKED"}, want: ErrCursorMismatch},
+		{name: "other sort order", codec: codec, token: token, scope: testScope{Status: "ACTIVE", Desc: true}, want: ErrCursorMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got testPosition
+			if err := tt.codec.Decode(tt.token, tt.scope, &got); !errors.Is(err, tt.want) {
+				t.Errorf("Decode() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCursorEncodeUnsupportedScope(t *testing.T) {
+	codec := NewCursorCodec("secret")
+	if _, err := codec.Encode(make(chan int), testPosition{}); err == nil {
+		t.Error("Encode() accepted a scope that cannot be serialized")
+	}
+}
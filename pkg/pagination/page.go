@@ -0,0 +1,32 @@
+package pagination
+
+const (
+	// DefaultLimit is the page size used when none is requested
+	DefaultLimit = 10
+	// MaxLimit is the largest page size a client may request
+	MaxLimit = 100
+)
+
+// Page represents the pagination envelope of a list response
+// NextCursor/PrevCursor are only set by listings that support keyset pagination.
+type Page struct {
+	Limit      int    `json:"limit"`
+	Offset     int    `json:"offset"`
+	Total      int64  `json:"total"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	PrevCursor string `json:"prev_cursor,omitempty"`
+}
+
+// Normalize clamps a requested limit and offset to the allowed range
+func Normalize(limit, offset int) (int, int) {
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	if limit > MaxLimit {
+		limit = MaxLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
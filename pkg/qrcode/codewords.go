@@ -0,0 +1,112 @@
+package qrcode
+
+// bitBuffer accumulates bits most significant first
+type bitBuffer struct {
+	bits []bool
+}
+
+// append adds the low n bits of value
+func (b *bitBuffer) append(value, n int) {
+	for i := n - 1; i >= 0; i-- {
+		b.bits = append(b.bits, bit(value, i))
+	}
+}
+
+// len returns the number of bits
+func (b *bitBuffer) len() int {
+	return len(b.bits)
+}
+
+// bytes packs the bits into bytes; the length must be a multiple of 8
+func (b *bitBuffer) bytes() []byte {
+	result := make([]byte, len(b.bits)/8)
+	for i, set := range b.bits {
+		if set {
+			result[i>>3] |= 1 << (7 - (i & 7))
+		}
+	}
+	return result
+}
+
+// addErrorCorrection splits the data codewords into blocks, appends the Reed-Solomon codewords of each
+// and interleaves them in the order they are placed in the symbol
+func addErrorCorrection(data []byte, version int) []byte {
+	numBlocks := eccBlocks[version]
+	eccLen := eccCodewordsPerBlock[version]
+	rawCodewords := rawDataModules(version) / 8
+	numShortBlocks := numBlocks - rawCodewords%numBlocks
+	shortBlockLen := rawCodewords / numBlocks
+
+	// Short blocks come first, long blocks hold one more data codeword
+	divisor := reedSolomonDivisor(eccLen)
+	dataBlocks := make([][]byte, numBlocks)
+	eccCodewords := make([][]byte, numBlocks)
+	for i, offset := 0, 0; i < numBlocks; i++ {
+		length := shortBlockLen - eccLen
+		if i >= numShortBlocks {
+			length++
+		}
+		dataBlocks[i] = data[offset : offset+length]
+		eccCodewords[i] = reedSolomonRemainder(dataBlocks[i], divisor)
+		offset += length
+	}
+
+	result := make([]byte, 0, rawCodewords)
+	for i := 0; i <= shortBlockLen-eccLen; i++ {
+		for _, block := range dataBlocks {
+			if i < len(block) {
+				result = append(result, block[i])
+			}
+		}
+	}
+	for i := range eccLen {
+		for _, block := range eccCodewords {
+			result = append(result, block[i])
+		}
+	}
+	return result
+}
+
+// reedSolomonDivisor returns the generator polynomial of the degree, highest coefficient first
+// with the leading 1 left out
+func reedSolomonDivisor(degree int) []byte {
+	result := make([]byte, degree)
+	result[degree-1] = 1
+
+	// Multiply (x - r^0)(x - r^1)...(x - r^{degree-1}) where r = 0x02 generates GF(2^8)
+	root := byte(1)
+	for range degree {
+		for j := range degree {
+			result[j] = gfMultiply(result[j], root)
+			if j+1 < degree {
+				result[j] ^= result[j+1]
+			}
+		}
+		root = gfMultiply(root, 0x02)
+	}
+	return result
+}
+
+// reedSolomonRemainder returns the error correction codewords of data
+func reedSolomonRemainder(data, divisor []byte) []byte {
+	result := make([]byte, len(divisor))
+	for _, b := range data {
+		factor := b ^ result[0]
+		copy(result, result[1:])
+		result[len(result)-1] = 0
+		for i, coefficient := range divisor {
+			result[i] ^= gfMultiply(coefficient, factor)
+		}
+	}
+	return result
+}
+
+// gfMultiply multiplies in GF(2^8) modulo x^8 + x^4 + x^3 + x^2 + 1
+func gfMultiply(x, y byte) byte {
+	var z int
+	for i := 7; i >= 0; i-- {
+		z = (z << 1) ^ ((z >> 7) * 0x11D)
+		z ^= int((y>>i)&1) * int(x)
+	}
+	return byte(z)
+}
@@ -0,0 +1,397 @@
+package qrcode
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files")
+
+// Level M format information by mask, ISO/IEC 18004 table C.1
+var formatInfoM = [8]int{0x5412, 0x5125, 0x5E7C, 0x5B4B, 0x45F9, 0x40CE, 0x4F97, 0x4AA0}
+
+// Version information of versions 7 and up, ISO/IEC 18004 table D.1
+var versionInfo = map[int]int{7: 0x07C94, 8: 0x085BC, 9: 0x09A99, 10: 0x0A4D3}
+
+// Level M block structure, ISO/IEC 18004 table 9: blocks of (total, data) codewords
+var blocksM = map[int][][2]int{
+	1:  {{26, 16}},
+	2:  {{44, 28}},
+	3:  {{70, 44}},
+	4:  {{50, 32}, {50, 32}},
+	5:  {{67, 43}, {67, 43}},
+	6:  {{43, 27}, {43, 27}, {43, 27}, {43, 27}},
+	7:  {{49, 31}, {49, 31}, {49, 31}, {49, 31}},
+	8:  {{60, 38}, {60, 38}, {61, 39}, {61, 39}},
+	9:  {{58, 36}, {58, 36}, {58, 36}, {59, 37}, {59, 37}},
+	10: {{69, 43}, {69, 43}, {69, 43}, {69, 43}, {70, 44}},
+}
+
+// Alignment pattern centers, ISO/IEC 18004 annex E
+var alignmentCenters = map[int][]int{
+	1: nil, 2: {6, 18}, 3: {6, 22}, 4: {6, 26}, 5: {6, 30},
+	6: {6, 34}, 7: {6, 22, 38}, 8: {6, 24, 42}, 9: {6, 26, 46}, 10: {6, 28, 50},
+}
+
+func TestEncodeDecodes(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		version int
+	}{
+		{name: "single character", content: "a", version: 1},
+		{name: "url", content: "https://example.com", version: 2},
+		{name: "otpauth uri", content: "otpauth://totp/BM%20Staff:jdoe%40example.com?algorithm=SHA1&digits=6&issuer=BM%20Staff&period=30&secret=JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP", version: 8},
+		{name: "binary", content: "\x00\xff\x10\x80 mixed bytes", version: 2},
+		{name: "version information", content: strings.Repeat("0123456789", 12), version: 7},
+		{name: "sixteen bit count", content: strings.Repeat("abcdefghij", 20), version: 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := Encode(tt.content)
+			if err != nil {
+				t.Fatalf("Encode() error = %v", err)
+			}
+
+			if got := (code.Size() - 17) / 4; got != tt.version {
+				t.Errorf("version = %d, want %d", got, tt.version)
+			}
+
+			got, err := decode(code)
+			if err != nil {
+				t.Fatalf("decode() error = %v", err)
+			}
+			if got != tt.content {
+				t.Errorf("decode() = %q, want %q", got, tt.content)
+			}
+		})
+	}
+}
+
+func TestEncodeGolden(t *testing.T) {
+	code, err := Encode("https://example.com")
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+
+	var b strings.Builder
+	for y := range code.Size() {
+		for x := range code.Size() {
+			if code.Dark(x, y) {
+				b.WriteByte('#')
+			} else {
+				b.WriteByte('.')
+			}
+		}
+		b.WriteByte('\n')
+	}
+
+	path := filepath.Join("testdata", "example_com.golden")
+	if *update {
+		if err := os.WriteFile(path, []byte(b.String()), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.String() != string(want) {
+		t.Errorf("Encode() symbol differs from %s:\n%s", path, b.String())
+	}
+}
+
+func TestEncodeTooLong(t *testing.T) {
+	if _, err := Encode(strings.Repeat("x", 2332)); !errors.Is(err, ErrTooLong) {
+		t.Errorf("Encode() error = %v, want %v", err, ErrTooLong)
+	}
+}
+
+func TestPNG(t *testing.T) {
+	code, err := Encode("https://example.com")
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+
+	data, err := code.PNG(4)
+	if err != nil {
+		t.Fatalf("PNG() error = %v", err)
+	}
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("png.Decode() error = %v", err)
+	}
+
+	side := (code.Size() + 2*quietZone) * 4
+	if bounds := img.Bounds(); bounds.Dx() != side || bounds.Dy() != side {
+		t.Fatalf("PNG size = %v, want %dx%d", bounds, side, side)
+	}
+	for y := range code.Size() {
+		for x := range code.Size() {
+			r, _, _, _ := img.At((x+quietZone)*4+1, (y+quietZone)*4+1).RGBA()
+			if dark := r == 0; dark != code.Dark(x, y) {
+				t.Fatalf("pixel of module (%d, %d) dark = %v, want %v", x, y, dark, code.Dark(x, y))
+			}
+		}
+	}
+}
+
+// decode reads a byte mode, level M symbol using the tables of the standard rather than the encoder's helpers
+func decode(code *Code) (string, error) {
+	size := code.Size()
+	version := (size - 17) / 4
+	blocks, ok := blocksM[version]
+	if !ok || size != version*4+17 {
+		return "", errors.New("unsupported version")
+	}
+	dark := func(x, y int) int {
+		if code.Dark(x, y) {
+			return 1
+		}
+		return 0
+	}
+
+	// Format information, both copies
+	var first, second int
+	for i, pos := range [][2]int{{8, 0}, {8, 1}, {8, 2}, {8, 3}, {8, 4}, {8, 5}, {8, 7}, {8, 8}, {7, 8}, {5, 8}, {4, 8}, {3, 8}, {2, 8}, {1, 8}, {0, 8}} {
+		first |= dark(pos[0], pos[1]) << i
+	}
+	for i := range 8 {
+		second |= dark(size-1-i, 8) << i
+	}
+	for i := 8; i < 15; i++ {
+		second |= dark(8, size-15+i) << i
+	}
+	if first != second {
+		return "", errors.New("format information copies differ")
+	}
+	mask := -1
+	for m, info := range formatInfoM {
+		if info == first {
+			mask = m
+		}
+	}
+	if mask < 0 {
+		return "", errors.New("format information is not level M")
+	}
+	if dark(8, size-8) != 1 {
+		return "", errors.New("dark module missing")
+	}
+
+	// Version information, both copies
+	if version >= 7 {
+		var bottomLeft, topRight int
+		for i := range 18 {
+			bottomLeft |= dark(i/3, size-11+i%3) << i
+			topRight |= dark(size-11+i%3, i/3) << i
+		}
+		if bottomLeft != versionInfo[version] || topRight != versionInfo[version] {
+			return "", errors.New("version information mismatch")
+		}
+	}
+
+	// Modules reserved for function patterns
+	reserved := make([][]bool, size)
+	for i := range reserved {
+		reserved[i] = make([]bool, size)
+	}
+	reserve := func(x0, y0, w, h int) {
+		for y := y0; y < y0+h; y++ {
+			for x := x0; x < x0+w; x++ {
+				reserved[y][x] = true
+			}
+		}
+	}
+	reserve(0, 0, 9, 9)      // Top left finder, separator and format information
+	reserve(size-8, 0, 8, 9) // Top right finder, separator and format information
+	reserve(0, size-8, 9, 8) // Bottom left finder, separator, format information and dark module
+	reserve(6, 0, 1, size)   // Vertical timing pattern
+	reserve(0, 6, size, 1)   // Horizontal timing pattern
+	if version >= 7 {
+		reserve(size-11, 0, 3, 6) // Version information
+		reserve(0, size-11, 6, 3)
+	}
+	centers := alignmentCenters[version]
+	last := len(centers) - 1
+	for i, cy := range centers {
+		for j, cx := range centers {
+			if (i == 0 && j == 0) || (i == 0 && j == last) || (i == last && j == 0) {
+				continue // Overlaps a finder pattern
+			}
+			reserve(cx-2, cy-2, 5, 5)
+		}
+	}
+
+	// Codewords in zigzag order, unmasked
+	var bits []int
+	upward := true
+	for right := size - 1; right > 0; right -= 2 {
+		if right == 6 {
+			right--
+		}
+		for step := range size {
+			y := step
+			if upward {
+				y = size - 1 - step
+			}
+			for _, x := range []int{right, right - 1} {
+				if reserved[y][x] {
+					continue
+				}
+				bits = append(bits, dark(x, y)^maskBit(mask, y, x))
+			}
+		}
+		upward = !upward
+	}
+
+	total := 0
+	for _, block := range blocks {
+		total += block[0]
+	}
+	if len(bits)/8 != total {
+		return "", errors.New("codeword count mismatch")
+	}
+	codewords := make([]byte, total)
+	for i := range codewords {
+		for _, b := range bits[i*8 : i*8+8] {
+			codewords[i] = codewords[i]<<1 | byte(b)
+		}
+	}
+
+	// Deinterleave the blocks and check every block has zero syndromes
+	blockCodewords := make([][]byte, len(blocks))
+	i := 0
+	for k := 0; ; k++ {
+		placed := false
+		for b, block := range blocks {
+			if k < block[1] {
+				blockCodewords[b] = append(blockCodewords[b], codewords[i])
+				i++
+				placed = true
+			}
+		}
+		if !placed {
+			break
+		}
+	}
+	for k := 0; k < blocks[0][0]-blocks[0][1]; k++ {
+		for b := range blocks {
+			blockCodewords[b] = append(blockCodewords[b], codewords[i])
+			i++
+		}
+	}
+	var data []byte
+	for b, block := range blocks {
+		if !zeroSyndromes(blockCodewords[b], block[0]-block[1]) {
+			return "", errors.New("reed-solomon check failed")
+		}
+		data = append(data, blockCodewords[b][:block[1]]...)
+	}
+
+	// Byte mode segment followed by a terminator and pad codewords
+	reader := &bitReader{data: data}
+	if mode := reader.read(4); mode != 0x4 {
+		return "", errors.New("not byte mode")
+	}
+	countBits := 8
+	if version > 9 {
+		countBits = 16
+	}
+	content := make([]byte, reader.read(countBits))
+	for i := range content {
+		content[i] = byte(reader.read(8))
+	}
+	if reader.pos+4 <= len(data)*8 && reader.read(4) != 0 {
+		return "", errors.New("missing terminator")
+	}
+	reader.pos = (reader.pos + 7) / 8 * 8
+	for pad := 0xEC; reader.pos < len(data)*8; pad ^= 0xEC ^ 0x11 {
+		if reader.read(8) != pad {
+			return "", errors.New("invalid padding")
+		}
+	}
+
+	return string(content), nil
+}
+
+// maskBit returns the data mask of row i and column j, ISO/IEC 18004 table 10
+func maskBit(mask, i, j int) int {
+	var invert bool
+	switch mask {
+	case 0:
+		invert = (i+j)%2 == 0
+	case 1:
+		invert = i%2 == 0
+	case 2:
+		invert = j%3 == 0
+	case 3:
+		invert = (i+j)%3 == 0
+	case 4:
+		invert = (i/2+j/3)%2 == 0
+	case 5:
+		invert = (i*j)%2+(i*j)%3 == 0
+	case 6:
+		invert = ((i*j)%2+(i*j)%3)%2 == 0
+	case 7:
+		invert = ((i+j)%2+(i*j)%3)%2 == 0
+	}
+	if invert {
+		return 1
+	}
+	return 0
+}
+
+// zeroSyndromes evaluates the block polynomial at the first eccLen powers of the generator
+func zeroSyndromes(block []byte, eccLen int) bool {
+	var exp [512]byte
+	x := 1
+	for i := range 255 {
+		exp[i], exp[i+255] = byte(x), byte(x)
+		x <<= 1
+		if x&0x100 != 0 {
+			x ^= 0x11D
+		}
+	}
+	var log [256]int
+	for i := range 255 {
+		log[exp[i]] = i
+	}
+
+	for s := range eccLen {
+		var sum byte
+		for _, c := range block {
+			// Horner's rule: sum = sum * alpha^s + c
+			if sum != 0 {
+				sum = exp[log[sum]+s]
+			}
+			sum ^= c
+		}
+		if sum != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// bitReader reads bits most significant first
+type bitReader struct {
+	data []byte
+	pos  int
+}
+
+func (r *bitReader) read(n int) int {
+	value := 0
+	for range n {
+		value = value<<1 | int(r.data[r.pos/8]>>(7-r.pos%8)&1)
+		r.pos++
+	}
+	return value
+}
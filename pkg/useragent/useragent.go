@@ -0,0 +1,104 @@
+package useragent
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Device types
+const (
+	DeviceDesktop = "desktop"
+	DeviceMobile  = "mobile"
+	DeviceTablet  = "tablet"
+	DeviceBot     = "bot"
+	DeviceUnknown = "unknown"
+)
+
+// Info describes the client a User-Agent header identifies
+type Info struct {
+	Browser        string `json:"browser"`
+	BrowserVersion string `json:"browser_version,omitempty"`
+	OS             string `json:"os"`
+	Device         string `json:"device"`
+}
+
+// browserPattern matches a browser token; order matters since most browsers also claim Safari or Chrome
+type browserPattern struct {
+	name    string
+	pattern *regexp.Regexp
+}
+
+var browsers = []browserPattern{
+	{"Edge", regexp.MustCompile(`(?:Edg|Edge|EdgA|EdgiOS)/([\d.]+)`)},
+	{"Opera", regexp.MustCompile(`(?:OPR|Opera)/([\d.]+)`)},
+	{"Samsung Internet", regexp.MustCompile(`SamsungBrowser/([\d.]+)`)},
+	{"Coc Coc", regexp.MustCompile(`coc_coc_browser/([\d.]+)`)},
+	{"Firefox", regexp.MustCompile(`(?:Firefox|FxiOS)/([\d.]+)`)},
+	{"Chrome", regexp.MustCompile(`(?:Chrome|CriOS)/([\d.]+)`)},
+	{"Safari", regexp.MustCompile(`Version/([\d.]+).*Safari/`)},
+	{"Internet Explorer", regexp.MustCompile(`(?:MSIE |Trident/.*rv:)([\d.]+)`)},
+	{"curl", regexp.MustCompile(`curl/([\d.]+)`)},
+	{"Postman", regexp.MustCompile(`PostmanRuntime/([\d.]+)`)},
+}
+
+var botPattern = regexp.MustCompile(`(?i)bot|crawler|spider|slurp`)
+
+// Parse extracts browser, operating system and device type from a User-Agent header
+// Unrecognized values are reported as "Unknown" rather than failing.
+func Parse(userAgent string) Info {
+	info := Info{
+		Browser: "Unknown",
+		OS:      parseOS(userAgent),
+		Device:  parseDevice(userAgent),
+	}
+
+	for _, browser := range browsers {
+		if match := browser.pattern.FindStringSubmatch(userAgent); match != nil {
+			info.Browser = browser.name
+			info.BrowserVersion = match[1]
+			break
+		}
+	}
+
+	return info
+}
+
+// parseOS returns the operating system family named by the User-Agent
+func parseOS(userAgent string) string {
+	switch {
+	case strings.Contains(userAgent, "Windows"):
+		return "Windows"
+	case strings.Contains(userAgent, "iPhone"), strings.Contains(userAgent, "iPad"), strings.Contains(userAgent, "iPod"):
+		return "iOS"
+	case strings.Contains(userAgent, "Mac OS X"), strings.Contains(userAgent, "Macintosh"):
+		return "macOS"
+	case strings.Contains(userAgent, "Android"):
+		return "Android"
+	case strings.Contains(userAgent, "CrOS"):
+		return "ChromeOS"
+	case strings.Contains(userAgent, "Linux"):
+		return "Linux"
+	default:
+		return "Unknown"
+	}
+}
+
+// parseDevice returns the device type of the User-Agent
+func parseDevice(userAgent string) string {
+	switch {
+	case userAgent == "":
+		return DeviceUnknown
+	case botPattern.MatchString(userAgent):
+		return DeviceBot
+	case strings.Contains(userAgent, "iPad"), strings.Contains(userAgent, "Tablet"),
+		strings.Contains(userAgent, "Android") && !strings.Contains(userAgent, "Mobile"):
+		return DeviceTablet
+	case strings.Contains(userAgent, "Mobile"), strings.Contains(userAgent, "iPhone"):
+		return DeviceMobile
+	case strings.Contains(userAgent, "Windows"), strings.Contains(userAgent, "Macintosh"),
+		strings.Contains(userAgent, "Linux"), strings.Contains(userAgent, "CrOS"):
+		return DeviceDesktop
+	default:
+		return DeviceUnknown
+	}
+}